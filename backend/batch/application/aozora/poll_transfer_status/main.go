@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	service "github.com/huydq/test/batch/domain/service/aozora"
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	"github.com/huydq/test/batch/infrastructure/container"
+	payoutPersistence "github.com/huydq/test/batch/infrastructure/persistence/payout"
+	task "github.com/huydq/test/batch/task/aozora/poll_transfer_status"
+	sharedTask "github.com/huydq/test/batch/task/aozora/shared"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// Execute polls Aozora Net Bank for the status of requested transfers and reconciles them with the payouts
+func Execute(workers int, dryRun bool) {
+	log.Println("======= Start AozoraPollTransferStatus Shell =======")
+	defer log.Println("======= Stop AozoraPollTransferStatus Shell =======")
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize DB: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	bankClient := aozora.NewAozoraClient(aozora.AozoraConfig{
+		BaseURL:     appConfig.AozoraAPIBaseURL,
+		AccessToken: appConfig.AozoraAccessToken,
+		AccountID:   appConfig.AozoraAccountID,
+		Timeout:     time.Duration(appConfig.AozoraAPITimeoutSeconds) * time.Second,
+	})
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// Keep other processes from running the command at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, "aozora_poll_transfer_status")
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Initialize repositories
+	payoutRepo := payoutPersistence.NewPayoutRepository(batchService.DB)
+	payoutRecordRepo := payoutPersistence.NewPayoutRecordRepository(batchService.DB)
+	payoutReconciliationRepo := payoutPersistence.NewPayoutReconciliationRepository(batchService.DB)
+
+	// Initialize usecases
+	payoutUC := payoutUsecase.NewPayoutUsecase(payoutRepo, payoutRecordRepo)
+	reconciliationUC := payoutUsecase.NewPayoutReconciliationUsecase(payoutReconciliationRepo)
+
+	// Initialize domain services
+	transferStatusService := service.NewTransferStatusService()
+
+	// Initialize tasks
+	workerPoolTask := sharedTask.NewWorkerPoolTask(workers, logger)
+	pollTask := task.NewPollTransferStatusTask(bankClient, transferStatusService, payoutUC, reconciliationUC, dryRun, logger)
+	rollUpTask := task.NewRollUpPayoutTask(payoutUC, logger)
+
+	// Start the polling process
+	start := time.Now()
+
+	records, err := payoutUC.FindRecordsToPoll(ctx)
+	if err != nil {
+		logger.Error("Failed to find payout records to poll:", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	polledCount := workerPoolTask.ProcessPayoutRecords(ctx, records, pollTask.Do)
+	if commandLease.Lost() {
+		logger.Error("Stopped polling, the command lease was lost", map[string]any{
+			"polled": polledCount,
+		})
+		return
+	}
+
+	processedPayouts, failedPayouts := 0, 0
+	if !dryRun {
+		processedPayouts, failedPayouts = rollUpTask.Do(ctx, pollTask.SettledPayoutIDs())
+	}
+
+	log.Printf("AozoraPollTransferStatus job completed in %s, polled %d/%d records, processed %d payouts, %d payouts with failed transfers (dry-run: %t)",
+		time.Since(start), polledCount, len(records), processedPayouts, failedPayouts, dryRun)
+}
@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	service "github.com/huydq/test/batch/domain/service/aozora"
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	"github.com/huydq/test/batch/infrastructure/container"
+	payoutPersistence "github.com/huydq/test/batch/infrastructure/persistence/payout"
+	sharedTask "github.com/huydq/test/batch/task/aozora/shared"
+	task "github.com/huydq/test/batch/task/aozora/submit_payouts"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// Execute submits the approved payouts scheduled for today to Aozora Net Bank
+func Execute(workers int, dryRun bool) {
+	log.Println("======= Start AozoraSubmitPayouts Shell =======")
+	defer log.Println("======= Stop AozoraSubmitPayouts Shell =======")
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize DB: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	bankClient := aozora.NewAozoraClient(aozora.AozoraConfig{
+		BaseURL:     appConfig.AozoraAPIBaseURL,
+		AccessToken: appConfig.AozoraAccessToken,
+		AccountID:   appConfig.AozoraAccountID,
+		Timeout:     time.Duration(appConfig.AozoraAPITimeoutSeconds) * time.Second,
+	})
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// Keep other processes from running the command at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, "aozora_submit_payouts")
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Initialize repositories
+	payoutRepo := payoutPersistence.NewPayoutRepository(batchService.DB)
+	payoutRecordRepo := payoutPersistence.NewPayoutRecordRepository(batchService.DB)
+
+	// Initialize usecases
+	payoutUC := payoutUsecase.NewPayoutUsecase(payoutRepo, payoutRecordRepo)
+
+	// Initialize domain services
+	transferStatusService := service.NewTransferStatusService()
+
+	// Initialize tasks
+	workerPoolTask := sharedTask.NewWorkerPoolTask(workers, logger)
+	recordSubmitTask := task.NewSubmitPayoutRecordTask(bankClient, transferStatusService, payoutUC, dryRun, logger)
+	payoutSubmitTask := task.NewSubmitPayoutTask(payoutUC, workerPoolTask, recordSubmitTask, dryRun, logger)
+
+	// Start the submit process
+	start := time.Now()
+
+	payouts, err := payoutUC.FindPayoutsToSubmit(ctx, start)
+	if err != nil {
+		logger.Error("Failed to find payouts to submit:", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, payout := range payouts {
+		if commandLease.Lost() {
+			logger.Error("Stopped submitting payouts, the command lease was lost", map[string]any{
+				"payoutID": payout.ID,
+			})
+			return
+		}
+		if err := payoutSubmitTask.Do(ctx, payout); err != nil {
+			logger.Error("Failed to submit payout", map[string]any{
+				"payoutID": payout.ID,
+				"error":    err.Error(),
+			})
+		}
+	}
+
+	log.Printf("AozoraSubmitPayouts job completed in %s, processed %d payouts, %d records (dry-run: %t)",
+		time.Since(start), len(payouts), int(*workerPoolTask.ProcessedCount), dryRun)
+}
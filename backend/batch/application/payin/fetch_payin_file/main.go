@@ -0,0 +1,298 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	remoteAdapter "github.com/huydq/test/batch/infrastructure/adapter/remote"
+	remoteImpl "github.com/huydq/test/batch/infrastructure/adapter/remote"
+	storageAdapter "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	storageImpl "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	"github.com/huydq/test/batch/infrastructure/container"
+	payinPersistence "github.com/huydq/test/batch/infrastructure/persistence/payin"
+	"github.com/huydq/test/batch/provider"
+	task "github.com/huydq/test/batch/task/payin/fetch_payin_file"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// Execute runs the upload process for the payin reports of the provider from its remote server to S3
+// When resumeGroupID is set, the files of that group which are still pending or have failed are fetched again instead
+// of starting a new group.
+func Execute(payinProvider provider.Provider, workers int, fileLoadSizePerStream int, targetDate string, resumeGroupID int) {
+	commandName := provider.CommandName(payinProvider, "fetch_payin_file")
+	log.Printf("======= Start UploadPayinFileToS3 Shell (%s) =======", payinProvider.Code())
+	defer log.Printf("======= Stop UploadPayinFileToS3 Shell (%s) =======", payinProvider.Code())
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize batch container: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// Keep other processes from running the command at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, commandName)
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Record the run in the batch job run history
+	jobRun := batchService.StartJobRun(ctx, commandName, map[string]string{
+		"provider":              payinProvider.Code(),
+		"workers":               strconv.Itoa(workers),
+		"fileLoadSizePerStream": strconv.Itoa(fileLoadSizePerStream),
+		"targetDate":            targetDate,
+		"resume":                strconv.Itoa(resumeGroupID),
+	})
+	var runErr error
+	processedCount := 0
+	defer func() {
+		jobRun.Finish(ctx, processedCount, runErr)
+	}()
+
+	// Parse SSH port
+	port, err := strconv.Atoi(appConfig.SSHPort)
+	if err != nil {
+		logger.Error("Invalid SSH port:", map[string]any{
+			"error": err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Initialize SSH client
+	sshConfig := remoteAdapter.SSHConfig{
+		User:                 appConfig.SSHUser,
+		Host:                 appConfig.SSHHost,
+		Port:                 port,
+		Password:             appConfig.SSHPassword,
+		PrivateKeyPath:       appConfig.SSHPrivateKeyPath,
+		PrivateKeyPassphrase: appConfig.SSHPrivateKeyPassphrase,
+		KnownHostsPath:       appConfig.SSHKnownHostsPath,
+		Timeout:              time.Duration(appConfig.SSHTimeoutSeconds) * time.Second,
+	}
+	sshClient := remoteImpl.NewSFTPClient(sshConfig)
+	defer sshClient.Close()
+
+	// Initialize object storage (S3 or local directory)
+	storageConfig := storageAdapter.StorageConfig{
+		Backend: appConfig.StorageBackend,
+		S3: storageAdapter.S3Config{
+			Bucket:          appConfig.S3Bucket,
+			Region:          appConfig.S3Region,
+			AccessKeyID:     appConfig.AwsAccessKeyID,
+			SecretAccessKey: appConfig.AwsSecretAccessKey,
+		},
+		Local: storageAdapter.LocalConfig{
+			RootDir: appConfig.LocalStorageDir,
+			Bucket:  appConfig.S3Bucket,
+		},
+	}
+	storageClient, err := storageImpl.NewStorageService(storageConfig)
+	if err != nil {
+		logger.Error("Failed to initialize storage:", map[string]any{
+			"backend": appConfig.StorageBackend,
+			"error":   err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Report folders to fetch from the remote server
+	reportFolders, err := payinProvider.Layout(appConfig)
+	if err != nil {
+		logger.Error("Invalid report folders:", map[string]any{
+			"provider": payinProvider.Code(),
+			"error":    err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Initialize repositories and usecases
+	fileRepo := payinPersistence.NewPayinFileRepository(batchService.DB)
+	fileGroupRepo := payinPersistence.NewPayinFileGroupRepository(batchService.DB)
+	paymentProviderRepo := payinPersistence.NewPaymentProviderRepository(batchService.DB)
+	fileUC := payinUsecase.NewPayinFileUsecase(fileRepo)
+	fileGroupUC := payinUsecase.NewPayinFileGroupUsecase(fileGroupRepo)
+	paymentProviderUC := payinUsecase.NewPaymentProviderUsecase(paymentProviderRepo)
+
+	// The file groups and files are recorded under the ID of the provider in the payment_provider table
+	paymentProvider, err := paymentProviderUC.GetByCode(ctx, payinProvider.Code())
+	if err != nil {
+		logger.Error("Failed to load payment provider:", map[string]any{
+			"provider": payinProvider.Code(),
+			"error":    err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Start timing the process
+	start := time.Now()
+
+	// Create the file group, or load the one to resume
+	var group *payinModel.PayinFileGroup
+	if resumeGroupID > 0 {
+		loadGroupTask := task.NewLoadFileGroupTask(fileGroupUC, paymentProvider.ID)
+		group, err = loadGroupTask.Do(ctx, resumeGroupID)
+		if err != nil {
+			logger.Error("Failed to load file group:", map[string]any{
+				"groupID": resumeGroupID,
+				"error":   err.Error(),
+			})
+			runErr = err
+			return
+		}
+		if group.IsImported() {
+			log.Printf("File group %d is already complete, nothing to resume", group.ID)
+			return
+		}
+		// The files are listed again for the date of the group when the previous run stopped while listing
+		targetDate = group.ImportTargetDate.Format(remoteAdapter.TargetDateLayout)
+	} else {
+		importTargetDate, err := time.ParseInLocation(remoteAdapter.TargetDateLayout, targetDate, time.Local)
+		if err != nil {
+			logger.Error("Invalid target date:", map[string]any{
+				"targetDate": targetDate,
+				"error":      err.Error(),
+			})
+			runErr = err
+			return
+		}
+		createGroupTask := task.NewCreateFileGroupTask(fileGroupUC, paymentProvider.ID)
+		group, err = createGroupTask.Do(ctx, importTargetDate)
+		if err != nil {
+			logger.Error("Failed to create file group:", map[string]any{
+				"error": err.Error(),
+			})
+			runErr = err
+			return
+		}
+	}
+	log.Printf("Fetching files of file group %d", group.ID)
+
+	// Register the remote files of the group as pending until the listing completes
+	if !group.IsListed() {
+		streamTask := task.NewStreamRemoteFilesTask(sshClient, targetDate, reportFolders.RemoteFolders())
+		remoteFiles, err := streamTask.Do(ctx, reportFolders.RemoteDir, fileLoadSizePerStream)
+		if err != nil {
+			logger.Error("Failed to stream remote files:", map[string]any{
+				"error": err.Error(),
+			})
+			runErr = err
+			return
+		}
+
+		registerTask := task.NewRegisterRemoteFilesTask(fileUC, fileGroupUC)
+		registered, err := registerTask.Do(ctx, group, remoteFiles)
+		if err != nil {
+			logger.Error("Failed to register remote files:", map[string]any{
+				"groupID": group.ID,
+				"error":   err.Error(),
+			})
+			log.Printf("File group %d is not listed, rerun with --resume %d to list it again", group.ID, group.ID)
+			runErr = err
+			return
+		}
+		log.Printf("Registered %d remote files in file group %d", registered, group.ID)
+	}
+
+	// Fetch the files of the group that are still pending or have failed
+	listTask := task.NewListUnfetchedFilesTask(fileUC, reportFolders.RemoteDir)
+	pendingFiles, pendingCount, err := listTask.Do(ctx, group.ID)
+	if err != nil {
+		logger.Error("Failed to list pending files:", map[string]any{
+			"groupID": group.ID,
+			"error":   err.Error(),
+		})
+		runErr = err
+		return
+	}
+	log.Printf("%d files to fetch in file group %d", pendingCount, group.ID)
+
+	// Initialize worker pool
+	workerPoolTask := task.NewWorkerPoolTask(workers, logger)
+
+	// Process function for each file
+	processFile := func(ctx context.Context, fileInfo task.RemoteFileInfo) error {
+		// Skip a file that another process is writing or importing
+		storageKey := storageClient.GetS3KeyFromRemotePath(fileInfo.RemotePath, fileInfo.LocalPath)
+		fileLease, err := batchService.JobLeases.Acquire(ctx, batchJobUsecase.FileLeaseKey(storageKey))
+		if errors.Is(err, batchJobUsecase.ErrLeaseHeld) {
+			logger.Warn("Skipping file locked by another process", map[string]any{
+				"remotePath": fileInfo.RemotePath,
+				"reason":     err.Error(),
+			})
+			return nil
+		}
+		if err != nil {
+			jobRun.RecordFailure(fileInfo.RemotePath, err)
+			return err
+		}
+		defer fileLease.Release(ctx)
+
+		processTask := task.NewProcessFileTask(
+			fileUC,
+			storageClient,
+			sshClient,
+			fileInfo.File,
+			fileInfo.LocalPath,
+			jobRun,
+		)
+		if err := processTask.Do(fileLease.Context()); err != nil {
+			jobRun.RecordFailure(fileInfo.RemotePath, err)
+			return err
+		}
+		return nil
+	}
+
+	// Process all files using worker pool
+	processedCount = workerPoolTask.ProcessRemoteFiles(ctx, pendingFiles, processFile)
+	if commandLease.Lost() {
+		logger.Error("Stopped fetching files, the command lease was lost", map[string]any{
+			"groupID": group.ID,
+		})
+		runErr = context.Cause(ctx)
+		return
+	}
+
+	// Mark the group as imported once all its files are fetched
+	completeTask := task.NewCompleteFileGroupTask(fileUC, fileGroupUC)
+	complete, err := completeTask.Do(ctx, group)
+	if err != nil {
+		logger.Error("Failed to complete file group:", map[string]any{
+			"groupID": group.ID,
+			"error":   err.Error(),
+		})
+		runErr = err
+		return
+	}
+	if !complete {
+		log.Printf("File group %d is incomplete, rerun with --resume %d to retry the failed files", group.ID, group.ID)
+	}
+
+	log.Printf("Job completed in %s, total processed files: %d", time.Since(start), processedCount)
+}
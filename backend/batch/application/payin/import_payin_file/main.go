@@ -0,0 +1,296 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/huydq/test/batch/infrastructure/adapter/storage"
+	storageImpl "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	"github.com/huydq/test/batch/infrastructure/container"
+	payinPersistence "github.com/huydq/test/batch/infrastructure/persistence/payin"
+	"github.com/huydq/test/batch/provider"
+	task "github.com/huydq/test/batch/task/payin/import_payin_file"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+var (
+	errPayinFileNotFound        = errors.New("payin file not found")
+	errPayinFileNotReimported   = errors.New("payin file is not in an imported folder")
+	errPayinFileOfOtherProvider = errors.New("payin file is of another payment provider")
+)
+
+// Execute runs the import process for the payin reports of the provider. When reimportPayinFileID is given only that
+// payin file is imported again, replacing the rows imported from it, and the reimport is recorded as reimportUserID
+// when given.
+func Execute(payinProvider provider.Provider, readers, filesLoadPerStream, lineOfDataReadPerStream, reimportPayinFileID, reimportUserID int) {
+	commandName := provider.CommandName(payinProvider, "import_payin_file")
+	log.Printf("======= Start ImportPayinData Shell (%s) =======", payinProvider.Code())
+	defer log.Printf("======= Stop ImportPayinData Shell (%s) =======", payinProvider.Code())
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize DB: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// Keep other processes from running the command at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, commandName)
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Record the run in the batch job run history
+	jobRun := batchService.StartJobRun(ctx, commandName, map[string]string{
+		"provider":                 payinProvider.Code(),
+		"readers":                  strconv.Itoa(readers),
+		"fileLoadPerStream":        strconv.Itoa(filesLoadPerStream),
+		"lineOfDataReadePerStream": strconv.Itoa(lineOfDataReadPerStream),
+		"reimport":                 strconv.Itoa(reimportPayinFileID),
+		"user":                     strconv.Itoa(reimportUserID),
+	})
+	var runErr error
+	processedCount := 0
+	defer func() {
+		jobRun.Finish(ctx, processedCount, runErr)
+	}()
+
+	storageConfig := storage.StorageConfig{
+		Backend: appConfig.StorageBackend,
+		S3: storage.S3Config{
+			Bucket:          appConfig.S3Bucket,
+			Region:          appConfig.S3Region,
+			AccessKeyID:     appConfig.AwsAccessKeyID,
+			SecretAccessKey: appConfig.AwsSecretAccessKey,
+		},
+		Local: storage.LocalConfig{
+			RootDir: appConfig.LocalStorageDir,
+			Bucket:  appConfig.S3Bucket,
+		},
+	}
+	storageClient, err := storageImpl.NewStorageService(storageConfig)
+	if err != nil {
+		logger.Error("Failed to initialize storage:", map[string]any{
+			"backend": appConfig.StorageBackend,
+			"error":   err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Report folders to import and the type of the reports in them
+	reportFolders, err := payinProvider.Layout(appConfig)
+	if err != nil {
+		logger.Error("Invalid report folders:", map[string]any{
+			"provider": payinProvider.Code(),
+			"error":    err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// What happens to files with rows that cannot be imported as is
+	importErrorPolicy, err := payinObject.ParsePayinImportErrorPolicy(appConfig.PayinImportErrorPolicy)
+	if err != nil {
+		logger.Error("Invalid payin import error policy:", map[string]any{
+			"error": err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Initialize repositories and usecases
+	payinFileRepo := payinPersistence.NewPayinFileRepository(batchService.DB)
+	payinImportErrorRepo := payinPersistence.NewPayinImportErrorRepository(batchService.DB)
+	paymentProviderRepo := payinPersistence.NewPaymentProviderRepository(batchService.DB)
+	payinFileUC := payinUsecase.NewPayinFileUsecase(payinFileRepo)
+	importErrorUC := payinUsecase.NewPayinImportErrorUsecase(payinImportErrorRepo, importErrorPolicy)
+	paymentProviderUC := payinUsecase.NewPaymentProviderUsecase(paymentProviderRepo)
+
+	// The payin files of the provider are recorded under its ID in the payment_provider table
+	paymentProvider, err := paymentProviderUC.GetByCode(ctx, payinProvider.Code())
+	if err != nil {
+		logger.Error("Failed to load payment provider:", map[string]any{
+			"provider": payinProvider.Code(),
+			"error":    err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Initialize the importers of the provider
+	payinImporter, err := payinProvider.NewImporter(ctx, provider.ImportEnv{
+		BatchService:  batchService,
+		StorageClient: storageClient,
+		ReportFolders: reportFolders,
+		PayinFileUC:   payinFileUC,
+		ImportErrorUC: importErrorUC,
+		JobRun:        jobRun,
+	})
+	if err != nil {
+		logger.Error("Failed to initialize importers:", map[string]any{
+			"provider": payinProvider.Code(),
+			"error":    err.Error(),
+		})
+		runErr = err
+		return
+	}
+	log.Printf("Importing %s reports into the tables by file type: %v", payinProvider.Code(), payinProvider.TargetTables())
+
+	// Initialize tasks
+	filterTask := task.NewFilterS3KeysTask(reportFolders)
+
+	// Files imported out of order are reconciled once the import is done
+	defer payinImporter.Finish(ctx)
+
+	if reimportPayinFileID > 0 {
+		runErr = reimportPayinFile(ctx, batchService, payinFileUC, payinImporter, storageClient, appConfig.RemoteDir, paymentProvider.ID, reimportPayinFileID, reimportUserID)
+		if runErr == nil {
+			processedCount = 1
+		}
+		return
+	}
+
+	// Initialize worker pool task
+	workerPoolTask := task.NewWorkerPoolTask(readers, logger)
+
+	// Start the import process
+	start := time.Now()
+
+	// Stream keys from storage
+	keys, err := storageClient.StreamKeys(ctx, appConfig.S3Bucket)
+	if err != nil {
+		logger.Error("Failed to stream keys from storage:", map[string]any{
+			"error": err.Error(),
+		})
+		runErr = err
+		return
+	}
+
+	// Process files using worker pool with explicitly typed functions
+	processFile := func(ctx context.Context, key string) (bool, error) {
+		// Skip a file that another process is writing or importing
+		fileLease, err := batchService.JobLeases.Acquire(ctx, batchJobUsecase.FileLeaseKey(key))
+		if errors.Is(err, batchJobUsecase.ErrLeaseHeld) {
+			logger.Warn("Skipping file locked by another process", map[string]any{
+				"key":    key,
+				"reason": err.Error(),
+			})
+			return false, nil
+		}
+		if err != nil {
+			jobRun.RecordFailure(key, err)
+			return false, err
+		}
+		defer fileLease.Release(ctx)
+
+		processed, err := payinImporter.ImporterFor(key).Do(fileLease.Context(), key)
+		if err != nil {
+			jobRun.RecordFailure(key, err)
+		}
+		return processed, err
+	}
+	processedCount = workerPoolTask.ProcessS3Keys(
+		ctx,
+		keys,
+		filterTask.Do, // S3KeyFilterFunc - filters keys based on folder and extension
+		processFile,   // ZipFileProcessFunc - processes zip files containing CSV data and invoice PDFs
+	)
+	if commandLease.Lost() {
+		logger.Error("Stopped importing files, the command lease was lost", map[string]any{
+			"processed": processedCount,
+		})
+		runErr = context.Cause(ctx)
+		return
+	}
+
+	log.Printf("ImportPayinData job completed in %s, processed %d files", time.Since(start), processedCount)
+}
+
+// reimportPayinFile imports the payin file with the ID again under the lease of its storage key; the file must be one
+// of the provider being imported
+func reimportPayinFile(
+	ctx context.Context,
+	batchService *container.BatchService,
+	payinFileUC *payinUsecase.PayinFileUsecase,
+	payinImporter provider.Importer,
+	storageClient storage.StorageService,
+	remoteDir string,
+	paymentProviderID int,
+	payinFileID int,
+	userID int,
+) error {
+	logger := batchService.Logger
+
+	payinFile, err := payinFileUC.GetByID(ctx, payinFileID)
+	if err != nil {
+		logger.Error("Failed to load payin file to reimport:", map[string]any{
+			"payinFileID": payinFileID,
+			"error":       err.Error(),
+		})
+		return err
+	}
+	if payinFile == nil {
+		logger.Error("Payin file to reimport not found:", map[string]any{
+			"payinFileID": payinFileID,
+		})
+		return errPayinFileNotFound
+	}
+	if payinFile.PaymentProviderID != paymentProviderID {
+		logger.Error("Payin file to reimport is of another provider:", map[string]any{
+			"payinFileID":       payinFileID,
+			"paymentProviderID": payinFile.PaymentProviderID,
+		})
+		return errPayinFileOfOtherProvider
+	}
+
+	key := storageClient.GetS3KeyFromRemotePath(payinFile.FileContentKey, remoteDir)
+	fileLease, err := batchService.JobLeases.Acquire(ctx, batchJobUsecase.FileLeaseKey(key))
+	if err != nil {
+		logger.Error("Failed to lock payin file to reimport:", map[string]any{
+			"key":   key,
+			"error": err.Error(),
+		})
+		return err
+	}
+	defer fileLease.Release(ctx)
+
+	var auditUserID *int
+	if userID > 0 {
+		auditUserID = &userID
+	}
+	processed, err := payinImporter.ImporterFor(key).Reimport(fileLease.Context(), key, payinFile, auditUserID)
+	if err != nil {
+		return err
+	}
+	if !processed {
+		logger.Warn("Payin file was not reimported:", map[string]any{
+			"key": key,
+		})
+		return errPayinFileNotReimported
+	}
+	log.Printf("Reimported payin file %d from %s", payinFileID, key)
+	return nil
+}
@@ -0,0 +1,129 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	payoutService "github.com/huydq/test/batch/domain/service/payout"
+	"github.com/huydq/test/batch/infrastructure/container"
+	merchantPersistence "github.com/huydq/test/batch/infrastructure/persistence/merchant"
+	payoutPersistence "github.com/huydq/test/batch/infrastructure/persistence/payout"
+	paypayPersistence "github.com/huydq/test/batch/infrastructure/persistence/paypay"
+	transactionPersistence "github.com/huydq/test/batch/infrastructure/persistence/transaction"
+	task "github.com/huydq/test/batch/task/payout/aggregate_payin"
+	merchantUsecase "github.com/huydq/test/batch/usecase/merchant"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
+	transactionUsecase "github.com/huydq/test/batch/usecase/transaction"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// Execute aggregates the payin details of the cutoff date into transactions and a draft payout
+func Execute(cutoffDate, sendingDate string) {
+	log.Println("======= Start AggregatePayin Shell =======")
+	defer log.Println("======= Stop AggregatePayin Shell =======")
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize DB: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	cutoff, err := parseDate(cutoffDate)
+	if err != nil {
+		logger.Error("Invalid cutoff date:", map[string]any{
+			"cutoffDate": cutoffDate,
+			"error":      err.Error(),
+		})
+		return
+	}
+	sending, err := parseDate(sendingDate)
+	if err != nil {
+		logger.Error("Invalid sending date:", map[string]any{
+			"sendingDate": sendingDate,
+			"error":       err.Error(),
+		})
+		return
+	}
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// Keep other processes from running the command at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, "payout_aggregate_payin")
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Initialize repositories
+	paypayPayinDetailRepo := paypayPersistence.NewPayinDetailRepository(batchService.DB)
+	merchantRepo := merchantPersistence.NewMerchantRepository(batchService.DB)
+	merchantBankAccountRepo := merchantPersistence.NewMerchantBankAccountRepository(batchService.DB)
+	transactionRepo := transactionPersistence.NewTransactionRepository(batchService.DB)
+	transactionRecordRepo := transactionPersistence.NewTransactionRecordRepository(batchService.DB)
+	payoutRepo := payoutPersistence.NewPayoutRepository(batchService.DB)
+	payoutRecordRepo := payoutPersistence.NewPayoutRecordRepository(batchService.DB)
+
+	// Initialize usecases
+	detailUC := paypayUsecase.NewPayinDetailUsecase(paypayPayinDetailRepo, logger)
+	merchantUC := merchantUsecase.NewMerchantUsecase(merchantRepo, merchantBankAccountRepo)
+	transactionUC := transactionUsecase.NewTransactionUsecase(transactionRepo, transactionRecordRepo)
+	payoutUC := payoutUsecase.NewPayoutUsecase(payoutRepo, payoutRecordRepo)
+
+	// Initialize domain services
+	payinAggregationService := payoutService.NewPayinAggregationService(float64(appConfig.PayoutTransferFee))
+
+	// Initialize tasks
+	aggregateTask := task.NewAggregatePayinTask(
+		detailUC,
+		merchantUC,
+		transactionUC,
+		payoutUC,
+		payinAggregationService,
+		appConfig.ProviderID,
+		appConfig.PayoutBatchUserID,
+		logger,
+	)
+
+	// Start the aggregation process
+	start := time.Now()
+
+	payout, err := aggregateTask.Do(ctx, cutoff, sending)
+	if err != nil {
+		logger.Error("Failed to aggregate payin details:", map[string]any{
+			"cutoffDate": cutoffDate,
+			"error":      err.Error(),
+		})
+		return
+	}
+	if payout == nil {
+		log.Printf("AggregatePayin job completed in %s, nothing to pay out", time.Since(start))
+		return
+	}
+
+	log.Printf("AggregatePayin job completed in %s, created draft payout %d with %d records", time.Since(start), payout.ID, payout.TotalCount)
+}
+
+// parseDate parses a YYYY-MM-DD date in local time; an empty value means today
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local), nil
+	}
+	return time.ParseInLocation(time.DateOnly, value, time.Local)
+}
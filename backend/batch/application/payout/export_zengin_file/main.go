@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/huydq/test/batch/infrastructure/container"
+	payoutPersistence "github.com/huydq/test/batch/infrastructure/persistence/payout"
+	task "github.com/huydq/test/batch/task/payout/export_zengin_file"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// Execute exports the Zengin transfer file of a payout
+func Execute(payoutID int, outputDir string) {
+	log.Println("======= Start ExportZenginFile Shell =======")
+	defer log.Println("======= Stop ExportZenginFile Shell =======")
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize DB: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// Keep other processes from running the command at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, "payout_export_zengin_file")
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Initialize repositories
+	payoutRepo := payoutPersistence.NewPayoutRepository(batchService.DB)
+	payoutRecordRepo := payoutPersistence.NewPayoutRecordRepository(batchService.DB)
+
+	// Initialize usecases
+	payoutUC := payoutUsecase.NewPayoutUsecase(payoutRepo, payoutRecordRepo)
+
+	// Initialize domain services
+	zenginTransferFileService := service.NewZenginTransferFileService(service.ZenginRequester{
+		Code:        appConfig.ZenginRequesterCode,
+		Name:        appConfig.ZenginRequesterName,
+		BankCode:    appConfig.ZenginBankCode,
+		BankName:    appConfig.ZenginBankName,
+		BranchCode:  appConfig.ZenginBranchCode,
+		BranchName:  appConfig.ZenginBranchName,
+		AccountType: object.BankAccountType(appConfig.ZenginAccountType),
+		AccountNo:   appConfig.ZenginAccountNo,
+	})
+
+	// Initialize tasks
+	exportTask := task.NewExportZenginFileTask(payoutUC, zenginTransferFileService, outputDir, logger)
+
+	// Start the export process
+	start := time.Now()
+
+	path, err := exportTask.Do(ctx, payoutID)
+	if err != nil {
+		logger.Error("Failed to export zengin file:", map[string]any{
+			"payoutID": payoutID,
+			"error":    err.Error(),
+		})
+		return
+	}
+
+	log.Printf("ExportZenginFile job completed in %s, exported %s", time.Since(start), path)
+}
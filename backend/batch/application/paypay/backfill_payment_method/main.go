@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	"github.com/huydq/test/batch/infrastructure/container"
+	paypayPersistence "github.com/huydq/test/batch/infrastructure/persistence/paypay"
+	task "github.com/huydq/test/batch/task/paypay/backfill_payment_method"
+	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// Execute sets the payment method of the imported PayPay transactions and transaction details from their labels
+func Execute(dryRun bool) {
+	log.Println("======= Start BackfillPaymentMethod Shell =======")
+	defer log.Println("======= Stop BackfillPaymentMethod Shell =======")
+
+	// Initialize batch container and services
+	batchService, err := container.NewBatchContainer()
+	if err != nil {
+		log.Fatalf("Failed to initialize DB: %v", err)
+	}
+	defer batchService.Close()
+
+	appConfig := batchService.AppConfig
+	logger := batchService.Logger
+
+	// Labels of the payment methods added to the default ones
+	paymentMethodAliases, err := paypayService.ParsePaymentMethodAliases(appConfig.PaypayPaymentMethodAliases)
+	if err != nil {
+		logger.Error("Invalid payment method aliases:", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// Setup context with DB
+	ctx := context.Background()
+	ctx, dbSetErr := database.SetDB(ctx, batchService.DB)
+	if dbSetErr != nil {
+		logger.Error("Failed to set DB in context:", map[string]any{
+			"error": dbSetErr.Error(),
+		})
+		return
+	}
+
+	// The import updates the same rows, so it must not run at the same time
+	commandLease, ok := batchService.AcquireCommandLease(ctx, "paypay_import_payin_file")
+	if !ok {
+		return
+	}
+	defer commandLease.Release(ctx)
+	// Stop the work once the lease is lost to another process
+	ctx = commandLease.Context()
+
+	// Initialize repositories
+	paypayPayinTransactionRepo := paypayPersistence.NewPayinTransactionRepository(batchService.DB)
+	paypayTransactionDetailRepo := paypayPersistence.NewTransactionDetailRepository(batchService.DB)
+
+	// Initialize domain services
+	paymentMethodMappingService := paypayService.NewPaymentMethodMappingService(paymentMethodAliases)
+
+	// Initialize usecases
+	backfillUC := paypayUsecase.NewPaymentMethodBackfillUsecase(
+		paypayPayinTransactionRepo,
+		paypayTransactionDetailRepo,
+		paymentMethodMappingService,
+		logger,
+	)
+
+	// Initialize tasks
+	backfillTask := task.NewBackfillPaymentMethodTask(backfillUC, dryRun, logger)
+
+	// Start the backfill
+	start := time.Now()
+
+	result, err := backfillTask.Do(ctx)
+	if err != nil {
+		logger.Error("Failed to backfill payment methods:", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	log.Printf("BackfillPaymentMethod job completed in %s, %d rows updated, %d labels unmapped, %d payin files to reimport", time.Since(start), result.Updated, len(result.Unmapped), len(result.UnlabeledPayinFileIDs))
+}
@@ -0,0 +1,25 @@
+package command
+
+import (
+	application "github.com/huydq/test/batch/application/aozora/poll_transfer_status"
+	"github.com/spf13/cobra"
+)
+
+var pollWorkers int
+var pollDryRun bool
+
+var aozoraPollTransferStatus = &cobra.Command{
+	Use:   "aozora_poll_transfer_status",
+	Short: "run aozora_poll_transfer_status Shell batch job",
+	Long:  "run aozora_poll_transfer_status Shell batch job for polling Aozora Net Bank for requested transfers and reconciling them with the payouts",
+	Run: func(batch *cobra.Command, args []string) {
+		application.Execute(pollWorkers, pollDryRun)
+	},
+}
+
+func InitAozoraPollTransferStatusBatch(rootBatch *cobra.Command) {
+	aozoraPollTransferStatus.Flags().IntVarP(&pollWorkers, "workers", "w", 5, "number of concurrent workers")
+	aozoraPollTransferStatus.Flags().BoolVarP(&pollDryRun, "dryRun", "d", false, "log the status changes without updating the DB")
+
+	rootBatch.AddCommand(aozoraPollTransferStatus)
+}
@@ -0,0 +1,25 @@
+package command
+
+import (
+	application "github.com/huydq/test/batch/application/aozora/submit_payouts"
+	"github.com/spf13/cobra"
+)
+
+var submitWorkers int
+var submitDryRun bool
+
+var aozoraSubmitPayouts = &cobra.Command{
+	Use:   "aozora_submit_payouts",
+	Short: "run aozora_submit_payouts Shell batch job",
+	Long:  "run aozora_submit_payouts Shell batch job for submitting approved payouts scheduled for today to Aozora Net Bank",
+	Run: func(batch *cobra.Command, args []string) {
+		application.Execute(submitWorkers, submitDryRun)
+	},
+}
+
+func InitAozoraSubmitPayoutsBatch(rootBatch *cobra.Command) {
+	aozoraSubmitPayouts.Flags().IntVarP(&submitWorkers, "workers", "w", 5, "number of concurrent workers")
+	aozoraSubmitPayouts.Flags().BoolVarP(&submitDryRun, "dryRun", "d", false, "log the transfers that would be requested without calling the bank or updating the DB")
+
+	rootBatch.AddCommand(aozoraSubmitPayouts)
+}
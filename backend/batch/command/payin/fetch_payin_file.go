@@ -0,0 +1,40 @@
+package command
+
+import (
+	"strings"
+	"time"
+
+	application "github.com/huydq/test/batch/application/payin/fetch_payin_file"
+	"github.com/spf13/cobra"
+)
+
+var fetchProviderCode string
+var workers int
+var fileLoadSizePerStream int
+var targetDate string
+var resumeGroupID int
+
+var fetchPayinFile = &cobra.Command{
+	Use:     "payin_fetch_file",
+	Aliases: []string{"paypay_fetch_payin_file"},
+	Short:   "run payin_fetch_file Shell batch job",
+	Long:    "run payin_fetch_file Shell batch job for fetching the payin data of a payment provider from its server to Storage",
+	RunE: func(batch *cobra.Command, args []string) error {
+		payinProvider, err := providers.Lookup(fetchProviderCode)
+		if err != nil {
+			return err
+		}
+		application.Execute(payinProvider, workers, fileLoadSizePerStream, targetDate, resumeGroupID)
+		return nil
+	},
+}
+
+func InitFetchPayinFileBatch(rootBatch *cobra.Command) {
+	fetchPayinFile.Flags().StringVarP(&fetchProviderCode, "provider", "p", defaultProviderCode, "payment provider to fetch the payin data of: "+strings.Join(providers.Codes(), ", "))
+	fetchPayinFile.Flags().IntVarP(&workers, "workers", "w", 5, "number of concurrent workers")
+	fetchPayinFile.Flags().IntVarP(&fileLoadSizePerStream, "fileLoadSizePerStream", "f", 10, "number of files to load per stream")
+	fetchPayinFile.Flags().StringVarP(&targetDate, "targetDate", "t", time.Now().Format("20060102"), "target date in format: yyyymmdd")
+	fetchPayinFile.Flags().IntVarP(&resumeGroupID, "resume", "r", 0, "file group ID to resume, retrying only its pending and failed files")
+
+	rootBatch.AddCommand(fetchPayinFile)
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"strings"
+
+	application "github.com/huydq/test/batch/application/payin/import_payin_file"
+	"github.com/spf13/cobra"
+)
+
+var importProviderCode string
+var readers int
+var filesLoadPerStream int
+var lineOfDataReadPerStream int
+var reimportPayinFileID int
+var reimportUserID int
+
+var importPayinFile = &cobra.Command{
+	Use:     "payin_import_file",
+	Aliases: []string{"paypay_import_payin_file"},
+	Short:   "run payin_import_file Shell batch job",
+	Long:    "run payin_import_file Shell batch job for importing the payin data of a payment provider from Storage to DB",
+	RunE: func(batch *cobra.Command, args []string) error {
+		payinProvider, err := providers.Lookup(importProviderCode)
+		if err != nil {
+			return err
+		}
+		application.Execute(payinProvider, readers, filesLoadPerStream, lineOfDataReadPerStream, reimportPayinFileID, reimportUserID)
+		return nil
+	},
+}
+
+func InitImportPayinFileBatch(rootBatch *cobra.Command) {
+	importPayinFile.Flags().StringVarP(&importProviderCode, "provider", "p", defaultProviderCode, "payment provider to import the payin data of: "+strings.Join(providers.Codes(), ", "))
+	importPayinFile.Flags().IntVarP(&readers, "readers", "r", 5, "number of concurrent readers")
+	importPayinFile.Flags().IntVarP(&filesLoadPerStream, "fileLoadPerStream", "f", 10, "number of files to load per stream")
+	importPayinFile.Flags().IntVarP(&lineOfDataReadPerStream, "lineOfDataReadePerStream", "l", 100, "number of files to load per stream")
+	importPayinFile.Flags().IntVar(&reimportPayinFileID, "reimport", 0, "payin file ID to import again, replacing the rows imported from it")
+	importPayinFile.Flags().IntVar(&reimportUserID, "user", 0, "ID of the user the reimport is recorded as in the audit log")
+
+	rootBatch.AddCommand(importPayinFile)
+}
@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/huydq/test/batch/provider"
+	paypayProvider "github.com/huydq/test/batch/provider/paypay"
+)
+
+// providers are the payment providers the payin commands can be run for with --provider
+var providers = provider.NewRegistry(
+	paypayProvider.NewProvider(),
+)
+
+// defaultProviderCode is the provider of the commands run without --provider, and of their former paypay_ names
+const defaultProviderCode = "paypay"
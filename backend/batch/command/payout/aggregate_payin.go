@@ -0,0 +1,25 @@
+package command
+
+import (
+	application "github.com/huydq/test/batch/application/payout/aggregate_payin"
+	"github.com/spf13/cobra"
+)
+
+var cutoffDate string
+var sendingDate string
+
+var aggregatePayin = &cobra.Command{
+	Use:   "payout_aggregate_payin",
+	Short: "run payout_aggregate_payin Shell batch job",
+	Long:  "run payout_aggregate_payin Shell batch job for aggregating payin details of a cutoff date into transactions and a draft payout",
+	Run: func(batch *cobra.Command, args []string) {
+		application.Execute(cutoffDate, sendingDate)
+	},
+}
+
+func InitAggregatePayinBatch(rootBatch *cobra.Command) {
+	aggregatePayin.Flags().StringVarP(&cutoffDate, "cutoffDate", "c", "", "cutoff date of the payin details to aggregate (YYYY-MM-DD, defaults to today)")
+	aggregatePayin.Flags().StringVarP(&sendingDate, "sendingDate", "s", "", "date the payout is scheduled to be sent (YYYY-MM-DD, defaults to today)")
+
+	rootBatch.AddCommand(aggregatePayin)
+}
@@ -0,0 +1,26 @@
+package command
+
+import (
+	application "github.com/huydq/test/batch/application/payout/export_zengin_file"
+	"github.com/spf13/cobra"
+)
+
+var payoutID int
+var outputDir string
+
+var exportZenginFile = &cobra.Command{
+	Use:   "payout_export_zengin_file",
+	Short: "run payout_export_zengin_file Shell batch job",
+	Long:  "run payout_export_zengin_file Shell batch job for exporting an approved payout as a Shift_JIS Zengin transfer file",
+	Run: func(batch *cobra.Command, args []string) {
+		application.Execute(payoutID, outputDir)
+	},
+}
+
+func InitExportZenginFileBatch(rootBatch *cobra.Command) {
+	exportZenginFile.Flags().IntVarP(&payoutID, "payoutId", "p", 0, "ID of the payout to export")
+	exportZenginFile.Flags().StringVarP(&outputDir, "output", "o", ".", "directory to write the zengin file to")
+	_ = exportZenginFile.MarkFlagRequired("payoutId")
+
+	rootBatch.AddCommand(exportZenginFile)
+}
@@ -0,0 +1,23 @@
+package command
+
+import (
+	application "github.com/huydq/test/batch/application/paypay/backfill_payment_method"
+	"github.com/spf13/cobra"
+)
+
+var backfillDryRun bool
+
+var backfillPaypayPaymentMethod = &cobra.Command{
+	Use:   "paypay_backfill_payment_method",
+	Short: "run paypay_backfill_payment_method Shell batch job",
+	Long:  "run paypay_backfill_payment_method Shell batch job for setting the payment method of the imported paypay transactions from their payment method labels. The transactions imported before the labels were kept have no label, the job lists their payin files, which must be imported again with payin_import_file --reimport",
+	Run: func(batch *cobra.Command, args []string) {
+		application.Execute(backfillDryRun)
+	},
+}
+
+func InitBackfillPaypayPaymentMethodBatch(rootBatch *cobra.Command) {
+	backfillPaypayPaymentMethod.Flags().BoolVarP(&backfillDryRun, "dryRun", "d", false, "count the rows that would be updated and log the unmapped labels without updating the DB")
+
+	rootBatch.AddCommand(backfillPaypayPaymentMethod)
+}
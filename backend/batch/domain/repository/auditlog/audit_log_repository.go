@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/audit_log"
+)
+
+type AuditLogRepository interface {
+	// Create records an operation run by a batch in the audit log
+	Create(ctx context.Context, auditLog *model.AuditLog) error
+}
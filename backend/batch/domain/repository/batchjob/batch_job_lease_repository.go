@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+)
+
+type BatchJobLeaseRepository interface {
+	// TryAcquire stores the lease unless another owner holds an unexpired lease on the same key, and reports whether it did
+	TryAcquire(ctx context.Context, lease *model.BatchJobLease, now time.Time) (bool, error)
+
+	// Renew stores the new expiry of a lease still held by its owner, and reports whether it is still held
+	Renew(ctx context.Context, lease *model.BatchJobLease) (bool, error)
+
+	// Release deletes the lease if it is still held by its owner
+	Release(ctx context.Context, lease *model.BatchJobLease) error
+
+	// FindByKey retrieves the lease on key, nil when there is none
+	FindByKey(ctx context.Context, leaseKey string) (*model.BatchJobLease, error)
+}
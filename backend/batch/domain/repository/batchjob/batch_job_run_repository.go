@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+)
+
+type BatchJobRunRepository interface {
+	// Create a new BatchJobRun record and set the generated ID on the model
+	Create(ctx context.Context, run *model.BatchJobRun) error
+
+	// UpdateResult stores the status, end time, counts and error summary of a BatchJobRun record
+	UpdateResult(ctx context.Context, run *model.BatchJobRun) error
+
+	// AddPayinFile links a PayinFile to the run; linking the same file twice is a no-op
+	AddPayinFile(ctx context.Context, runID int, payinFileID int) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/merchant"
+)
+
+type MerchantBankAccountRepository interface {
+	// FindByMerchantIDs returns the active bank account of each merchant keyed by merchant ID
+	FindByMerchantIDs(ctx context.Context, merchantIDs []int) (map[int]*model.MerchantBankAccount, error)
+}
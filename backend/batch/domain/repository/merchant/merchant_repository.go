@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/merchant"
+)
+
+type MerchantRepository interface {
+	// FindByPaymentMerchantIDs lists the merchants of a payment provider by the merchant IDs issued by the provider
+	FindByPaymentMerchantIDs(ctx context.Context, paymentProviderID int, paymentMerchantIDs []string) ([]*model.Merchant, error)
+}
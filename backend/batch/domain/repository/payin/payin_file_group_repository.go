@@ -9,4 +9,10 @@ import (
 type PayinFileGroupRepository interface {
 	//Create methods for PayinFileGroupRepository
 	Create(ctx context.Context, group *model.PayinFileGroup) error
+
+	// FindByID retrieves a PayinFileGroup record by its ID, nil when it does not exist
+	FindByID(ctx context.Context, id int) (*model.PayinFileGroup, error)
+
+	// UpdateCheckpoint stores the listed_at and imported_at checkpoints of a PayinFileGroup record
+	UpdateCheckpoint(ctx context.Context, group *model.PayinFileGroup) error
 }
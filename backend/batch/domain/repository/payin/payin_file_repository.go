@@ -13,9 +13,21 @@ type PayinFileRepository interface {
 	// UpdateStatus updates the status of a PayinFile record
 	UpdateStatus(ctx context.Context, file *model.PayinFile) error
 
+	// UpdateUploadResult stores the statuses, size and checksums of an uploaded PayinFile record
+	UpdateUploadResult(ctx context.Context, file *model.PayinFile) error
+
+	// UpdateEncoding stores the character encoding the content of a PayinFile record was read with
+	UpdateEncoding(ctx context.Context, file *model.PayinFile) error
+
 	// FindByFilename checks if a file exists by its filename and returns its PayinFile model
 	FindByFilename(ctx context.Context, filename string) (*model.PayinFile, error)
 
 	// GetByID retrieves a PayinFile record by its ID
 	GetByID(ctx context.Context, id int) (*model.PayinFile, error)
+
+	// ListUnfetchedByGroupID lists the PayinFile records of a group that are not both downloaded and uploaded
+	ListUnfetchedByGroupID(ctx context.Context, groupID int) ([]*model.PayinFile, error)
+
+	// CountUnfetchedByGroupID counts the PayinFile records of a group that are not both downloaded and uploaded
+	CountUnfetchedByGroupID(ctx context.Context, groupID int) (int64, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+)
+
+type PayinImportErrorRepository interface {
+	// BulkCreate creates the PayinImportError records of a file import
+	BulkCreate(ctx context.Context, importErrors []*model.PayinImportError) error
+
+	// DeleteByPayinFileID deletes the PayinImportError records left by previous imports of a PayinFile
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+)
+
+type PaymentProviderRepository interface {
+	// FindByCode retrieves a PaymentProvider record by its code, nil when it does not exist
+	FindByCode(ctx context.Context, code string) (*model.PaymentProvider, error)
+}
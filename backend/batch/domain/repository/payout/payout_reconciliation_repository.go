@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/payout_reconciliation"
+)
+
+type PayoutReconciliationRepository interface {
+	// Save creates the reconciliation of a payout record, or updates it if the record has already been flagged
+	Save(ctx context.Context, reconciliation *model.PayoutReconciliation) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+)
+
+type PayoutRecordRepository interface {
+	// FindByPayoutID lists the records belonging to a payout
+	FindByPayoutID(ctx context.Context, payoutID int) ([]*model.PayoutRecord, error)
+
+	// FindByTransferStatuses lists the records in any of the given transfer statuses that have been accepted by the bank
+	FindByTransferStatuses(ctx context.Context, statuses []object.TransferStatus) ([]*model.PayoutRecord, error)
+
+	// CreateBatch creates payout records and sets the generated IDs on the models
+	CreateBatch(ctx context.Context, records []*model.PayoutRecord) error
+
+	// UpdateTransferResult updates the transfer status and bank response columns of a payout record
+	UpdateTransferResult(ctx context.Context, record *model.PayoutRecord) error
+}
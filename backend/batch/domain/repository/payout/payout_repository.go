@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payout"
+)
+
+type PayoutRepository interface {
+	// FindByID retrieves a payout by its ID
+	FindByID(ctx context.Context, id int) (*model.Payout, error)
+
+	// FindApprovedBySendingDate lists approved payouts scheduled to be sent on the given date
+	FindApprovedBySendingDate(ctx context.Context, sendingDate time.Time) ([]*model.Payout, error)
+
+	// Create creates a payout and sets the generated ID on the model
+	Create(ctx context.Context, payout *model.Payout) error
+
+	// UpdateStatus updates the status and sent date of a payout
+	UpdateStatus(ctx context.Context, payout *model.Payout) error
+}
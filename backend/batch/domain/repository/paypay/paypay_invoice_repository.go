@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+)
+
+type PaypayInvoiceRepository interface {
+	// Upsert inserts the invoice, updating the invoice already imported from the same payin file
+	Upsert(ctx context.Context, invoice *model.PaypayInvoice) error
+
+	// FindUnlinked lists the invoices with a merchant and cutoff date that are not linked to an existing payin detail
+	// or summary, as the payin reports may be imported after the invoice or imported again
+	FindUnlinked(ctx context.Context) ([]*model.PaypayInvoice, error)
+
+	// UpdateLinks updates the payin detail and summary the invoice is linked to
+	UpdateLinks(ctx context.Context, invoice *model.PaypayInvoice) error
+}
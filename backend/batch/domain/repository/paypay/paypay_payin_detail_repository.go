@@ -2,10 +2,29 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	model "github.com/huydq/test/internal/domain/model/paypay"
 )
 
 type PaypayPayinDetailRepository interface {
-	BulkInsert(ctx context.Context, details []*model.PaypayPayinDetail) error
+	// BulkUpsert inserts the details, updating the existing details of the same merchant and cutoff date. The details
+	// that would overwrite an aggregated detail must be left out, see FindAggregatedByNaturalKeys.
+	BulkUpsert(ctx context.Context, details []*model.PaypayPayinDetail) error
+
+	// DeleteByPayinFileID deletes the details imported from a payin file
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+
+	// FindAggregatedByNaturalKeys finds and locks the existing details with the merchant and cutoff date of one of the
+	// given details that are already aggregated into a transaction
+	FindAggregatedByNaturalKeys(ctx context.Context, details []*model.PaypayPayinDetail) ([]*model.PaypayPayinDetail, error)
+
+	// CountAggregatedByPayinFileID counts the transaction records made from the details of a payin file
+	CountAggregatedByPayinFileID(ctx context.Context, payinFileID int) (int64, error)
+
+	// FindUnaggregatedByCutoffDate lists the details of a cutoff date that have not been aggregated into a transaction yet
+	FindUnaggregatedByCutoffDate(ctx context.Context, cutoffDate time.Time) ([]*model.PaypayPayinDetail, error)
+
+	// FindByMerchantAndCutoffDate finds the detail of a merchant and cutoff date, or nil when there is none
+	FindByMerchantAndCutoffDate(ctx context.Context, merchantID string, cutoffDate time.Time) (*model.PaypayPayinDetail, error)
 }
@@ -2,10 +2,18 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	model "github.com/huydq/test/internal/domain/model/paypay"
 )
 
 type PaypayPayinSummaryRepository interface {
-	BulkInsert(ctx context.Context, summaries []*model.PaypayPayinSummary) error
+	// BulkUpsert inserts the summaries, updating the existing summaries of the same corporate name, cutoff date and payment date
+	BulkUpsert(ctx context.Context, summaries []*model.PaypayPayinSummary) error
+
+	// DeleteByPayinFileID deletes the summaries imported from a payin file
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+
+	// FindByPayinFileIDAndCutoffDate finds the summary of a cutoff date imported from a payin file, or nil when there is none
+	FindByPayinFileIDAndCutoffDate(ctx context.Context, payinFileID int, cutoffDate time.Time) (*model.PaypayPayinSummary, error)
 }
@@ -4,8 +4,24 @@ import (
 	"context"
 
 	model "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/paypay"
 )
 
 type PaypayPayinTransactionRepository interface {
-	BulkInsert(ctx context.Context, transactions []*model.PaypayPayinTransaction) error
+	// BulkUpsert inserts the transactions, updating the existing transactions of the same payment transaction ID and status
+	BulkUpsert(ctx context.Context, transactions []*model.PaypayPayinTransaction) error
+
+	// DeleteByPayinFileID deletes the transactions imported from a payin file
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+
+	// CountUnmappedPaymentMethodLabels counts the transactions without a payment method per payment method label
+	CountUnmappedPaymentMethodLabels(ctx context.Context) (map[string]int64, error)
+
+	// UpdatePaymentMethodByLabel sets the payment method of the transactions of the label that have none, returning the
+	// number of transactions updated
+	UpdatePaymentMethodByLabel(ctx context.Context, label string, method object.PaypayPaymentMethod) (int64, error)
+
+	// ListPayinFileIDsWithoutPaymentMethodLabel lists the payin files of the transactions imported before the payment
+	// method labels were kept, that have neither a payment method nor a label
+	ListPayinFileIDsWithoutPaymentMethodLabel(ctx context.Context) ([]int, error)
 }
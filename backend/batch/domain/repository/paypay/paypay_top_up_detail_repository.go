@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+)
+
+type PaypayTopUpDetailRepository interface {
+	// BulkUpsert inserts the top-up details, updating the existing details of the same merchant, cutoff date and payment date
+	BulkUpsert(ctx context.Context, details []*model.PaypayTopUpDetail) error
+
+	// DeleteByPayinFileID deletes the top-up details imported from a payin file
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+}
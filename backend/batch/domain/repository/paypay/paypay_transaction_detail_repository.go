@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/paypay"
+)
+
+type PaypayTransactionDetailRepository interface {
+	// BulkUpsert inserts the transaction details, updating the existing details of the same transaction ID and status
+	BulkUpsert(ctx context.Context, details []*model.PaypayTransactionDetail) error
+
+	// DeleteByPayinFileID deletes the details imported from a payin file
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+
+	// CountUnmappedPaymentMethodLabels counts the details without a payment method per payment method label
+	CountUnmappedPaymentMethodLabels(ctx context.Context) (map[string]int64, error)
+
+	// UpdatePaymentMethodByLabel sets the payment method of the details of the label that have none, returning the
+	// number of details updated
+	UpdatePaymentMethodByLabel(ctx context.Context, label string, method object.PaypayPaymentMethod) (int64, error)
+}
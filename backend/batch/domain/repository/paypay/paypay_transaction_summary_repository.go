@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+)
+
+type PaypayTransactionSummaryRepository interface {
+	// BulkUpsert inserts the transaction summaries, updating the existing summaries of the same merchant and transaction date
+	BulkUpsert(ctx context.Context, summaries []*model.PaypayTransactionSummary) error
+
+	// DeleteByPayinFileID deletes the summaries imported from a payin file
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/transaction"
+)
+
+type TransactionRecordRepository interface {
+	// BulkInsert creates the records of a transaction
+	BulkInsert(ctx context.Context, records []model.TransactionRecord) error
+}
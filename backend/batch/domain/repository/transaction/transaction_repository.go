@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/transaction"
+)
+
+type TransactionRepository interface {
+	// Create creates a transaction and sets the generated ID on the model
+	Create(ctx context.Context, transaction *model.Transaction) error
+
+	// UpdatePayoutRecordID links a transaction to the payout record it is paid out by
+	UpdatePayoutRecordID(ctx context.Context, transaction *model.Transaction) error
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	reconciliationModel "github.com/huydq/test/internal/domain/model/payout_reconciliation"
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+)
+
+const (
+	transferDesignatedDateLayout = "2006-01-02"
+	transferExecutedLayout       = "2006-01-02T15:04:05-07:00"
+)
+
+// bankStatusToTransferStatus maps the bank's transfer status codes to TransferStatus
+var bankStatusToTransferStatus = map[string]object.TransferStatus{
+	aozora.TransferStatusCodeApplying:         object.TransferStatusInProgress,
+	aozora.TransferStatusCodeReserved:         object.TransferStatusInProgress,
+	aozora.TransferStatusCodeProcessing:       object.TransferStatusInProgress,
+	aozora.TransferStatusCodeRetrying:         object.TransferStatusInProgress,
+	aozora.TransferStatusCodeReversalPending:  object.TransferStatusInProgress,
+	aozora.TransferStatusCodeUnknown:          object.TransferStatusInProgress,
+	aozora.TransferStatusCodeCompleted:        object.TransferStatusProcessed,
+	aozora.TransferStatusCodeReversalRejected: object.TransferStatusProcessed,
+	aozora.TransferStatusCodeRemanded:         object.TransferStatusFailed,
+	aozora.TransferStatusCodeWithdrawn:        object.TransferStatusFailed,
+	aozora.TransferStatusCodeExpired:          object.TransferStatusFailed,
+	aozora.TransferStatusCodeCancelled:        object.TransferStatusFailed,
+	aozora.TransferStatusCodeFundsReturned:    object.TransferStatusFailed,
+	aozora.TransferStatusCodeReversed:         object.TransferStatusFailed,
+}
+
+// bankErrorToTransferStatus maps the error codes of rejected transfer requests to TransferStatus
+var bankErrorToTransferStatus = map[string]object.TransferStatus{
+	aozora.ErrorCodeNotWhitelisted: object.TransferStatusWhitelistError,
+	aozora.ErrorCodeInvalidRequest: object.TransferStatusFailed,
+	aozora.ErrorCodeUnauthorized:   object.TransferStatusApiError,
+	aozora.ErrorCodeRateLimited:    object.TransferStatusApiError,
+}
+
+// TransferStatusService builds bank requests from payout records and maps the bank's responses back onto them
+type TransferStatusService struct{}
+
+// NewTransferStatusService creates a new instance of TransferStatusService
+func NewTransferStatusService() *TransferStatusService {
+	return &TransferStatusService{}
+}
+
+// ToBeneficiary builds the bank beneficiary for a payout record
+func (s *TransferStatusService) ToBeneficiary(record *model.PayoutRecord) aozora.Beneficiary {
+	return aozora.Beneficiary{
+		BankCode:        record.BankCode,
+		BranchCode:      record.BranchCode,
+		AccountTypeCode: toAccountTypeCode(record.BankAccountType),
+		AccountNumber:   record.AccountNo,
+		Name:            record.AccountName,
+	}
+}
+
+// ToTransferRequest builds a single-item transfer request for a payout record
+func (s *TransferStatusService) ToTransferRequest(record *model.PayoutRecord, designatedDate time.Time) (aozora.TransferRequest, error) {
+	amount, err := FormatTransferAmount(record.Amount)
+	if err != nil {
+		return aozora.TransferRequest{}, err
+	}
+
+	return aozora.TransferRequest{
+		TransferDesignatedDate: designatedDate.Format(transferDesignatedDateLayout),
+		TotalCount:             "1",
+		TotalAmount:            amount,
+		Transfers: []aozora.TransferItem{
+			{
+				ItemID:         "1",
+				TransferAmount: amount,
+				Beneficiary:    s.ToBeneficiary(record),
+			},
+		},
+	}, nil
+}
+
+// ApplyWhitelistError records a failed beneficiary whitelist registration on the payout record
+func (s *TransferStatusService) ApplyWhitelistError(record *model.PayoutRecord, err error) {
+	record.TransferStatus = object.TransferStatusWhitelistError
+	record.TransferRequestError = err.Error()
+}
+
+// ApplyTransferResult records the outcome of a transfer request on the payout record.
+// The errors the bank responds with are mapped by their code, see ToRequestErrorStatus.
+func (s *TransferStatusService) ApplyTransferResult(record *model.PayoutRecord, response *aozora.TransferResponse, err error, requestedAt time.Time) {
+	record.TransferRequestedAt = &requestedAt
+
+	if err != nil {
+		record.TransferStatus = s.ToRequestErrorStatus(err)
+		record.TransferRequestError = err.Error()
+		return
+	}
+
+	record.AozoraTransferApplyNo = response.ApplyNo
+	record.TransferRequestError = ""
+	if response.ResultCode == aozora.ResultCodeCompleted {
+		record.TransferStatus = object.TransferStatusRequested
+	} else {
+		record.TransferStatus = object.TransferStatusInProgress
+	}
+}
+
+// ApplyTransferDetail records the status reported by a transfer status inquiry on the payout record
+func (s *TransferStatusService) ApplyTransferDetail(record *model.PayoutRecord, detail aozora.TransferDetail) error {
+	status := s.ToTransferStatus(detail.TransferStatus)
+	if !status.IsProcessed() {
+		record.TransferStatus = status
+		if status == object.TransferStatusFailed {
+			record.TransferRequestError = fmt.Sprintf("%s(%s)", detail.TransferStatusName, detail.TransferStatus)
+		}
+		return nil
+	}
+
+	executedAt := time.Now()
+	if detail.TransferExecutedDatetime != "" {
+		parsed, err := time.Parse(transferExecutedLayout, detail.TransferExecutedDatetime)
+		if err != nil {
+			return fmt.Errorf("invalid transfer executed datetime %q: %w", detail.TransferExecutedDatetime, err)
+		}
+		executedAt = parsed
+	}
+
+	record.MarkAsProcessed(executedAt)
+	return nil
+}
+
+// ReconcileAmount compares the amount reported by the bank with the amount of the payout record.
+// It returns the mismatch to be flagged, or nil when the amounts match or the bank did not report an amount.
+func (s *TransferStatusService) ReconcileAmount(
+	record *model.PayoutRecord,
+	detail aozora.TransferDetail,
+	detectedAt time.Time,
+) (*reconciliationModel.PayoutReconciliation, error) {
+	if detail.TransferAmount == "" {
+		return nil, nil
+	}
+
+	bankAmount, err := strconv.ParseFloat(detail.TransferAmount, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transfer amount %q: %w", detail.TransferAmount, err)
+	}
+	if bankAmount == record.Amount {
+		return nil, nil
+	}
+
+	return reconciliationModel.NewPayoutReconciliation(reconciliationModel.PayoutReconciliationParams{
+		PayoutID:              record.PayoutID,
+		PayoutRecordID:        record.ID,
+		AozoraTransferApplyNo: record.AozoraTransferApplyNo,
+		LocalAmount:           record.Amount,
+		BankAmount:            bankAmount,
+		BankTransferStatus:    detail.TransferStatus,
+		DetectedAt:            detectedAt,
+	}), nil
+}
+
+// ToRequestErrorStatus maps the error of a transfer request. Beneficiaries missing from the whitelist are whitelist
+// errors and requests the bank rejected as invalid have failed for good. Transport errors, rejected access tokens, rate
+// limits and server errors are API errors, as the request may be accepted when sent again; so are the error codes not
+// known yet, unless their HTTP status tells the request itself was rejected.
+func (s *TransferStatusService) ToRequestErrorStatus(err error) object.TransferStatus {
+	var apiErr *aozora.APIError
+	if !errors.As(err, &apiErr) {
+		return object.TransferStatusApiError
+	}
+	if status, ok := bankErrorToTransferStatus[apiErr.ErrorCode]; ok {
+		return status
+	}
+	if apiErr.IsRetryable() {
+		return object.TransferStatusApiError
+	}
+	return object.TransferStatusFailed
+}
+
+// ToTransferStatus maps a bank transfer status code; unknown codes are treated as still in progress
+func (s *TransferStatusService) ToTransferStatus(code string) object.TransferStatus {
+	if status, ok := bankStatusToTransferStatus[code]; ok {
+		return status
+	}
+	return object.TransferStatusInProgress
+}
+
+// FormatTransferAmount formats a yen amount for the bank API, which only accepts positive whole yen
+func FormatTransferAmount(amount float64) (string, error) {
+	if amount <= 0 || amount != math.Trunc(amount) {
+		return "", fmt.Errorf("invalid transfer amount: %v", amount)
+	}
+	return strconv.FormatInt(int64(amount), 10), nil
+}
+
+func toAccountTypeCode(accountType object.BankAccountType) string {
+	switch accountType {
+	case object.BankAccountTypeOrdinary:
+		return aozora.AccountTypeCodeOrdinary
+	case object.BankAccountTypeCurrent:
+		return aozora.AccountTypeCodeCurrent
+	default:
+		return aozora.AccountTypeCodeOther
+	}
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPayoutRecord() *model.PayoutRecord {
+	return model.NewPayoutRecord(model.PayoutRecordParams{
+		ID:              1,
+		PayoutID:        1,
+		BankCode:        "0310",
+		BranchCode:      "101",
+		BankAccountType: object.BankAccountTypeOrdinary,
+		AccountNo:       "1234567",
+		AccountName:     "ﾃｽﾄ ｼﾖｳﾃﾝ",
+		Amount:          15000,
+		IdempotencyKey:  "6f1c1f0e-5a55-4f61-9a43-6a0d3c6f9c01",
+	})
+}
+
+func newTestBank(t *testing.T) (aozora.AozoraTransferService, *aozora.FakeBankServer) {
+	t.Helper()
+
+	bank := aozora.NewFakeBankServer("token", "101011234567")
+	server := httptest.NewServer(bank)
+	t.Cleanup(server.Close)
+
+	return aozora.NewAozoraClient(aozora.AozoraConfig{
+		BaseURL:     server.URL,
+		AccessToken: "token",
+		AccountID:   "101011234567",
+	}), bank
+}
+
+func TestTransferStatusService_TransferLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, bank := newTestBank(t)
+	svc := NewTransferStatusService()
+	record := newTestPayoutRecord()
+	requestedAt := time.Date(2025, 5, 20, 9, 0, 0, 0, time.UTC)
+
+	_, err := client.RegisterWhitelist(ctx, svc.ToBeneficiary(record))
+	require.NoError(t, err)
+
+	request, err := svc.ToTransferRequest(record, requestedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-05-20", request.TransferDesignatedDate)
+	assert.Equal(t, "15000", request.TotalAmount)
+	assert.Equal(t, aozora.AccountTypeCodeOrdinary, request.Transfers[0].AccountTypeCode)
+
+	response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+	svc.ApplyTransferResult(record, response, err, requestedAt)
+	assert.Equal(t, object.TransferStatusRequested, record.TransferStatus)
+	assert.Equal(t, response.ApplyNo, record.AozoraTransferApplyNo)
+	assert.Equal(t, requestedAt, *record.TransferRequestedAt)
+	assert.Empty(t, record.TransferRequestError)
+
+	status, err := client.GetTransferStatus(ctx, record.AozoraTransferApplyNo)
+	require.NoError(t, err)
+	require.NoError(t, svc.ApplyTransferDetail(record, status.TransferDetails[0]))
+	assert.Equal(t, object.TransferStatusInProgress, record.TransferStatus)
+	assert.Nil(t, record.TransferExecutedAt)
+
+	executedAt := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, bank.CompleteTransfer(record.AozoraTransferApplyNo, executedAt))
+
+	status, err = client.GetTransferStatus(ctx, record.AozoraTransferApplyNo)
+	require.NoError(t, err)
+	require.NoError(t, svc.ApplyTransferDetail(record, status.TransferDetails[0]))
+	assert.Equal(t, object.TransferStatusProcessed, record.TransferStatus)
+	require.NotNil(t, record.TransferExecutedAt)
+	assert.True(t, executedAt.Equal(*record.TransferExecutedAt))
+}
+
+func TestTransferStatusService_ApplyTransferResultErrors(t *testing.T) {
+	ctx := context.Background()
+	svc := NewTransferStatusService()
+
+	t.Run("beneficiary not whitelisted", func(t *testing.T) {
+		client, _ := newTestBank(t)
+		record := newTestPayoutRecord()
+		request, err := svc.ToTransferRequest(record, time.Now())
+		require.NoError(t, err)
+
+		response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+		svc.ApplyTransferResult(record, response, err, time.Now())
+
+		assert.Equal(t, object.TransferStatusWhitelistError, record.TransferStatus)
+		assert.Contains(t, record.TransferRequestError, aozora.FakeErrorCodeNotWhitelisted)
+		assert.Empty(t, record.AozoraTransferApplyNo)
+	})
+
+	t.Run("rejected as invalid by the bank", func(t *testing.T) {
+		client, bank := newTestBank(t)
+		record := newTestPayoutRecord()
+		_, err := client.RegisterWhitelist(ctx, svc.ToBeneficiary(record))
+		require.NoError(t, err)
+		request, err := svc.ToTransferRequest(record, time.Now())
+		require.NoError(t, err)
+		request.Transfers = nil
+
+		response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+		svc.ApplyTransferResult(record, response, err, time.Now())
+
+		assert.Equal(t, object.TransferStatusFailed, record.TransferStatus)
+		assert.Contains(t, record.TransferRequestError, aozora.FakeErrorCodeInvalidRequest)
+		assert.Equal(t, 0, bank.TransferCount())
+	})
+
+	t.Run("access token rejected", func(t *testing.T) {
+		bank := aozora.NewFakeBankServer("token", "101011234567")
+		server := httptest.NewServer(bank)
+		t.Cleanup(server.Close)
+		client := aozora.NewAozoraClient(aozora.AozoraConfig{
+			BaseURL:     server.URL,
+			AccessToken: "expired",
+			AccountID:   "101011234567",
+		})
+		record := newTestPayoutRecord()
+		request, err := svc.ToTransferRequest(record, time.Now())
+		require.NoError(t, err)
+
+		response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+		svc.ApplyTransferResult(record, response, err, time.Now())
+
+		assert.Equal(t, object.TransferStatusApiError, record.TransferStatus)
+		assert.Contains(t, record.TransferRequestError, aozora.FakeErrorCodeUnauthorized)
+	})
+
+	t.Run("rate limited by the bank", func(t *testing.T) {
+		client, bank := newTestBank(t)
+		record := newTestPayoutRecord()
+		_, err := client.RegisterWhitelist(ctx, svc.ToBeneficiary(record))
+		require.NoError(t, err)
+		bank.FailNext(aozora.TransferPath, http.StatusTooManyRequests)
+		request, err := svc.ToTransferRequest(record, time.Now())
+		require.NoError(t, err)
+
+		response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+		svc.ApplyTransferResult(record, response, err, time.Now())
+
+		assert.Equal(t, object.TransferStatusApiError, record.TransferStatus)
+		assert.Equal(t, 0, bank.TransferCount())
+	})
+
+	t.Run("bank api unavailable", func(t *testing.T) {
+		client, bank := newTestBank(t)
+		record := newTestPayoutRecord()
+		_, err := client.RegisterWhitelist(ctx, svc.ToBeneficiary(record))
+		require.NoError(t, err)
+		bank.FailNext(aozora.TransferPath, http.StatusInternalServerError)
+		request, err := svc.ToTransferRequest(record, time.Now())
+		require.NoError(t, err)
+
+		response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+		svc.ApplyTransferResult(record, response, err, time.Now())
+
+		assert.Equal(t, object.TransferStatusApiError, record.TransferStatus)
+		assert.NotEmpty(t, record.TransferRequestError)
+		assert.Equal(t, 0, bank.TransferCount())
+	})
+
+	t.Run("whitelist registration failed", func(t *testing.T) {
+		client, bank := newTestBank(t)
+		record := newTestPayoutRecord()
+		bank.FailNext(aozora.WhitelistPath, http.StatusBadGateway)
+
+		_, err := client.RegisterWhitelist(ctx, svc.ToBeneficiary(record))
+		require.Error(t, err)
+		svc.ApplyWhitelistError(record, err)
+
+		assert.Equal(t, object.TransferStatusWhitelistError, record.TransferStatus)
+		assert.NotEmpty(t, record.TransferRequestError)
+	})
+}
+
+func TestTransferStatusService_ToRequestErrorStatus(t *testing.T) {
+	svc := NewTransferStatusService()
+
+	cases := []struct {
+		name string
+		err  error
+		want object.TransferStatus
+	}{
+		{"not whitelisted", &aozora.APIError{StatusCode: http.StatusBadRequest, ErrorResponse: aozora.ErrorResponse{ErrorCode: aozora.ErrorCodeNotWhitelisted}}, object.TransferStatusWhitelistError},
+		{"invalid request", &aozora.APIError{StatusCode: http.StatusBadRequest, ErrorResponse: aozora.ErrorResponse{ErrorCode: aozora.ErrorCodeInvalidRequest}}, object.TransferStatusFailed},
+		{"unauthorized", &aozora.APIError{StatusCode: http.StatusUnauthorized, ErrorResponse: aozora.ErrorResponse{ErrorCode: aozora.ErrorCodeUnauthorized}}, object.TransferStatusApiError},
+		{"rate limited", &aozora.APIError{StatusCode: http.StatusTooManyRequests, ErrorResponse: aozora.ErrorResponse{ErrorCode: aozora.ErrorCodeRateLimited}}, object.TransferStatusApiError},
+		{"unknown code with 401", &aozora.APIError{StatusCode: http.StatusUnauthorized}, object.TransferStatusApiError},
+		{"unknown code with 429", &aozora.APIError{StatusCode: http.StatusTooManyRequests}, object.TransferStatusApiError},
+		{"unknown code with 400", &aozora.APIError{StatusCode: http.StatusBadRequest}, object.TransferStatusFailed},
+		{"server error", &aozora.APIError{StatusCode: http.StatusServiceUnavailable}, object.TransferStatusApiError},
+		{"transport error", errors.New("connection refused"), object.TransferStatusApiError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, svc.ToRequestErrorStatus(tc.err))
+		})
+	}
+}
+
+func TestTransferStatusService_ToTransferStatus(t *testing.T) {
+	svc := NewTransferStatusService()
+
+	cases := map[string]object.TransferStatus{
+		aozora.TransferStatusCodeApplying:      object.TransferStatusInProgress,
+		aozora.TransferStatusCodeProcessing:    object.TransferStatusInProgress,
+		aozora.TransferStatusCodeUnknown:       object.TransferStatusInProgress,
+		aozora.TransferStatusCodeCompleted:     object.TransferStatusProcessed,
+		aozora.TransferStatusCodeRemanded:      object.TransferStatusFailed,
+		aozora.TransferStatusCodeExpired:       object.TransferStatusFailed,
+		aozora.TransferStatusCodeFundsReturned: object.TransferStatusFailed,
+		"999":                                  object.TransferStatusInProgress,
+	}
+	for code, expected := range cases {
+		assert.Equal(t, expected, svc.ToTransferStatus(code), "status code %s", code)
+	}
+}
+
+func TestTransferStatusService_ApplyTransferDetailFailed(t *testing.T) {
+	svc := NewTransferStatusService()
+	record := newTestPayoutRecord()
+
+	err := svc.ApplyTransferDetail(record, aozora.TransferDetail{
+		TransferStatus:     aozora.TransferStatusCodeFundsReturned,
+		TransferStatusName: "資金返却",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, object.TransferStatusFailed, record.TransferStatus)
+	assert.Equal(t, "資金返却(22)", record.TransferRequestError)
+	assert.Nil(t, record.TransferExecutedAt)
+}
+
+func TestFormatTransferAmount(t *testing.T) {
+	amount, err := FormatTransferAmount(15000)
+	require.NoError(t, err)
+	assert.Equal(t, "15000", amount)
+
+	for _, invalid := range []float64{0, -100, 100.5} {
+		_, err := FormatTransferAmount(invalid)
+		assert.Error(t, err, "amount %v", invalid)
+	}
+}
+
+func TestTransferStatusService_ReconcileAmount(t *testing.T) {
+	ctx := context.Background()
+	client, bank := newTestBank(t)
+	svc := NewTransferStatusService()
+	record := newTestPayoutRecord()
+	detectedAt := time.Date(2025, 5, 21, 9, 0, 0, 0, time.UTC)
+
+	_, err := client.RegisterWhitelist(ctx, svc.ToBeneficiary(record))
+	require.NoError(t, err)
+	request, err := svc.ToTransferRequest(record, detectedAt)
+	require.NoError(t, err)
+	response, err := client.RequestTransfer(ctx, record.IdempotencyKey, request)
+	svc.ApplyTransferResult(record, response, err, detectedAt)
+	require.NoError(t, err)
+
+	status, err := client.GetTransferStatus(ctx, record.AozoraTransferApplyNo)
+	require.NoError(t, err)
+	mismatch, err := svc.ReconcileAmount(record, status.TransferDetails[0], detectedAt)
+	require.NoError(t, err)
+	assert.Nil(t, mismatch)
+
+	require.NoError(t, bank.SetTransferAmount(record.AozoraTransferApplyNo, "14000"))
+	status, err = client.GetTransferStatus(ctx, record.AozoraTransferApplyNo)
+	require.NoError(t, err)
+	mismatch, err = svc.ReconcileAmount(record, status.TransferDetails[0], detectedAt)
+	require.NoError(t, err)
+	require.NotNil(t, mismatch)
+	assert.Equal(t, record.ID, mismatch.PayoutRecordID)
+	assert.Equal(t, record.PayoutID, mismatch.PayoutID)
+	assert.Equal(t, record.AozoraTransferApplyNo, mismatch.AozoraTransferApplyNo)
+	assert.Equal(t, 15000.0, mismatch.LocalAmount)
+	assert.Equal(t, 14000.0, mismatch.BankAmount)
+	assert.Equal(t, aozora.TransferStatusCodeProcessing, mismatch.BankTransferStatus)
+	assert.Equal(t, detectedAt, mismatch.DetectedAt)
+
+	_, err = svc.ReconcileAmount(record, aozora.TransferDetail{TransferAmount: "abc"}, detectedAt)
+	assert.Error(t, err)
+}
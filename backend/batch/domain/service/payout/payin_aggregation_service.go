@@ -0,0 +1,235 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
+	payoutModel "github.com/huydq/test/internal/domain/model/payout"
+	payoutRecordModel "github.com/huydq/test/internal/domain/model/payout_record"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	transactionModel "github.com/huydq/test/internal/domain/model/transaction"
+	object "github.com/huydq/test/internal/domain/object/payout"
+)
+
+// Titles of the aggregated transaction records
+const (
+	DepositRecordTitle     = "PayPay入金"
+	FeeRecordTitle         = "PayPay手数料"
+	TransferFeeRecordTitle = "振込手数料"
+)
+
+// SkipReason tells why the payin details of a merchant are left for a later run
+type SkipReason string
+
+const (
+	SkipReasonMerchantNotFound SkipReason = "merchant not found"
+	SkipReasonNoBankAccount    SkipReason = "merchant has no bank account"
+	SkipReasonNoPayoutAmount   SkipReason = "merchant has no amount to pay out"
+)
+
+// MerchantPayout pairs a merchant's transaction with the payout record paying it out
+type MerchantPayout struct {
+	Transaction  *transactionModel.Transaction
+	PayoutRecord *payoutRecordModel.PayoutRecord
+}
+
+// SkippedMerchant is a merchant whose payin details are not aggregated by the run
+type SkippedMerchant struct {
+	PaymentMerchantID string
+	MerchantID        int // Zero when the merchant was not found
+	DetailCount       int
+	Amount            float64 // Amount that would have been paid out, for the merchants without a positive amount
+	Reason            SkipReason
+}
+
+// PayinAggregationService builds transactions and payouts from imported payin details
+type PayinAggregationService struct {
+	TransferFee float64
+}
+
+// NewPayinAggregationService creates a new instance of PayinAggregationService
+func NewPayinAggregationService(transferFee float64) *PayinAggregationService {
+	return &PayinAggregationService{TransferFee: transferFee}
+}
+
+// GroupByPaymentMerchantID groups payin details by the merchant ID issued by the payment provider
+func (s *PayinAggregationService) GroupByPaymentMerchantID(details []*paypayModel.PaypayPayinDetail) map[string][]*paypayModel.PaypayPayinDetail {
+	groups := make(map[string][]*paypayModel.PaypayPayinDetail)
+	for _, detail := range details {
+		groups[detail.PaymentMerchantID] = append(groups[detail.PaymentMerchantID], detail)
+	}
+	return groups
+}
+
+// PaymentMerchantIDs returns the merchant IDs of the groups in order
+func (s *PayinAggregationService) PaymentMerchantIDs(groups map[string][]*paypayModel.PaypayPayinDetail) []string {
+	paymentMerchantIDs := make([]string, 0, len(groups))
+	for paymentMerchantID := range groups {
+		paymentMerchantIDs = append(paymentMerchantIDs, paymentMerchantID)
+	}
+	sort.Strings(paymentMerchantIDs)
+	return paymentMerchantIDs
+}
+
+// Aggregate builds the transaction and the payout record of each merchant of the groups, in the order of their
+// payment merchant IDs. Merchants that are not registered, that have no bank account or that have no positive amount
+// to pay out are skipped, so that their payin details are aggregated by a later run.
+func (s *PayinAggregationService) Aggregate(
+	payout *payoutModel.Payout,
+	groups map[string][]*paypayModel.PaypayPayinDetail,
+	merchants map[string]*merchantModel.Merchant,
+	bankAccounts map[int]*merchantModel.MerchantBankAccount,
+) ([]MerchantPayout, []SkippedMerchant) {
+	var payouts []MerchantPayout
+	var skipped []SkippedMerchant
+	for _, paymentMerchantID := range s.PaymentMerchantIDs(groups) {
+		details := groups[paymentMerchantID]
+		merchant, ok := merchants[paymentMerchantID]
+		if !ok {
+			skipped = append(skipped, SkippedMerchant{
+				PaymentMerchantID: paymentMerchantID,
+				DetailCount:       len(details),
+				Reason:            SkipReasonMerchantNotFound,
+			})
+			continue
+		}
+
+		bankAccount, ok := bankAccounts[merchant.ID]
+		if !ok {
+			skipped = append(skipped, SkippedMerchant{
+				PaymentMerchantID: paymentMerchantID,
+				MerchantID:        merchant.ID,
+				DetailCount:       len(details),
+				Reason:            SkipReasonNoBankAccount,
+			})
+			continue
+		}
+
+		transaction := s.BuildTransaction(merchant, details)
+		amount := s.PayoutAmount(transaction)
+		if amount <= 0 {
+			skipped = append(skipped, SkippedMerchant{
+				PaymentMerchantID: paymentMerchantID,
+				MerchantID:        merchant.ID,
+				DetailCount:       len(details),
+				Amount:            amount,
+				Reason:            SkipReasonNoPayoutAmount,
+			})
+			continue
+		}
+
+		payouts = append(payouts, MerchantPayout{
+			Transaction:  transaction,
+			PayoutRecord: s.BuildPayoutRecord(payout, transaction, bankAccount, amount),
+		})
+	}
+	return payouts, skipped
+}
+
+// BuildTransaction builds the transaction of a merchant with a deposit and a fee record per payin detail
+// and a single transfer fee record. The deposit is the transaction amount net of refunds and the fee is
+// everything PayPay deducted from it before paying out.
+//
+// transaction_record.payin_detail_id is required, so the transfer fee record, which is charged once per
+// transaction rather than per payin detail, is linked to the first payin detail of the merchant. That detail
+// is already referenced by its own deposit record, so the link does not change which details are aggregated.
+func (s *PayinAggregationService) BuildTransaction(
+	merchant *merchantModel.Merchant,
+	details []*paypayModel.PaypayPayinDetail,
+) *transactionModel.Transaction {
+	transaction := &transactionModel.Transaction{
+		ShopID:            merchant.ID,
+		TransactionStatus: transactionModel.TransactionStatusProcessing,
+	}
+
+	for _, detail := range details {
+		deposit := detail.TransactionAmount - detail.RefundAmount
+		transaction.TransactionRecords = append(transaction.TransactionRecords, newTransactionRecord(
+			merchant, detail, transactionModel.TransactionRecordTypeDeposit, DepositRecordTitle, deposit,
+		))
+
+		if fee := deposit - detail.Amount; fee != 0 {
+			transaction.TransactionRecords = append(transaction.TransactionRecords, newTransactionRecord(
+				merchant, detail, transactionModel.TransactionRecordTypeFee, FeeRecordTitle, fee,
+			))
+		}
+	}
+
+	if s.TransferFee > 0 && len(details) > 0 {
+		transaction.TransactionRecords = append(transaction.TransactionRecords, newTransactionRecord(
+			merchant, details[0], transactionModel.TransactionRecordTypeTransferFee, TransferFeeRecordTitle, s.TransferFee,
+		))
+	}
+
+	return transaction
+}
+
+// PayoutAmount returns the amount to transfer for a transaction: deposits minus fees and the transfer fee
+func (s *PayinAggregationService) PayoutAmount(transaction *transactionModel.Transaction) float64 {
+	var amount float64
+	for _, record := range transaction.TransactionRecords {
+		switch record.TransactionRecordType {
+		case transactionModel.TransactionRecordTypeDeposit:
+			amount += record.Amount
+		case transactionModel.TransactionRecordTypeFee,
+			transactionModel.TransactionRecordTypeTransferFee:
+			amount -= record.Amount
+		}
+	}
+	return amount
+}
+
+// BuildPayout builds a draft payout scheduled for the sending date
+func (s *PayinAggregationService) BuildPayout(sendingDate time.Time, userID int) *payoutModel.Payout {
+	return payoutModel.NewPayout(payoutModel.NewPayoutParams{
+		PayoutStatus: object.PayoutStatusDraft,
+		SendingDate:  sendingDate,
+		UserID:       userID,
+	})
+}
+
+// BuildPayoutRecord builds the payout record paying a transaction out to the merchant's bank account.
+// The record is not submitted to the bank until the payout is approved.
+func (s *PayinAggregationService) BuildPayoutRecord(
+	payout *payoutModel.Payout,
+	transaction *transactionModel.Transaction,
+	bankAccount *merchantModel.MerchantBankAccount,
+	amount float64,
+) *payoutRecordModel.PayoutRecord {
+	sendingDate := payout.SendingDate
+	return payoutRecordModel.NewPayoutRecord(payoutRecordModel.PayoutRecordParams{
+		ShopID:          transaction.ShopID,
+		PayoutID:        payout.ID,
+		TransactionID:   transaction.ID,
+		BankName:        bankAccount.BankName,
+		BankCode:        bankAccount.BankCode,
+		BranchName:      bankAccount.BranchName,
+		BranchCode:      bankAccount.BranchCode,
+		BankAccountType: bankAccount.BankAccountType,
+		AccountNo:       bankAccount.AccountNo,
+		AccountName:     bankAccount.AccountName,
+		Amount:          amount,
+		TransferStatus:  object.TransferStatusNotSubmitted,
+		SendingDate:     &sendingDate,
+		IdempotencyKey:  uuid.NewString(),
+	})
+}
+
+func newTransactionRecord(
+	merchant *merchantModel.Merchant,
+	detail *paypayModel.PaypayPayinDetail,
+	recordType int,
+	title string,
+	amount float64,
+) transactionModel.TransactionRecord {
+	merchantID := merchant.ID
+	return transactionModel.TransactionRecord{
+		MerchantID:            &merchantID,
+		PayinDetailID:         detail.ID,
+		TransactionRecordType: recordType,
+		Title:                 title,
+		Amount:                amount,
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	transactionModel "github.com/huydq/test/internal/domain/model/transaction"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPayinDetail(id int, paymentMerchantID string, transactionAmount, refundAmount, amount float64) *paypayModel.PaypayPayinDetail {
+	return &paypayModel.PaypayPayinDetail{
+		ID:                id,
+		PaymentMerchantID: paymentMerchantID,
+		TransactionAmount: transactionAmount,
+		RefundAmount:      refundAmount,
+		Amount:            amount,
+	}
+}
+
+func newTestBankAccount(merchantID int) *merchantModel.MerchantBankAccount {
+	return &merchantModel.MerchantBankAccount{
+		MerchantID:      merchantID,
+		BankName:        "あおぞら銀行",
+		BankCode:        "0398",
+		BranchName:      "本店",
+		BranchCode:      "101",
+		BankAccountType: object.BankAccountTypeOrdinary,
+		AccountNo:       "1234567",
+		AccountName:     "ｶ)ﾏｲｸｼﾖﾂﾌﾟ",
+	}
+}
+
+func recordsOfType(transaction *transactionModel.Transaction, recordType int) []transactionModel.TransactionRecord {
+	var records []transactionModel.TransactionRecord
+	for _, record := range transaction.TransactionRecords {
+		if record.TransactionRecordType == recordType {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func TestPayinAggregationService_BuildTransaction(t *testing.T) {
+	svc := NewPayinAggregationService(250)
+	merchant := &merchantModel.Merchant{ID: 7}
+	details := []*paypayModel.PaypayPayinDetail{
+		newTestPayinDetail(11, "M1", 10000, 1000, 8700),
+		newTestPayinDetail(12, "M1", 5000, 0, 5000),
+	}
+
+	transaction := svc.BuildTransaction(merchant, details)
+
+	assert.Equal(t, merchant.ID, transaction.ShopID)
+	assert.Equal(t, transactionModel.TransactionStatusProcessing, transaction.TransactionStatus)
+
+	t.Run("deposits are the transaction amounts net of refunds", func(t *testing.T) {
+		deposits := recordsOfType(transaction, transactionModel.TransactionRecordTypeDeposit)
+		require.Len(t, deposits, 2)
+		assert.Equal(t, 11, deposits[0].PayinDetailID)
+		assert.Equal(t, 9000.0, deposits[0].Amount)
+		assert.Equal(t, DepositRecordTitle, deposits[0].Title)
+		assert.Equal(t, 12, deposits[1].PayinDetailID)
+		assert.Equal(t, 5000.0, deposits[1].Amount)
+	})
+
+	t.Run("fees are what was deducted from the deposits, and only recorded when not zero", func(t *testing.T) {
+		fees := recordsOfType(transaction, transactionModel.TransactionRecordTypeFee)
+		require.Len(t, fees, 1)
+		assert.Equal(t, 11, fees[0].PayinDetailID)
+		assert.Equal(t, 300.0, fees[0].Amount)
+		assert.Equal(t, FeeRecordTitle, fees[0].Title)
+	})
+
+	t.Run("the transfer fee is charged once and linked to the first payin detail", func(t *testing.T) {
+		transferFees := recordsOfType(transaction, transactionModel.TransactionRecordTypeTransferFee)
+		require.Len(t, transferFees, 1)
+		assert.Equal(t, 250.0, transferFees[0].Amount)
+		assert.Equal(t, TransferFeeRecordTitle, transferFees[0].Title)
+		assert.Equal(t, details[0].ID, transferFees[0].PayinDetailID)
+
+		deposits := recordsOfType(transaction, transactionModel.TransactionRecordTypeDeposit)
+		assert.Equal(t, deposits[0].PayinDetailID, transferFees[0].PayinDetailID)
+	})
+
+	t.Run("records belong to the merchant", func(t *testing.T) {
+		for _, record := range transaction.TransactionRecords {
+			require.NotNil(t, record.MerchantID)
+			assert.Equal(t, merchant.ID, *record.MerchantID)
+		}
+	})
+
+	t.Run("payout amount is the deposits minus the fees and the transfer fee", func(t *testing.T) {
+		assert.Equal(t, 13450.0, svc.PayoutAmount(transaction))
+	})
+}
+
+func TestPayinAggregationService_BuildTransactionWithoutTransferFee(t *testing.T) {
+	svc := NewPayinAggregationService(0)
+
+	transaction := svc.BuildTransaction(&merchantModel.Merchant{ID: 7}, []*paypayModel.PaypayPayinDetail{
+		newTestPayinDetail(11, "M1", 1000, 0, 900),
+	})
+
+	assert.Empty(t, recordsOfType(transaction, transactionModel.TransactionRecordTypeTransferFee))
+	assert.Equal(t, 900.0, svc.PayoutAmount(transaction))
+}
+
+func TestPayinAggregationService_BuildPayoutRecord(t *testing.T) {
+	svc := NewPayinAggregationService(250)
+	sendingDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	payout := svc.BuildPayout(sendingDate, 1)
+	payout.ID = 3
+	transaction := &transactionModel.Transaction{ID: 5, ShopID: 7}
+	bankAccount := newTestBankAccount(7)
+
+	record := svc.BuildPayoutRecord(payout, transaction, bankAccount, 13450)
+
+	assert.Equal(t, object.PayoutStatusDraft, payout.PayoutStatus)
+	assert.Equal(t, object.TransferStatusNotSubmitted, record.TransferStatus)
+	assert.Equal(t, 3, record.PayoutID)
+	assert.Equal(t, 5, record.TransactionID)
+	assert.Equal(t, 7, record.ShopID)
+	assert.Equal(t, 13450.0, record.Amount)
+	assert.Equal(t, bankAccount.BankCode, record.BankCode)
+	assert.Equal(t, bankAccount.BranchCode, record.BranchCode)
+	assert.Equal(t, bankAccount.AccountNo, record.AccountNo)
+	assert.Equal(t, bankAccount.AccountName, record.AccountName)
+	require.NotNil(t, record.SendingDate)
+	assert.Equal(t, sendingDate, *record.SendingDate)
+	assert.NotEmpty(t, record.IdempotencyKey)
+
+	other := svc.BuildPayoutRecord(payout, transaction, bankAccount, 13450)
+	assert.NotEqual(t, record.IdempotencyKey, other.IdempotencyKey)
+}
+
+func TestPayinAggregationService_Aggregate(t *testing.T) {
+	svc := NewPayinAggregationService(250)
+	payout := svc.BuildPayout(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), 1)
+	details := []*paypayModel.PaypayPayinDetail{
+		newTestPayinDetail(1, "PAID", 10000, 0, 9700),
+		newTestPayinDetail(2, "UNKNOWN", 5000, 0, 4800),
+		newTestPayinDetail(3, "NO_ACCOUNT", 5000, 0, 4800),
+		newTestPayinDetail(4, "NEGATIVE", 1000, 1000, -100),
+		newTestPayinDetail(5, "BELOW_FEE", 200, 0, 200),
+		newTestPayinDetail(6, "PAID", 3000, 0, 2900),
+	}
+	merchants := map[string]*merchantModel.Merchant{
+		"PAID":       {ID: 1, PaymentMerchantID: "PAID"},
+		"NO_ACCOUNT": {ID: 2, PaymentMerchantID: "NO_ACCOUNT"},
+		"NEGATIVE":   {ID: 3, PaymentMerchantID: "NEGATIVE"},
+		"BELOW_FEE":  {ID: 4, PaymentMerchantID: "BELOW_FEE"},
+	}
+	bankAccounts := map[int]*merchantModel.MerchantBankAccount{
+		1: newTestBankAccount(1),
+		3: newTestBankAccount(3),
+		4: newTestBankAccount(4),
+	}
+
+	payouts, skipped := svc.Aggregate(payout, svc.GroupByPaymentMerchantID(details), merchants, bankAccounts)
+
+	require.Len(t, payouts, 1)
+	assert.Equal(t, 1, payouts[0].Transaction.ShopID)
+	assert.Len(t, recordsOfType(payouts[0].Transaction, transactionModel.TransactionRecordTypeDeposit), 2)
+	assert.Equal(t, 12350.0, payouts[0].PayoutRecord.Amount)
+	assert.Equal(t, object.TransferStatusNotSubmitted, payouts[0].PayoutRecord.TransferStatus)
+
+	assert.Equal(t, []SkippedMerchant{
+		{PaymentMerchantID: "BELOW_FEE", MerchantID: 4, DetailCount: 1, Amount: -50, Reason: SkipReasonNoPayoutAmount},
+		{PaymentMerchantID: "NEGATIVE", MerchantID: 3, DetailCount: 1, Amount: -350, Reason: SkipReasonNoPayoutAmount},
+		{PaymentMerchantID: "NO_ACCOUNT", MerchantID: 2, DetailCount: 1, Reason: SkipReasonNoBankAccount},
+		{PaymentMerchantID: "UNKNOWN", DetailCount: 1, Reason: SkipReasonMerchantNotFound},
+	}, skipped)
+}
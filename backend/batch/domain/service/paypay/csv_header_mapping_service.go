@@ -1,5 +1,9 @@
 package service
 
+import (
+	object "github.com/huydq/test/internal/domain/object/payin"
+)
+
 // and optionally a description or type for better documentation/formatting.
 type CSVHeaderMappingEntry struct {
 	JP   string // Japanese or original header
@@ -36,6 +40,16 @@ var CSVHeaderMappings = []CSVHeaderMappingEntry{
 	{JP: "調整額", EN: "adjustment", Desc: "Adjustment"},
 	{JP: "入金手数料", EN: "fee", Desc: "Fee"},
 	{JP: "支払金額", EN: "amount", Desc: "Amount"},
+	{JP: "送料", EN: "shipping_fee", Desc: "Shipping Fee"},
+	{JP: "発送日時", EN: "shipped_at", Desc: "Shipped At"},
+	{JP: "取引日", EN: "transaction_date", Desc: "Transaction Date"},
+	{JP: "取引件数", EN: "transaction_count", Desc: "Transaction Count"},
+	{JP: "返金件数", EN: "refund_count", Desc: "Refund Count"},
+	{JP: "金融機関名", EN: "bank_name", Desc: "Bank Name"},
+	{JP: "支店名", EN: "branch_name", Desc: "Branch Name"},
+	{JP: "口座種別", EN: "account_type", Desc: "Account Type"},
+	{JP: "口座番号", EN: "account_number", Desc: "Account Number"},
+	{JP: "口座名義", EN: "account_holder", Desc: "Account Holder"},
 }
 
 // CSVHeaderMapping is a map for fast lookup (JP -> EN)
@@ -74,6 +88,16 @@ func (s *CSVHeaderMappingService) MapHeaders(headers []string, records [][]strin
 	return normHeaders, mappedRecords
 }
 
+// MapRows normalizes headers like MapHeaders and keeps the line each record starts on with its values
+func (s *CSVHeaderMappingService) MapRows(headers []string, records [][]string, lines []int) ([]string, []object.PayinFileRow) {
+	normHeaders, mappedRecords := s.MapHeaders(headers, records)
+	rows := make([]object.PayinFileRow, len(mappedRecords))
+	for i, values := range mappedRecords {
+		rows[i] = object.PayinFileRow{Line: lines[i], Values: values}
+	}
+	return normHeaders, rows
+}
+
 // NormalizeHeader maps a header to its normalized form if available
 func (s *CSVHeaderMappingService) NormalizeHeader(header string) string {
 	if norm, ok := CSVHeaderMapping[header]; ok {
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ledongthuc/pdf"
+	"golang.org/x/text/width"
+)
+
+// InvoiceMetadata is what is read from the text of a qualified invoice (適格請求書). Fields not found in the text
+// are left empty.
+type InvoiceMetadata struct {
+	RegistrationNumber string
+	IssueDate          *time.Time
+	MerchantID         string
+	MerchantName       string
+	CutoffDate         *time.Time
+	TaxableAmount      *float64
+	TaxAmount          *float64
+	TotalAmount        *float64
+}
+
+// Labels of the invoice fields, in order of preference
+var (
+	invoiceRegistrationLabels = []string{"登録番号"}
+	invoiceIssueDateLabels    = []string{"発行日", "請求日"}
+	invoiceMerchantIDLabels   = []string{"加盟店ID"}
+	invoiceMerchantLabels     = []string{"屋号", "加盟店名"}
+	invoiceCutoffLabels       = []string{"締め日", "締日"}
+	invoicePeriodLabels       = []string{"対象期間", "請求期間", "利用期間"}
+	invoiceTotalLabels        = []string{"ご請求金額", "請求金額", "合計金額", "合計"}
+	invoiceTaxLabels          = []string{"消費税"}
+	invoiceTaxableLabels      = []string{"10%対象"}
+)
+
+// invoiceAddresseeSuffix ends the addressee line of an invoice
+const invoiceAddresseeSuffix = "御中"
+
+const (
+	// pdfLineTolerance is how far apart, in points, the baselines of glyphs on the same line can be
+	pdfLineTolerance = 1.0
+	// pdfWordGap is the gap between two glyphs, relative to the font size, from which it is read as a space
+	pdfWordGap = 0.2
+)
+
+var (
+	registrationNumberPattern = regexp.MustCompile(`T(?:\d[\s-]?){12}\d`)
+	datePattern               = regexp.MustCompile(`(\d{4})\s*[年/.-]\s*(\d{1,2})\s*[月/.-]\s*(\d{1,2})`)
+	amountPattern             = regexp.MustCompile(`-?\d[\d,]*(?:\.\d+)?`)
+)
+
+// InvoiceTextParseService reads the metadata of the qualified invoices from the text of their PDF
+type InvoiceTextParseService struct{}
+
+// NewInvoiceTextParseService creates a new instance of InvoiceTextParseService
+func NewInvoiceTextParseService() *InvoiceTextParseService {
+	return &InvoiceTextParseService{}
+}
+
+// ExtractText returns the text of the invoice PDF, a line for each line of text from the top of the page, the glyphs
+// of a line being separated by a space where they are apart. Scanned invoices have no text layer and return an empty
+// string.
+func (s *InvoiceTextParseService) ExtractText(content []byte) (text string, err error) {
+	// The reader panics on some malformed files
+	defer func() {
+		if r := recover(); r != nil {
+			text, err = "", fmt.Errorf("malformed PDF file: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", err
+	}
+
+	var lines []string
+	for i := 1; i <= reader.NumPage(); i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		lines = append(lines, pdfLines(page.Content().Text)...)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// pdfLines groups the glyphs of a page by baseline, from the top of the page, and joins the glyphs of each line from
+// left to right
+func pdfLines(glyphs []pdf.Text) []string {
+	type line struct {
+		y      float64
+		glyphs []pdf.Text
+	}
+	var pageLines []*line
+	for _, glyph := range glyphs {
+		// The reader ends every TJ with a newline, decoded by two-byte fonts as the replacement character
+		if glyph.S == "\n" || glyph.S == string(unicode.ReplacementChar) {
+			continue
+		}
+		var found *line
+		for _, l := range pageLines {
+			if math.Abs(l.y-glyph.Y) <= pdfLineTolerance {
+				found = l
+				break
+			}
+		}
+		if found == nil {
+			found = &line{y: glyph.Y}
+			pageLines = append(pageLines, found)
+		}
+		found.glyphs = append(found.glyphs, glyph)
+	}
+	sort.SliceStable(pageLines, func(i, j int) bool { return pageLines[i].y > pageLines[j].y })
+
+	var texts []string
+	for _, l := range pageLines {
+		// Stable, as the glyphs of fonts without widths all have the position of the start of their text
+		sort.SliceStable(l.glyphs, func(i, j int) bool { return l.glyphs[i].X < l.glyphs[j].X })
+
+		var sb strings.Builder
+		for i, glyph := range l.glyphs {
+			if i > 0 {
+				prev := l.glyphs[i-1]
+				if glyph.X-(prev.X+prev.W) > glyph.FontSize*pdfWordGap && prev.S != " " && glyph.S != " " {
+					sb.WriteString(" ")
+				}
+			}
+			sb.WriteString(glyph.S)
+		}
+		if text := strings.TrimSpace(sb.String()); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+// Parse reads the metadata from the text, where a label and its value are on the same line or the value on the line
+// below. Fullwidth digits and symbols are read as their ASCII counterparts.
+func (s *InvoiceTextParseService) Parse(text string) InvoiceMetadata {
+	var lines []string
+	for _, line := range strings.Split(width.Fold.String(text), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	var metadata InvoiceMetadata
+
+	registration := labelValue(lines, invoiceRegistrationLabels)
+	if match := registrationNumberPattern.FindString(registration); match != "" {
+		metadata.RegistrationNumber = normalizeRegistrationNumber(match)
+	} else if match := registrationNumberPattern.FindString(strings.Join(lines, "\n")); match != "" {
+		metadata.RegistrationNumber = normalizeRegistrationNumber(match)
+	}
+
+	metadata.IssueDate = firstDate(labelValue(lines, invoiceIssueDateLabels))
+
+	if id := strings.Fields(labelValue(lines, invoiceMerchantIDLabels)); len(id) > 0 {
+		metadata.MerchantID = id[0]
+	}
+
+	metadata.MerchantName = addressee(lines)
+	if metadata.MerchantName == "" {
+		metadata.MerchantName = labelValue(lines, invoiceMerchantLabels)
+	}
+
+	metadata.CutoffDate = firstDate(labelValue(lines, invoiceCutoffLabels))
+	if metadata.CutoffDate == nil {
+		// The cutoff date is the end of the period the invoice covers
+		metadata.CutoffDate = lastDate(labelValue(lines, invoicePeriodLabels))
+	}
+
+	metadata.TotalAmount = firstAmount(labelValue(lines, invoiceTotalLabels))
+	metadata.TaxAmount = firstAmount(labelValue(lines, invoiceTaxLabels))
+	metadata.TaxableAmount = firstAmount(labelValue(lines, invoiceTaxableLabels))
+
+	return metadata
+}
+
+// labelValue returns the text following the first label found, or the next line when the label ends its line
+func labelValue(lines []string, labels []string) string {
+	for _, label := range labels {
+		for i, line := range lines {
+			idx := strings.Index(line, label)
+			if idx < 0 {
+				continue
+			}
+			value := strings.TrimLeft(line[idx+len(label):], " :\t")
+			// Skip a unit or note in brackets, such as 合計(税込)
+			if strings.HasPrefix(value, "(") {
+				if end := strings.Index(value, ")"); end >= 0 {
+					value = strings.TrimLeft(value[end+1:], " :\t")
+				}
+			}
+			if value == "" && i+1 < len(lines) {
+				value = lines[i+1]
+			}
+			return value
+		}
+	}
+	return ""
+}
+
+// addressee returns the name on the addressee line, such as "株式会社テスト 御中"
+func addressee(lines []string) string {
+	for _, line := range lines {
+		if name, found := strings.CutSuffix(line, invoiceAddresseeSuffix); found {
+			if name = strings.TrimSpace(name); name != "" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func normalizeRegistrationNumber(s string) string {
+	return strings.NewReplacer(" ", "", "-", "", "\t", "").Replace(s)
+}
+
+func firstDate(s string) *time.Time {
+	matches := datePattern.FindAllStringSubmatch(s, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+	return toDate(matches[0])
+}
+
+func lastDate(s string) *time.Time {
+	matches := datePattern.FindAllStringSubmatch(s, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+	return toDate(matches[len(matches)-1])
+}
+
+func toDate(match []string) *time.Time {
+	year, _ := strconv.Atoi(match[1])
+	month, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return nil
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		return nil
+	}
+	return &date
+}
+
+func firstAmount(s string) *float64 {
+	match := amountPattern.FindString(s)
+	if match == "" {
+		return nil
+	}
+	amount, err := strconv.ParseFloat(strings.ReplaceAll(match, ",", ""), 64)
+	if err != nil {
+		return nil
+	}
+	return &amount
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDate(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func testAmount(v float64) *float64 {
+	return &v
+}
+
+func TestInvoiceTextParseService_Parse(t *testing.T) {
+	text := `適格請求書
+株式会社テスト商店 御中
+加盟店ID ２２３３４４５５
+発行日：２０２５年６月３日
+対象期間 2025年5月1日～2025年5月31日
+PayPay株式会社
+登録番号 T1234-5678-90123
+ご請求金額（税込）
+￥１２,３４５
+10%対象 11,223 消費税 1,122`
+
+	metadata := NewInvoiceTextParseService().Parse(text)
+
+	assert.Equal(t, InvoiceMetadata{
+		RegistrationNumber: "T1234567890123",
+		IssueDate:          testDate(2025, time.June, 3),
+		MerchantID:         "22334455",
+		MerchantName:       "株式会社テスト商店",
+		CutoffDate:         testDate(2025, time.May, 31),
+		TaxableAmount:      testAmount(11223),
+		TaxAmount:          testAmount(1122),
+		TotalAmount:        testAmount(12345),
+	}, metadata)
+}
+
+func TestInvoiceTextParseService_Parse_Fallbacks(t *testing.T) {
+	text := `請求書 T9876543210987
+屋号 テスト屋
+請求日 2025/06/30
+締め日 2025-06-20
+合計 5,500`
+
+	metadata := NewInvoiceTextParseService().Parse(text)
+
+	assert.Equal(t, "T9876543210987", metadata.RegistrationNumber)
+	assert.Equal(t, "テスト屋", metadata.MerchantName)
+	assert.Equal(t, testDate(2025, time.June, 30), metadata.IssueDate)
+	assert.Equal(t, testDate(2025, time.June, 20), metadata.CutoffDate)
+	require.NotNil(t, metadata.TotalAmount)
+	assert.Equal(t, 5500.0, *metadata.TotalAmount)
+	assert.Nil(t, metadata.TaxAmount)
+	assert.Empty(t, metadata.MerchantID)
+}
+
+func TestInvoiceTextParseService_Parse_NoText(t *testing.T) {
+	// Scanned invoices have no text layer
+	assert.Equal(t, InvoiceMetadata{}, NewInvoiceTextParseService().Parse(""))
+}
+
+// buildPDF writes the objects as "1 0 obj", "2 0 obj" and so on, object 1 being the catalog, followed by their xref table
+func buildPDF(objects ...string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n\r\n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func flateStream(t *testing.T, data string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return fmt.Sprintf("<< /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream", buf.Len(), buf.Bytes())
+}
+
+// cidFont maps the characters of text to consecutive 2-byte codes, the way subset CID fonts are embedded
+type cidFont map[rune]int
+
+func newCIDFont(text string) cidFont {
+	f := cidFont{}
+	for _, r := range text {
+		if _, ok := f[r]; !ok {
+			f[r] = len(f) + 1
+		}
+	}
+	return f
+}
+
+// hex returns s as a hex string of the codes of the font
+func (f cidFont) hex(s string) string {
+	var sb strings.Builder
+	sb.WriteString("<")
+	for _, r := range s {
+		fmt.Fprintf(&sb, "%04X", f[r])
+	}
+	sb.WriteString(">")
+	return sb.String()
+}
+
+func (f cidFont) toUnicode() string {
+	var sb strings.Builder
+	sb.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	sb.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	fmt.Fprintf(&sb, "%d beginbfchar\n", len(f))
+	for r, code := range f {
+		fmt.Fprintf(&sb, "<%04X> <", code)
+		for _, unit := range utf16.Encode([]rune{r}) {
+			fmt.Fprintf(&sb, "%04X", unit)
+		}
+		sb.WriteString(">\n")
+	}
+	sb.WriteString("endbfchar\nendcmap\nend\nend\n")
+	return sb.String()
+}
+
+func TestInvoiceTextParseService_ExtractText(t *testing.T) {
+	font := newCIDFont("適格請求書株式会社テスト商店御中登録番号発行日ご請求金額")
+	content := strings.Join([]string{
+		"BT /F1 16 Tf 1 0 0 1 50 800 Tm " + font.hex("適格請求書") + " Tj ET",
+		// The addressee and 御中 are drawn apart on the same line
+		"BT /F1 10 Tf 50 760 Td " + font.hex("株式会社テスト商店") + " Tj 120 0 Td " + font.hex("御中") + " Tj ET",
+		// Values drawn in another font, and lines drawn out of order
+		"BT /F1 10 Tf 50 720 Td " + font.hex("発行日") + " Tj /F2 10 Tf 60 0 Td (2025/06/03) Tj ET",
+		"BT /F1 10 Tf 50 740 Td [" + font.hex("登録番号") + "] TJ /F2 10 Tf 60 0 Td (T1234567890123) Tj ET",
+		"BT /F1 10 Tf 50 700 Td " + font.hex("ご請求金額") + " Tj 0 -20 Td /F2 10 Tf (12,345) Tj ET",
+	}, "\n")
+
+	pdf := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 8 0 R] /Count 2 /Resources << /Font << /F1 5 0 R /F2 7 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		flateStream(t, content),
+		"<< /Type /Font /Subtype /Type0 /BaseFont /Subset /Encoding /Identity-H /ToUnicode 6 0 R >>",
+		flateStream(t, font.toUnicode()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 9 0 R >>",
+		flateStream(t, "BT /F2 10 Tf 50 700 Td (Page 2) Tj ET"),
+	)
+
+	svc := NewInvoiceTextParseService()
+	text, err := svc.ExtractText(pdf)
+	require.NoError(t, err)
+	assert.Equal(t, "適格請求書\n株式会社テスト商店 御中\n登録番号 T1234567890123\n発行日 2025/06/03\nご請求金額\n12,345\nPage 2", text)
+
+	metadata := svc.Parse(text)
+	assert.Equal(t, "T1234567890123", metadata.RegistrationNumber)
+	assert.Equal(t, "株式会社テスト商店", metadata.MerchantName)
+	assert.Equal(t, testDate(2025, time.June, 3), metadata.IssueDate)
+	assert.Equal(t, testAmount(12345), metadata.TotalAmount)
+}
+
+func TestInvoiceTextParseService_ExtractText_NoText(t *testing.T) {
+	// A scanned invoice only draws an image
+	pdf := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		flateStream(t, "q 600 0 0 800 0 0 cm /Im1 Do Q"),
+	)
+
+	text, err := NewInvoiceTextParseService().ExtractText(pdf)
+	require.NoError(t, err)
+	assert.Empty(t, text)
+}
+
+func TestInvoiceTextParseService_ExtractText_NotPDF(t *testing.T) {
+	_, err := NewInvoiceTextParseService().ExtractText([]byte("PK\x03\x04"))
+	assert.Error(t, err)
+
+	truncated := buildPDF("<< /Type /Catalog /Pages 2 0 R >>")
+	_, err = NewInvoiceTextParseService().ExtractText(truncated[:len(truncated)/2])
+	assert.Error(t, err)
+}
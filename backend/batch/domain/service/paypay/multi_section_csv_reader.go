@@ -0,0 +1,97 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"log"
+	"strings"
+
+	object "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// utf8BOM is written at the start of the reports by some spreadsheet exports
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// CSVSection is a section of a multi-section CSV: a header record followed by its data records
+type CSVSection struct {
+	FileType object.PayinFileType // PayinFileTypePaymentSummary or PayinFileTypePaymentDetail
+	Headers  []string
+	Records  [][]string
+	Lines    []int // line each record starts on
+}
+
+// ReadSections reads a multi-section CSV such as the PayPay top-up report. Quoted fields, including fields with
+// commas or line breaks, are read as specified by RFC 4180. A section starts at a record that holds all the required
+// headers of the summary or the detail section and runs until the next section header; blank records are skipped,
+// and records before the first section header are ignored.
+func (s *MultiSectionCSVImportService) ReadSections(r io.Reader) ([]CSVSection, error) {
+	reader := csv.NewReader(skipBOM(r))
+	reader.FieldsPerRecord = -1 // sections have different widths
+
+	var sections []CSVSection
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+
+		if fileType, headers, ok := s.recognizeSectionHeader(record); ok {
+			sections = append(sections, CSVSection{FileType: fileType, Headers: headers})
+			continue
+		}
+		if len(sections) == 0 {
+			line, _ := reader.FieldPos(0)
+			log.Printf("[MultiSectionCSVImportService] Ignoring line %d before the first section header", line)
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		current := &sections[len(sections)-1]
+		current.Records = append(current.Records, record)
+		current.Lines = append(current.Lines, line)
+	}
+	return sections, nil
+}
+
+// recognizeSectionHeader reports whether record is the header of a summary or a detail section and returns its
+// trimmed headers
+func (s *MultiSectionCSVImportService) recognizeSectionHeader(record []string) (object.PayinFileType, []string, bool) {
+	headers := make([]string, len(record))
+	normHeaders := make([]string, len(record))
+	for i, field := range record {
+		headers[i] = strings.TrimSpace(field)
+		normHeaders[i] = s.HeaderMapping.NormalizeHeader(headers[i])
+	}
+
+	for _, fileType := range []object.PayinFileType{object.PayinFileTypePaymentSummary, object.PayinFileTypePaymentDetail} {
+		if isValid, _ := s.Validator.ValidateHeaders(normHeaders, fileType); isValid {
+			return fileType, headers, true
+		}
+	}
+	return 0, nil, false
+}
+
+// skipBOM drops a leading UTF-8 byte order mark, which would otherwise stick to the first header
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if prefix, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(prefix, utf8BOM) {
+		_, _ = br.Discard(len(utf8BOM))
+	}
+	return br
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	object "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSummaryHeader = "支払日,取引額,入金手数料,支払金額,締め日,返金額,利用料,プラットフォーム使用料,初期費用,税,キャッシュバック,調整額,法人名"
+	testDetailHeader  = "加盟店ID,屋号,締め日,取引額,返金額,利用料,プラットフォーム使用料,初期費用,税,キャッシュバック,調整額,入金手数料,支払金額"
+)
+
+func newTestMultiSectionService(summaries, details *[]object.PayinFileRow) *MultiSectionCSVImportService {
+	return NewMultiSectionCSVImportService(
+		func(ctx context.Context, payinFileID int, rows []object.PayinFileRow, policy object.PayinImportErrorPolicy) ([]*model.PayinImportError, error) {
+			*summaries = append(*summaries, rows...)
+			return nil, nil
+		},
+		func(ctx context.Context, payinFileID int, rows []object.PayinFileRow, policy object.PayinImportErrorPolicy) ([]*model.PayinImportError, error) {
+			*details = append(*details, rows...)
+			var importErrors []*model.PayinImportError
+			for _, row := range rows {
+				if row.Values["fee"] == "x" {
+					importErrors = append(importErrors, model.NewPayinImportError(payinFileID, row.Line, "fee", "x", "invalid"))
+				}
+			}
+			return importErrors, nil
+		},
+	)
+}
+
+func TestMultiSectionCSVImportService_ReadSections(t *testing.T) {
+	s := newTestMultiSectionService(&[]object.PayinFileRow{}, &[]object.PayinFileRow{})
+	content := "\ufeff" + testSummaryHeader + "\r\n" +
+		"2025/05/31,1000,10,990,2025/05/20,0,0,0,0,0,0,0,\"株式会社テスト, 東京\"\r\n" +
+		"\r\n" +
+		",,,\r\n" +
+		testDetailHeader + "\r\n" +
+		"M001,\"カフェ \"\"まるいち\"\"\",2025/05/20,600,0,0,0,0,0,0,0,6,594\r\n" +
+		"M002,\"ベーカリー\n2号店\",2025/05/20,400,0,0,0,0,0,0,0,4,396\r\n"
+
+	sections, err := s.ReadSections(strings.NewReader(content))
+	require.NoError(t, err)
+	require.Len(t, sections, 2)
+
+	assert.Equal(t, object.PayinFileTypePaymentSummary, sections[0].FileType)
+	assert.Equal(t, "支払日", sections[0].Headers[0])
+	require.Len(t, sections[0].Records, 1)
+	assert.Equal(t, "株式会社テスト, 東京", sections[0].Records[0][12])
+
+	assert.Equal(t, object.PayinFileTypePaymentDetail, sections[1].FileType)
+	require.Len(t, sections[1].Records, 2)
+	assert.Equal(t, `カフェ "まるいち"`, sections[1].Records[0][1])
+	assert.Equal(t, "ベーカリー\n2号店", sections[1].Records[1][1])
+	assert.Equal(t, []int{2}, sections[0].Lines)
+	assert.Equal(t, []int{6, 7}, sections[1].Lines)
+}
+
+func TestMultiSectionCSVImportService_ReadSections_HeaderPosition(t *testing.T) {
+	s := newTestMultiSectionService(&[]object.PayinFileRow{}, &[]object.PayinFileRow{})
+	// A title line before the first header and a detail section without a summary section
+	content := "入金明細レポート\n" + testDetailHeader + "\nM001,カフェ,2025/05/20,600,0,0,0,0,0,0,0,6,594\n"
+
+	sections, err := s.ReadSections(strings.NewReader(content))
+	require.NoError(t, err)
+	require.Len(t, sections, 1)
+	assert.Equal(t, object.PayinFileTypePaymentDetail, sections[0].FileType)
+	assert.Len(t, sections[0].Records, 1)
+}
+
+func TestMultiSectionCSVImportService_ReadSections_Malformed(t *testing.T) {
+	s := newTestMultiSectionService(&[]object.PayinFileRow{}, &[]object.PayinFileRow{})
+
+	_, err := s.ReadSections(strings.NewReader(testSummaryHeader + "\n\"unterminated,1000\n"))
+	var parseErr *csv.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+}
+
+func TestMultiSectionCSVImportService_ProcessReader(t *testing.T) {
+	var summaries, details []object.PayinFileRow
+	s := newTestMultiSectionService(&summaries, &details)
+	content := testSummaryHeader + "\n" +
+		"2025/05/31,1000,10,990,2025/05/20,0,0,0,0,0,0,0,\"株式会社テスト, 東京\"\n" +
+		testDetailHeader + "\n" +
+		"M001,\"カフェ, 本店\",2025/05/20,1000,0,0,0,0,0,0,0,10\n" +
+		"M002,ベーカリー,2025/05/20,500,0,0,0,0,0,0,0,x,495\n"
+
+	importErrors, err := s.ProcessReader(context.Background(), 1, strings.NewReader(content), object.PayinImportErrorPolicyWarn)
+	require.NoError(t, err)
+
+	require.Len(t, summaries, 1)
+	assert.Equal(t, 2, summaries[0].Line)
+	assert.Equal(t, "株式会社テスト, 東京", summaries[0].Values["corporate_name"])
+	assert.Equal(t, "990", summaries[0].Values["amount"])
+
+	// A short record leaves the missing columns unset
+	require.Len(t, details, 2)
+	assert.Equal(t, 4, details[0].Line)
+	assert.Equal(t, "カフェ, 本店", details[0].Values["merchant_business_name"])
+	assert.Equal(t, "10", details[0].Values["fee"])
+	assert.NotContains(t, details[0].Values, "amount")
+
+	// The import errors of the sections are returned with the line of their row
+	require.Len(t, importErrors, 1)
+	assert.Equal(t, 5, importErrors[0].LineNumber)
+	assert.Equal(t, "fee", importErrors[0].ColumnName)
+
+	_, err = s.ProcessReader(context.Background(), 1, strings.NewReader("a,b,c\n1,2,3\n"), object.PayinImportErrorPolicyWarn)
+	assert.ErrorIs(t, err, ErrNoCSVSection)
+}
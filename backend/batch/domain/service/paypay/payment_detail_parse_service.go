@@ -0,0 +1,118 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/paypay"
+	"golang.org/x/text/width"
+)
+
+var (
+	ErrInvalidPaymentDetail = errors.New("invalid payment detail")
+	ErrUnknownPaymentMethod = errors.New("unknown payment method")
+)
+
+var (
+	thousandsSeparatorPattern = regexp.MustCompile(`(\d),(\d{3})`)
+	paymentDetailSeparator    = regexp.MustCompile(`[,/;|\n、]`)
+	paymentDetailItemPattern  = regexp.MustCompile(`^(.*?)\s*[:=(]?\s*[¥\\]?\s*(-?\d+(?:\.\d+)?)?\s*円?\s*\)?$`)
+)
+
+// PaymentDetailParseService parses the 支払い詳細 column of the transactions into a breakdown by payment method
+type PaymentDetailParseService struct {
+	paymentMethods *PaymentMethodMappingService
+}
+
+// NewPaymentDetailParseService creates a new instance of PaymentDetailParseService
+func NewPaymentDetailParseService(paymentMethods *PaymentMethodMappingService) *PaymentDetailParseService {
+	return &PaymentDetailParseService{paymentMethods: paymentMethods}
+}
+
+// Parse reads a payment detail such as "PayPay残高:1,000円/PayPayポイント:200円", or a JSON object of the amounts
+// by label. A single payment method without an amount, such as "PayPay残高", is paid for the whole transaction
+// amount. It returns nil when the value is empty.
+func (s *PaymentDetailParseService) Parse(value string, transactionAmount float64) (*model.PaymentDetail, error) {
+	value = strings.TrimSpace(width.Fold.String(value))
+	if value == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(value, "{") {
+		return s.parseJSON(value)
+	}
+
+	// Drop the thousands separators first, so that the commas left separate the items
+	for {
+		replaced := thousandsSeparatorPattern.ReplaceAllString(value, "$1$2")
+		if replaced == value {
+			break
+		}
+		value = replaced
+	}
+
+	var segments []string
+	for _, segment := range paymentDetailSeparator.Split(value, -1) {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	detail := &model.PaymentDetail{}
+	for _, segment := range segments {
+		match := paymentDetailItemPattern.FindStringSubmatch(segment)
+		if match == nil || match[1] == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPaymentDetail, segment)
+		}
+
+		method, err := s.paymentMethod(match[1])
+		if err != nil {
+			return nil, err
+		}
+
+		amount := transactionAmount
+		if match[2] != "" {
+			amount, _ = strconv.ParseFloat(match[2], 64)
+		} else if len(segments) > 1 {
+			// The amount of each method of a split payment cannot be known
+			return nil, fmt.Errorf("%w: no amount for %q", ErrInvalidPaymentDetail, segment)
+		}
+
+		detail.Items = append(detail.Items, model.PaymentDetailItem{PaymentMethod: method, Amount: amount})
+	}
+	return detail, nil
+}
+
+func (s *PaymentDetailParseService) parseJSON(value string) (*model.PaymentDetail, error) {
+	var amounts map[string]float64
+	if err := json.Unmarshal([]byte(value), &amounts); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPaymentDetail, err)
+	}
+
+	detail := &model.PaymentDetail{}
+	for label, amount := range amounts {
+		method, err := s.paymentMethod(label)
+		if err != nil {
+			return nil, err
+		}
+		detail.Items = append(detail.Items, model.PaymentDetailItem{PaymentMethod: method, Amount: amount})
+	}
+	sort.Slice(detail.Items, func(i, j int) bool {
+		return detail.Items[i].PaymentMethod < detail.Items[j].PaymentMethod
+	})
+	return detail, nil
+}
+
+func (s *PaymentDetailParseService) paymentMethod(label string) (object.PaypayPaymentMethod, error) {
+	method, ok := s.paymentMethods.Map(label)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownPaymentMethod, label)
+	}
+	return method, nil
+}
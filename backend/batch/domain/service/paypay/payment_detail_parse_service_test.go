@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/paypay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentDetailParseService_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []model.PaymentDetailItem
+	}{
+		{
+			name:  "split payment",
+			value: "PayPay残高:1,000円/PayPayポイント:200円",
+			expected: []model.PaymentDetailItem{
+				{PaymentMethod: object.PaymentMethodPayPayBalance, Amount: 1000},
+				{PaymentMethod: object.PaymentMethodPayPayPoint, Amount: 200},
+			},
+		},
+		{
+			name:  "fullwidth with brackets",
+			value: "ＰａｙＰａｙ（クレジット）（１，２００円）、PayPay商品券 ￥300",
+			expected: []model.PaymentDetailItem{
+				{PaymentMethod: object.PaymentMethodPayPayCredit, Amount: 1200},
+				{PaymentMethod: object.PaymentMethodPayPayGiftCard, Amount: 300},
+			},
+		},
+		{
+			name:  "comma separated",
+			value: "クレジットカード=1,234,567,LINE Pay=100",
+			expected: []model.PaymentDetailItem{
+				{PaymentMethod: object.PaymentMethodCreditCard, Amount: 1234567},
+				{PaymentMethod: object.PaymentMethodLinePay, Amount: 100},
+			},
+		},
+		{
+			name:  "single method without amount",
+			value: "PayPay(残高)",
+			expected: []model.PaymentDetailItem{
+				{PaymentMethod: object.PaymentMethodPayPayBalance, Amount: 1500},
+			},
+		},
+		{
+			name:  "json",
+			value: `{"PayPayポイント": 50, "PayPay銀行残高": 1450}`,
+			expected: []model.PaymentDetailItem{
+				{PaymentMethod: object.PaymentMethodPayPayPoint, Amount: 50},
+				{PaymentMethod: object.PaymentMethodPayPayBankBalance, Amount: 1450},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail, err := NewPaymentDetailParseService(NewPaymentMethodMappingService(nil)).Parse(tt.value, 1500)
+
+			require.NoError(t, err)
+			require.NotNil(t, detail)
+			assert.Equal(t, tt.expected, detail.Items)
+		})
+	}
+}
+
+func TestPaymentDetailParseService_Parse_Empty(t *testing.T) {
+	detail, err := NewPaymentDetailParseService(NewPaymentMethodMappingService(nil)).Parse("  ", 1500)
+
+	require.NoError(t, err)
+	assert.Nil(t, detail)
+}
+
+func TestPaymentDetailParseService_Parse_Errors(t *testing.T) {
+	service := NewPaymentDetailParseService(NewPaymentMethodMappingService(nil))
+
+	_, err := service.Parse("PayPay残高:1000/謎の支払い:500", 1500)
+	assert.ErrorIs(t, err, ErrUnknownPaymentMethod)
+
+	_, err = service.Parse("PayPay残高/PayPayポイント", 1500)
+	assert.ErrorIs(t, err, ErrInvalidPaymentDetail)
+
+	_, err = service.Parse(":1000", 1000)
+	assert.ErrorIs(t, err, ErrInvalidPaymentDetail)
+
+	_, err = service.Parse(`{"PayPay残高": "abc"}`, 1000)
+	assert.ErrorIs(t, err, ErrInvalidPaymentDetail)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	object "github.com/huydq/test/internal/domain/object/paypay"
+	"golang.org/x/text/width"
+)
+
+// defaultPaymentMethodLabels maps the normalized labels of the payment methods in the reports to PaypayPaymentMethod
+var defaultPaymentMethodLabels = map[string]object.PaypayPaymentMethod{
+	"paypay残高":    object.PaymentMethodPayPayBalance,
+	"残高":          object.PaymentMethodPayPayBalance,
+	"クレジットカード":    object.PaymentMethodCreditCard,
+	"yahoo!マネー":   object.PaymentMethodYahooMoney,
+	"alipay":      object.PaymentMethodAlipay,
+	"あと払い":        object.PaymentMethodPayLater,
+	"あと払い一括のみ":    object.PaymentMethodPayLater,
+	"paypayあと払い":  object.PaymentMethodPayLater,
+	"プリペイドコード":    object.PaymentMethodPrepaidCode,
+	"linepay":     object.PaymentMethodLinePay,
+	"paypayクレジット": object.PaymentMethodPayPayCredit,
+	"paypay商品券":   object.PaymentMethodPayPayGiftCard,
+	"商品券":         object.PaymentMethodPayPayGiftCard,
+	"paypayポイント":  object.PaymentMethodPayPayPoint,
+	"ポイント":        object.PaymentMethodPayPayPoint,
+	"paypay銀行残高":  object.PaymentMethodPayPayBankBalance,
+	"paypay銀行":    object.PaymentMethodPayPayBankBalance,
+}
+
+var paymentMethodLabelCleaner = strings.NewReplacer(" ", "", "\t", "", "(", "", ")", "", "「", "", "」", "", "・", "")
+
+// PaymentMethodMappingService maps the Japanese labels of the payment methods in the reports to PaypayPaymentMethod
+type PaymentMethodMappingService struct {
+	labels map[string]object.PaypayPaymentMethod
+}
+
+// NewPaymentMethodMappingService creates a new instance of PaymentMethodMappingService. The aliases are added to the
+// default labels, replacing a default label they normalize to.
+func NewPaymentMethodMappingService(aliases map[string]object.PaypayPaymentMethod) *PaymentMethodMappingService {
+	labels := make(map[string]object.PaypayPaymentMethod, len(defaultPaymentMethodLabels)+len(aliases))
+	for label, method := range defaultPaymentMethodLabels {
+		labels[label] = method
+	}
+	for alias, method := range aliases {
+		labels[normalizePaymentMethodLabel(alias)] = method
+	}
+	return &PaymentMethodMappingService{labels: labels}
+}
+
+// Map returns the payment method of a label, ignoring the case, width, spaces and brackets of the label
+func (s *PaymentMethodMappingService) Map(label string) (object.PaypayPaymentMethod, bool) {
+	method, ok := s.labels[normalizePaymentMethodLabel(label)]
+	return method, ok
+}
+
+// ParsePaymentMethodAliases parses configured aliases such as "PayPayカード=8,d払い=1", where each label is mapped to
+// the number of its payment method. An empty value has no aliases.
+func ParsePaymentMethodAliases(value string) (map[string]object.PaypayPaymentMethod, error) {
+	aliases := make(map[string]object.PaypayPaymentMethod)
+	for _, entry := range strings.Split(value, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		label, number, ok := strings.Cut(entry, "=")
+		label = strings.TrimSpace(label)
+		if !ok || label == "" {
+			return nil, fmt.Errorf("invalid payment method alias: %q", entry)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(number))
+		if err != nil || !object.PaypayPaymentMethod(n).IsValid() {
+			return nil, fmt.Errorf("invalid payment method of alias %q: %q", label, number)
+		}
+		aliases[label] = object.PaypayPaymentMethod(n)
+	}
+	return aliases, nil
+}
+
+func normalizePaymentMethodLabel(label string) string {
+	return strings.ToLower(paymentMethodLabelCleaner.Replace(width.Fold.String(strings.TrimSpace(label))))
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"testing"
+
+	object "github.com/huydq/test/internal/domain/object/paypay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentMethodMappingService_Map(t *testing.T) {
+	service := NewPaymentMethodMappingService(nil)
+
+	tests := []struct {
+		label    string
+		expected object.PaypayPaymentMethod
+	}{
+		{label: "PayPay残高", expected: object.PaymentMethodPayPayBalance},
+		{label: "PayPay（残高）", expected: object.PaymentMethodPayPayBalance},
+		{label: " ＰａｙＰａｙ（クレジット） ", expected: object.PaymentMethodPayPayCredit},
+		{label: "LINE Pay", expected: object.PaymentMethodLinePay},
+		{label: "あと払い（一括のみ）", expected: object.PaymentMethodPayLater},
+		{label: "PayPay銀行残高", expected: object.PaymentMethodPayPayBankBalance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			method, ok := service.Map(tt.label)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, method)
+		})
+	}
+
+	_, ok := service.Map("d払い")
+	assert.False(t, ok)
+}
+
+func TestPaymentMethodMappingService_Map_Aliases(t *testing.T) {
+	service := NewPaymentMethodMappingService(map[string]object.PaypayPaymentMethod{
+		"PayPayカード": object.PaymentMethodPayPayCredit,
+		"残高":        object.PaymentMethodPayPayBankBalance,
+	})
+
+	method, ok := service.Map("ＰａｙＰａｙカード")
+	assert.True(t, ok)
+	assert.Equal(t, object.PaymentMethodPayPayCredit, method)
+
+	// An alias replaces the default label
+	method, ok = service.Map("残高")
+	assert.True(t, ok)
+	assert.Equal(t, object.PaymentMethodPayPayBankBalance, method)
+}
+
+func TestParsePaymentMethodAliases(t *testing.T) {
+	aliases, err := ParsePaymentMethodAliases(" PayPayカード=8, d払い = 1 ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]object.PaypayPaymentMethod{
+		"PayPayカード": object.PaymentMethodPayPayCredit,
+		"d払い":       object.PaymentMethodPayPayBalance,
+	}, aliases)
+
+	aliases, err = ParsePaymentMethodAliases("")
+	require.NoError(t, err)
+	assert.Empty(t, aliases)
+
+	for _, value := range []string{"PayPayカード", "=1", "PayPayカード=12", "PayPayカード=x"} {
+		_, err := ParsePaymentMethodAliases(value)
+		assert.Error(t, err, value)
+	}
+}
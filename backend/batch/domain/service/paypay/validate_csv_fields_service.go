@@ -38,11 +38,45 @@ var RequiredPayinDetailHeaders = []string{
 
 var RequiredPayinTransactionHeaders = []string{"payment_transaction_id", "payment_merchant_id", "shop_id", "terminal_code", "payment_transaction_status", "receipt_number", "paypay_payment_method", "merchant_order_id", "merchant_business_name", "shop_name", "transaction_at", "transaction_amount", "payment_detail"}
 
+var RequiredTransactionDetailHeaders = []string{"payment_transaction_id", "payment_merchant_id", "shop_id", "terminal_code", "payment_transaction_status", "receipt_number", "paypay_payment_method", "merchant_order_id", "merchant_business_name", "shop_name", "transaction_at", "transaction_amount"}
+
+// RequiredShippingTransactionDetailHeaders adds the shipping columns of the shipping related reports to the
+// transaction detail headers
+var RequiredShippingTransactionDetailHeaders = append(append([]string{}, RequiredTransactionDetailHeaders...), "shipping_fee", "shipped_at")
+
+var RequiredTransactionSummaryHeaders = []string{
+	"payment_merchant_id",
+	"merchant_business_name",
+	"transaction_date",
+	"transaction_count",
+	"transaction_amount",
+	"refund_count",
+	"refund_amount",
+	"usage_fee",
+}
+
+var RequiredTopUpDetailHeaders = []string{
+	"payment_merchant_id",
+	"merchant_business_name",
+	"cutoff_date",
+	"payment_date",
+	"amount",
+	"bank_name",
+	"branch_name",
+	"account_type",
+	"account_number",
+	"account_holder",
+}
+
 // RequiredCSVHeaders maps file type to required headers (for backward compatibility)
 var RequiredCSVHeaders = map[object.PayinFileType][]string{
-	0: RequiredPayinSummaryHeaders,     // PayinFileTypePaymentSummary (first section)
-	1: RequiredPayinDetailHeaders,      // PayinFileTypePaymentDetail (second section)
-	2: RequiredPayinTransactionHeaders, // PayinFileTypePaymentTransaction (single-section)
+	0: RequiredPayinSummaryHeaders,              // PayinFileTypePaymentSummary (first section)
+	1: RequiredPayinDetailHeaders,               // PayinFileTypePaymentDetail (second section)
+	2: RequiredPayinTransactionHeaders,          // PayinFileTypePaymentTransaction (single-section)
+	3: RequiredTransactionDetailHeaders,         // PayinFileTypeTransactionDetail (single-section)
+	4: RequiredShippingTransactionDetailHeaders, // PayinFileTypeShippingTransactionDetail (single-section)
+	5: RequiredTransactionSummaryHeaders,        // PayinFileTypeTransactionSummary (single-section)
+	6: RequiredTopUpDetailHeaders,               // PayinFileTypeTopUpDetail (single-section)
 }
 
 // ValidateCSVFieldsService provides functionality to validate CSV fields
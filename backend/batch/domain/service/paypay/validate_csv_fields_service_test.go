@@ -0,0 +1,49 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	object "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testTransactionDetailHeader         = "決済番号,加盟店ID,屋号,店舗ID,店舗名,端末番号/PosID,取引ステータス,取引日時,取引金額,レシート番号,支払い方法,加盟店決済ID"
+	testShippingTransactionDetailHeader = testTransactionDetailHeader + ",送料,発送日時"
+	testTransactionSummaryHeader        = "加盟店ID,屋号,取引日,取引件数,取引額,返金件数,返金額,利用料"
+	testTopUpDetailHeader               = "加盟店ID,屋号,締め日,支払日,支払金額,金融機関名,支店名,口座種別,口座番号,口座名義"
+)
+
+func normalizeTestHeaders(header string) []string {
+	mapping := NewCSVHeaderMappingService()
+	headers := strings.Split(header, ",")
+	for i, h := range headers {
+		headers[i] = mapping.NormalizeHeader(h)
+	}
+	return headers
+}
+
+func TestValidateCSVFieldsService_ValidateHeaders_ReportTypes(t *testing.T) {
+	s := NewValidateCSVFieldsService()
+
+	tests := []struct {
+		name     string
+		header   string
+		fileType object.PayinFileType
+		valid    bool
+	}{
+		{"transaction details", testTransactionDetailHeader, object.PayinFileTypeTransactionDetail, true},
+		{"shipping transaction details", testShippingTransactionDetailHeader, object.PayinFileTypeShippingTransactionDetail, true},
+		{"shipping transaction details without shipping columns", testTransactionDetailHeader, object.PayinFileTypeShippingTransactionDetail, false},
+		{"transaction summary", testTransactionSummaryHeader, object.PayinFileTypeTransactionSummary, true},
+		{"top-up details", testTopUpDetailHeader, object.PayinFileTypeTopUpDetail, true},
+		{"top-up details as transaction summary", testTopUpDetailHeader, object.PayinFileTypeTransactionSummary, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, _ := s.ValidateHeaders(normalizeTestHeaders(tt.header), tt.fileType)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+}
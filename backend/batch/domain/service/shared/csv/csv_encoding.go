@@ -0,0 +1,60 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	object "github.com/huydq/test/internal/domain/object/payin"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// sniffSize is how much of the content is inspected to detect its encoding
+const sniffSize = 64 * 1024
+
+// DecodeReader returns a reader of the content transcoded to UTF-8, along with the encoding it is read with.
+// With PayinFileEncodingAuto the encoding is detected from the start of the content: valid UTF-8, with or without
+// a BOM, is read as UTF-8 and anything else as Shift_JIS (CP932).
+func (s *CsvReaderService) DecodeReader(r io.Reader, encoding object.PayinFileEncoding) (io.Reader, object.PayinFileEncoding, error) {
+	br := bufio.NewReaderSize(r, sniffSize)
+	if encoding == object.PayinFileEncodingAuto {
+		prefix, err := br.Peek(sniffSize)
+		if err != nil && err != io.EOF {
+			return nil, encoding, err
+		}
+		encoding = detectEncoding(prefix, err == io.EOF)
+	}
+
+	switch encoding {
+	case object.PayinFileEncodingUTF8:
+		return br, encoding, nil
+	case object.PayinFileEncodingShiftJIS:
+		return transform.NewReader(br, japanese.ShiftJIS.NewDecoder()), encoding, nil
+	default:
+		return nil, encoding, fmt.Errorf("unsupported payin file encoding: %q", encoding)
+	}
+}
+
+// detectEncoding tells UTF-8 from Shift_JIS. complete is false when prefix is only the start of the content, in
+// which case a character cut at the end of prefix is ignored.
+func detectEncoding(prefix []byte, complete bool) object.PayinFileEncoding {
+	if bytes.HasPrefix(prefix, []byte("\uFEFF")) {
+		return object.PayinFileEncodingUTF8
+	}
+	if !complete {
+		start := len(prefix) - 1
+		for start > 0 && len(prefix)-start < utf8.UTFMax && !utf8.RuneStart(prefix[start]) {
+			start--
+		}
+		if start >= 0 && !utf8.FullRune(prefix[start:]) {
+			prefix = prefix[:start]
+		}
+	}
+	if utf8.Valid(prefix) {
+		return object.PayinFileEncodingUTF8
+	}
+	return object.PayinFileEncodingShiftJIS
+}
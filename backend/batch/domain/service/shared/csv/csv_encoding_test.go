@@ -0,0 +1,116 @@
+package service
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	object "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func toShiftJIS(t *testing.T, s string) string {
+	t.Helper()
+
+	encoded, err := japanese.ShiftJIS.NewEncoder().String(s)
+	require.NoError(t, err)
+	return encoded
+}
+
+func decodeAll(t *testing.T, content string, encoding object.PayinFileEncoding) (string, object.PayinFileEncoding) {
+	t.Helper()
+
+	r, detected, err := NewCsvReaderService().DecodeReader(strings.NewReader(content), encoding)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(data), detected
+}
+
+func TestCsvReaderService_DecodeReader_Detection(t *testing.T) {
+	content := "決済番号,加盟店ID,屋号\n1001,M001,髙橋商店①\n"
+
+	decoded, detected := decodeAll(t, content, object.PayinFileEncodingAuto)
+	assert.Equal(t, object.PayinFileEncodingUTF8, detected)
+	assert.Equal(t, content, decoded)
+
+	// CP932 extensions such as circled digits are decoded too
+	decoded, detected = decodeAll(t, toShiftJIS(t, content), object.PayinFileEncodingAuto)
+	assert.Equal(t, object.PayinFileEncodingShiftJIS, detected)
+	assert.Equal(t, content, decoded)
+
+	decoded, detected = decodeAll(t, "\uFEFF"+content, object.PayinFileEncodingAuto)
+	assert.Equal(t, object.PayinFileEncodingUTF8, detected)
+	assert.Equal(t, "\uFEFF"+content, decoded)
+
+	_, detected = decodeAll(t, "", object.PayinFileEncodingAuto)
+	assert.Equal(t, object.PayinFileEncodingUTF8, detected)
+}
+
+func TestCsvReaderService_DecodeReader_CharacterCutAtSniffSize(t *testing.T) {
+	// The sniffed prefix ends in the middle of a three byte character
+	content := strings.Repeat("a", sniffSize-1) + "決済番号\n"
+
+	decoded, detected := decodeAll(t, content, object.PayinFileEncodingAuto)
+	assert.Equal(t, object.PayinFileEncodingUTF8, detected)
+	assert.Equal(t, content, decoded)
+}
+
+func TestCsvReaderService_DecodeReader_Configured(t *testing.T) {
+	// ASCII only content would be detected as UTF-8 but is read with the configured encoding
+	_, detected := decodeAll(t, "a,b\n1,2\n", object.PayinFileEncodingShiftJIS)
+	assert.Equal(t, object.PayinFileEncodingShiftJIS, detected)
+
+	_, _, err := NewCsvReaderService().DecodeReader(strings.NewReader("a"), object.PayinFileEncoding("EUC-JP"))
+	assert.Error(t, err)
+}
+
+func TestCsvReaderService_ReadWithHeader_ShiftJIS(t *testing.T) {
+	content := toShiftJIS(t, "決済番号,加盟店ID,屋号\n1001,M001,\"カフェ, 本店\"\n")
+	s := NewCsvReaderService()
+
+	r, _, err := s.DecodeReader(strings.NewReader(content), object.PayinFileEncodingAuto)
+	require.NoError(t, err)
+	records, err := s.ReadWithHeader(r)
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, 2, records[0].Line)
+	assert.Equal(t, "1001", records[0].Values["payment_transaction_id"])
+	assert.Equal(t, "カフェ, 本店", records[0].Values["merchant_business_name"])
+}
+
+func TestCsvReaderService_ReadWithHeader_Lines(t *testing.T) {
+	content := "決済番号,加盟店ID,屋号\n1001,M001,\"ベーカリー\n2号店\"\n1002,M002\n1003,M003,カフェ\n"
+
+	records, err := NewCsvReaderService().ReadWithHeader(strings.NewReader(content))
+	require.NoError(t, err)
+
+	// A short record is padded and the records after it are still read
+	require.Len(t, records, 3)
+	assert.Equal(t, []int{2, 4, 5}, []int{records[0].Line, records[1].Line, records[2].Line})
+	assert.Equal(t, "", records[1].Values["merchant_business_name"])
+	assert.Equal(t, "1003", records[2].Values["payment_transaction_id"])
+
+	_, err = NewCsvReaderService().ReadWithHeader(strings.NewReader("決済番号,屋号\n1001,\"unterminated\n"))
+	assert.Error(t, err)
+}
+
+func TestParsePayinFileEncoding(t *testing.T) {
+	for name, expected := range map[string]object.PayinFileEncoding{
+		"":          object.PayinFileEncodingAuto,
+		"auto":      object.PayinFileEncodingAuto,
+		"UTF-8":     object.PayinFileEncodingUTF8,
+		"cp932":     object.PayinFileEncodingShiftJIS,
+		"Shift_JIS": object.PayinFileEncodingShiftJIS,
+	} {
+		encoding, err := object.ParsePayinFileEncoding(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, encoding, name)
+	}
+
+	_, err := object.ParsePayinFileEncoding("latin1")
+	assert.Error(t, err)
+}
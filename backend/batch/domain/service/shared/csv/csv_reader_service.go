@@ -7,20 +7,36 @@ import (
 	"strings"
 
 	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	object "github.com/huydq/test/internal/domain/object/payin"
 )
 
+// HeaderMapping normalizes the headers of a CSV and keys its records by them
+type HeaderMapping interface {
+	MapRows(headers []string, records [][]string, lines []int) ([]string, []object.PayinFileRow)
+}
+
 // CsvReaderService provides functionality to read and process CSV data
-type CsvReaderService struct{}
+type CsvReaderService struct {
+	HeaderMapping HeaderMapping
+}
 
-// NewCsvReaderService creates a new instance of CsvReaderService
+// NewCsvReaderService creates a new instance of CsvReaderService reading the headers of the PayPay reports
 func NewCsvReaderService() *CsvReaderService {
-	return &CsvReaderService{}
+	return NewCsvReaderServiceWithHeaderMapping(paypayService.NewCSVHeaderMappingService())
+}
+
+// NewCsvReaderServiceWithHeaderMapping creates a new instance of CsvReaderService normalizing the headers with the mapping
+func NewCsvReaderServiceWithHeaderMapping(headerMapping HeaderMapping) *CsvReaderService {
+	return &CsvReaderService{HeaderMapping: headerMapping}
 }
 
-// ReadWithHeader reads a CSV from io.Reader and returns records as []map[string]string
-func (s *CsvReaderService) ReadWithHeader(r io.Reader) ([]map[string]string, error) {
+// ReadWithHeader reads a CSV from io.Reader and returns its records keyed by normalized header, with the line each
+// record starts on
+func (s *CsvReaderService) ReadWithHeader(r io.Reader) ([]object.PayinFileRow, error) {
 	var records [][]string
+	var lines []int
 	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // short records are padded below instead of ending the read
 	headers, err := reader.Read()
 	if err != nil {
 		return nil, err
@@ -35,16 +51,20 @@ func (s *CsvReaderService) ReadWithHeader(r io.Reader) ([]map[string]string, err
 	log.Printf("[DEBUG] CSV headers: %v", headers)
 	for {
 		record, err := reader.Read()
-		if err != nil {
+		if err == io.EOF {
 			break
 		}
+		if err != nil {
+			return nil, err
+		}
 		for len(record) < len(headers) {
 			record = append(record, "")
 		}
+		line, _ := reader.FieldPos(0)
 		records = append(records, record)
+		lines = append(lines, line)
 	}
 	// Map headers from Japanese to normalized json (english) form
-	mappingService := paypayService.NewCSVHeaderMappingService()
-	_, mappedRecords := mappingService.MapHeaders(headers, records)
-	return mappedRecords, nil
+	_, rows := s.HeaderMapping.MapRows(headers, records, lines)
+	return rows, nil
 }
\ No newline at end of file
@@ -0,0 +1,151 @@
+package aozora
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// API paths relative to AozoraConfig.BaseURL
+const (
+	WhitelistPath      = "/transfer/whitelist"
+	TransferPath       = "/transfer/request"
+	TransferStatusPath = "/transfer/status"
+)
+
+const (
+	accessTokenHeader    = "x-access-token"
+	idempotencyKeyHeader = "Idempotency-Key"
+
+	defaultTimeout = 30 * time.Second
+)
+
+type AozoraClient struct {
+	Config     AozoraConfig
+	httpClient *http.Client
+}
+
+func NewAozoraClient(cfg AozoraConfig) AozoraTransferService {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &AozoraClient{
+		Config:     cfg,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *AozoraClient) RegisterWhitelist(ctx context.Context, beneficiary Beneficiary) (*WhitelistResponse, error) {
+	request := WhitelistRequest{
+		AccountID:   c.Config.AccountID,
+		Beneficiary: beneficiary,
+	}
+
+	var response WhitelistResponse
+	if err := c.do(ctx, http.MethodPost, WhitelistPath, nil, request, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *AozoraClient) RequestTransfer(ctx context.Context, idempotencyKey string, request TransferRequest) (*TransferResponse, error) {
+	if idempotencyKey == "" {
+		return nil, fmt.Errorf("idempotency key is required")
+	}
+	if request.AccountID == "" {
+		request.AccountID = c.Config.AccountID
+	}
+
+	headers := map[string]string{idempotencyKeyHeader: idempotencyKey}
+
+	var response TransferResponse
+	if err := c.do(ctx, http.MethodPost, TransferPath, nil, request, headers, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *AozoraClient) GetTransferStatus(ctx context.Context, applyNo string) (*TransferStatusResponse, error) {
+	query := url.Values{}
+	query.Set("accountId", c.Config.AccountID)
+	query.Set("queryKeyClass", "1")
+	query.Set("applyNo", applyNo)
+
+	var response TransferStatusResponse
+	if err := c.do(ctx, http.MethodGet, TransferStatusPath, query, nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// do sends a JSON request to the bank API and decodes the response into out
+func (c *AozoraClient) do(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	body any,
+	headers map[string]string,
+	out any,
+) error {
+	endpoint := strings.TrimRight(c.Config.BaseURL, "/") + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(accessTokenHeader, c.Config.AccessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call aozora api %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read aozora api response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(respBody, &apiErr.ErrorResponse); err != nil {
+			apiErr.ErrorMessage = strings.TrimSpace(string(respBody))
+		}
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode aozora api response: %w", err)
+	}
+	return nil
+}
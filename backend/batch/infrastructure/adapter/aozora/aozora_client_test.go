@@ -0,0 +1,174 @@
+package aozora
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccessToken = "test-token"
+	testAccountID   = "101011234567"
+)
+
+var testBeneficiary = Beneficiary{
+	BankCode:        "0310",
+	BranchCode:      "101",
+	AccountTypeCode: AccountTypeCodeOrdinary,
+	AccountNumber:   "1234567",
+	Name:            "ﾃｽﾄ ｼﾖｳﾃﾝ",
+}
+
+func newTestClient(t *testing.T) (AozoraTransferService, *FakeBankServer) {
+	t.Helper()
+
+	bank := NewFakeBankServer(testAccessToken, testAccountID)
+	server := httptest.NewServer(bank)
+	t.Cleanup(server.Close)
+
+	client := NewAozoraClient(AozoraConfig{
+		BaseURL:     server.URL,
+		AccessToken: testAccessToken,
+		AccountID:   testAccountID,
+		Timeout:     5 * time.Second,
+	})
+	return client, bank
+}
+
+func newTestTransferRequest() TransferRequest {
+	return TransferRequest{
+		TransferDesignatedDate: "2025-05-20",
+		TotalCount:             "1",
+		TotalAmount:            "15000",
+		Transfers: []TransferItem{
+			{ItemID: "1", TransferAmount: "15000", Beneficiary: testBeneficiary},
+		},
+	}
+}
+
+func TestAozoraClient_TransferFlow(t *testing.T) {
+	client, bank := newTestClient(t)
+	ctx := context.Background()
+
+	whitelist, err := client.RegisterWhitelist(ctx, testBeneficiary)
+	require.NoError(t, err)
+	assert.True(t, whitelist.Registered)
+	assert.NotEmpty(t, whitelist.BeneficiaryID)
+	assert.True(t, bank.IsWhitelisted(testBeneficiary))
+
+	transfer, err := client.RequestTransfer(ctx, "key-1", newTestTransferRequest())
+	require.NoError(t, err)
+	assert.Equal(t, ResultCodeCompleted, transfer.ResultCode)
+	assert.Equal(t, testAccountID, transfer.AccountID)
+	require.NotEmpty(t, transfer.ApplyNo)
+
+	status, err := client.GetTransferStatus(ctx, transfer.ApplyNo)
+	require.NoError(t, err)
+	require.Len(t, status.TransferDetails, 1)
+	assert.Equal(t, TransferStatusCodeProcessing, status.TransferDetails[0].TransferStatus)
+	assert.Equal(t, "15000", status.TransferDetails[0].TransferAmount)
+	assert.Empty(t, status.TransferDetails[0].TransferExecutedDatetime)
+
+	require.NoError(t, bank.CompleteTransfer(transfer.ApplyNo, time.Date(2025, 5, 20, 10, 30, 0, 0, time.UTC)))
+
+	status, err = client.GetTransferStatus(ctx, transfer.ApplyNo)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusCodeCompleted, status.TransferDetails[0].TransferStatus)
+	assert.Equal(t, "2025-05-20T19:30:00+09:00", status.TransferDetails[0].TransferExecutedDatetime)
+}
+
+func TestAozoraClient_RequestTransferIsIdempotent(t *testing.T) {
+	client, bank := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.RegisterWhitelist(ctx, testBeneficiary)
+	require.NoError(t, err)
+
+	first, err := client.RequestTransfer(ctx, "key-1", newTestTransferRequest())
+	require.NoError(t, err)
+	second, err := client.RequestTransfer(ctx, "key-1", newTestTransferRequest())
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ApplyNo, second.ApplyNo)
+	assert.Equal(t, 1, bank.TransferCount())
+
+	third, err := client.RequestTransfer(ctx, "key-2", newTestTransferRequest())
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ApplyNo, third.ApplyNo)
+	assert.Equal(t, 2, bank.TransferCount())
+}
+
+func TestAozoraClient_RequestTransferRequiresIdempotencyKey(t *testing.T) {
+	client, bank := newTestClient(t)
+
+	_, err := client.RequestTransfer(context.Background(), "", newTestTransferRequest())
+	require.Error(t, err)
+	assert.Equal(t, 0, bank.TransferCount())
+}
+
+func TestAozoraClient_Errors(t *testing.T) {
+	t.Run("beneficiary not whitelisted", func(t *testing.T) {
+		client, _ := newTestClient(t)
+
+		_, err := client.RequestTransfer(context.Background(), "key-1", newTestTransferRequest())
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.Equal(t, FakeErrorCodeNotWhitelisted, apiErr.ErrorCode)
+		assert.True(t, apiErr.IsClientError())
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		client, bank := newTestClient(t)
+		bank.FailNext(WhitelistPath, http.StatusServiceUnavailable)
+
+		_, err := client.RegisterWhitelist(context.Background(), testBeneficiary)
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		assert.False(t, apiErr.IsClientError())
+		assert.False(t, bank.IsWhitelisted(testBeneficiary))
+	})
+
+	t.Run("invalid access token", func(t *testing.T) {
+		bank := NewFakeBankServer(testAccessToken, testAccountID)
+		server := httptest.NewServer(bank)
+		defer server.Close()
+
+		client := NewAozoraClient(AozoraConfig{BaseURL: server.URL, AccessToken: "wrong", AccountID: testAccountID})
+		_, err := client.GetTransferStatus(context.Background(), "0000000000000001")
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+		assert.Equal(t, FakeErrorCodeUnauthorized, apiErr.ErrorCode)
+	})
+
+	t.Run("unknown transfer", func(t *testing.T) {
+		client, _ := newTestClient(t)
+
+		_, err := client.GetTransferStatus(context.Background(), "9999999999999999")
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	})
+}
+
+func TestAozoraClient_ContextCancelled(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.RegisterWhitelist(ctx, testBeneficiary)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
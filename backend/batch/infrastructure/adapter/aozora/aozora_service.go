@@ -0,0 +1,156 @@
+package aozora
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type AozoraConfig struct {
+	BaseURL     string
+	AccessToken string
+	AccountID   string
+	Timeout     time.Duration
+}
+
+// Account type codes used by the bank API
+const (
+	AccountTypeCodeOrdinary = "1" // 普通
+	AccountTypeCodeCurrent  = "2" // 当座
+	AccountTypeCodeSavings  = "4" // 貯蓄
+	AccountTypeCodeOther    = "9" // その他
+)
+
+// Result codes returned by the transfer request API
+const (
+	ResultCodeCompleted  = "1" // 完了
+	ResultCodeIncomplete = "2" // 未完了
+)
+
+// Transfer status codes returned by the transfer status inquiry API
+const (
+	TransferStatusCodeApplying         = "2"  // 申請中
+	TransferStatusCodeRemanded         = "3"  // 差戻
+	TransferStatusCodeWithdrawn        = "4"  // 取下げ
+	TransferStatusCodeExpired          = "5"  // 期限切れ
+	TransferStatusCodeCancelled        = "8"  // 承認取消/予約取消
+	TransferStatusCodeReserved         = "11" // 予約中
+	TransferStatusCodeProcessing       = "12" // 手続中
+	TransferStatusCodeRetrying         = "13" // リトライ中
+	TransferStatusCodeCompleted        = "20" // 手続済
+	TransferStatusCodeFundsReturned    = "22" // 資金返却
+	TransferStatusCodeReversalPending  = "24" // 組戻手続中
+	TransferStatusCodeReversed         = "25" // 組戻済
+	TransferStatusCodeReversalRejected = "26" // 組戻不成立
+	TransferStatusCodeUnknown          = "40" // 手続不明
+)
+
+// Beneficiary identifies the account receiving a transfer
+type Beneficiary struct {
+	BankCode        string `json:"beneficiaryBankCode"`
+	BranchCode      string `json:"beneficiaryBranchCode"`
+	AccountTypeCode string `json:"accountTypeCode"`
+	AccountNumber   string `json:"accountNumber"`
+	Name            string `json:"beneficiaryName"`
+}
+
+type WhitelistRequest struct {
+	AccountID string `json:"accountId"`
+	Beneficiary
+}
+
+type WhitelistResponse struct {
+	BeneficiaryID string `json:"beneficiaryId"`
+	Registered    bool   `json:"registered"`
+}
+
+// TransferItem is a single transfer in a transfer request
+type TransferItem struct {
+	ItemID         string `json:"itemId"`
+	TransferAmount string `json:"transferAmount"`
+	EdiInfo        string `json:"ediInfo,omitempty"`
+	Beneficiary
+}
+
+type TransferRequest struct {
+	AccountID              string         `json:"accountId"`
+	TransferDesignatedDate string         `json:"transferDesignatedDate"`
+	TotalCount             string         `json:"totalCount"`
+	TotalAmount            string         `json:"totalAmount"`
+	Transfers              []TransferItem `json:"transfers"`
+}
+
+type TransferResponse struct {
+	AccountID        string `json:"accountId"`
+	ResultCode       string `json:"resultCode"`
+	ApplyNo          string `json:"applyNo"`
+	ApplyEndDatetime string `json:"applyEndDatetime"`
+}
+
+type TransferDetail struct {
+	ApplyNo                  string `json:"applyNo"`
+	TransferStatus           string `json:"transferStatus"`
+	TransferStatusName       string `json:"transferStatusName"`
+	TransferAmount           string `json:"transferAmount"`
+	TransferExecutedDatetime string `json:"transferExecutedDatetime,omitempty"`
+}
+
+type TransferStatusResponse struct {
+	AccountID       string           `json:"accountId"`
+	TransferDetails []TransferDetail `json:"transferDetails"`
+}
+
+// Error codes of the bank API error responses
+const (
+	ErrorCodeUnauthorized   = "UNAUTHORIZED"                // アクセストークン不正・期限切れ
+	ErrorCodeRateLimited    = "RATE_LIMITED"                // 流量制限超過
+	ErrorCodeInvalidRequest = "INVALID_REQUEST"             // リクエスト不正
+	ErrorCodeNotWhitelisted = "BENEFICIARY_NOT_WHITELISTED" // 振込先未登録
+)
+
+// ErrorResponse is the error body returned by the bank API
+type ErrorResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// APIError is returned when the bank API responds with a non-2xx status
+type APIError struct {
+	StatusCode int
+	ErrorResponse
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("aozora api error: status=%d code=%s message=%s", e.StatusCode, e.ErrorCode, e.ErrorMessage)
+}
+
+// IsClientError reports whether the bank rejected the request itself, as opposed to failing to process it
+func (e *APIError) IsClientError() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500
+}
+
+// IsRetryable reports whether the same request may be accepted when sent again: the access token was rejected, the
+// rate limit was hit, or the bank failed to process the request
+func (e *APIError) IsRetryable() bool {
+	switch e.ErrorCode {
+	case ErrorCodeUnauthorized, ErrorCodeRateLimited:
+		return true
+	}
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	}
+	return !e.IsClientError()
+}
+
+type AozoraTransferService interface {
+	// RegisterWhitelist registers the beneficiary account so that transfers to it are accepted
+	RegisterWhitelist(ctx context.Context, beneficiary Beneficiary) (*WhitelistResponse, error)
+
+	// RequestTransfer requests a transfer; requests sharing an idempotency key are only executed once
+	RequestTransfer(ctx context.Context, idempotencyKey string, request TransferRequest) (*TransferResponse, error)
+
+	// GetTransferStatus inquires the status of a transfer by its apply number
+	GetTransferStatus(ctx context.Context, applyNo string) (*TransferStatusResponse, error)
+}
@@ -0,0 +1,263 @@
+package aozora
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Error codes returned by FakeBankServer
+const (
+	FakeErrorCodeUnauthorized     = ErrorCodeUnauthorized
+	FakeErrorCodeInvalidRequest   = ErrorCodeInvalidRequest
+	FakeErrorCodeNotWhitelisted   = ErrorCodeNotWhitelisted
+	FakeErrorCodeTransferNotFound = "TRANSFER_NOT_FOUND"
+	FakeErrorCodeInjectedFailure  = "INJECTED_FAILURE"
+)
+
+const (
+	fakeDatetimeLayout              = "2006-01-02T15:04:05-07:00"
+	fakeInitialTransferStatusCode   = TransferStatusCodeProcessing
+	fakeInitialTransferStatusName   = "手続中"
+	fakeCompletedTransferStatusName = "手続済"
+)
+
+var fakeBankLocation = time.FixedZone("JST", 9*60*60)
+
+type fakeTransfer struct {
+	detail TransferDetail
+}
+
+// FakeBankServer is an in-memory implementation of the bank transfer API.
+// It is meant to be served with net/http/httptest or run locally so that
+// the transfer flow can be exercised without the real bank.
+type FakeBankServer struct {
+	AccessToken string
+	AccountID   string
+
+	mu             sync.Mutex
+	sequence       int
+	whitelist      map[string]string
+	transfers      map[string]*fakeTransfer
+	idempotentKeys map[string]*TransferResponse
+	failures       map[string]int
+}
+
+// NewFakeBankServer creates a fake bank that accepts requests carrying the given access token
+func NewFakeBankServer(accessToken, accountID string) *FakeBankServer {
+	return &FakeBankServer{
+		AccessToken:    accessToken,
+		AccountID:      accountID,
+		whitelist:      make(map[string]string),
+		transfers:      make(map[string]*fakeTransfer),
+		idempotentKeys: make(map[string]*TransferResponse),
+		failures:       make(map[string]int),
+	}
+}
+
+func (s *FakeBankServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(accessTokenHeader) != s.AccessToken {
+		writeFakeError(w, http.StatusUnauthorized, FakeErrorCodeUnauthorized, "invalid access token")
+		return
+	}
+
+	if status, ok := s.takeFailure(r.URL.Path); ok {
+		writeFakeError(w, status, FakeErrorCodeInjectedFailure, "injected failure")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == WhitelistPath:
+		s.handleWhitelist(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == TransferPath:
+		s.handleTransfer(w, r)
+	case r.Method == http.MethodGet && r.URL.Path == TransferStatusPath:
+		s.handleTransferStatus(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// FailNext makes the next request to the given API path fail with the given HTTP status
+func (s *FakeBankServer) FailNext(path string, statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[path] = statusCode
+}
+
+// SetTransferStatus changes the status of a transfer; executedAt is reported when it is not zero
+func (s *FakeBankServer) SetTransferStatus(applyNo, statusCode, statusName string, executedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	transfer, ok := s.transfers[applyNo]
+	if !ok {
+		return fmt.Errorf("transfer %s not found", applyNo)
+	}
+
+	transfer.detail.TransferStatus = statusCode
+	transfer.detail.TransferStatusName = statusName
+	transfer.detail.TransferExecutedDatetime = ""
+	if !executedAt.IsZero() {
+		transfer.detail.TransferExecutedDatetime = executedAt.In(fakeBankLocation).Format(fakeDatetimeLayout)
+	}
+	return nil
+}
+
+// CompleteTransfer marks a transfer as executed at the given time
+func (s *FakeBankServer) CompleteTransfer(applyNo string, executedAt time.Time) error {
+	return s.SetTransferStatus(applyNo, TransferStatusCodeCompleted, fakeCompletedTransferStatusName, executedAt)
+}
+
+// SetTransferAmount overrides the amount reported for a transfer
+func (s *FakeBankServer) SetTransferAmount(applyNo, amount string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	transfer, ok := s.transfers[applyNo]
+	if !ok {
+		return fmt.Errorf("transfer %s not found", applyNo)
+	}
+
+	transfer.detail.TransferAmount = amount
+	return nil
+}
+
+// TransferCount returns the number of distinct transfers accepted by the bank
+func (s *FakeBankServer) TransferCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.transfers)
+}
+
+// IsWhitelisted reports whether the beneficiary has been registered
+func (s *FakeBankServer) IsWhitelisted(beneficiary Beneficiary) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.whitelist[beneficiaryKey(beneficiary)]
+	return ok
+}
+
+func (s *FakeBankServer) handleWhitelist(w http.ResponseWriter, r *http.Request) {
+	var request WhitelistRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || !isValidBeneficiary(request.Beneficiary) {
+		writeFakeError(w, http.StatusBadRequest, FakeErrorCodeInvalidRequest, "invalid beneficiary")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := beneficiaryKey(request.Beneficiary)
+	beneficiaryID, ok := s.whitelist[key]
+	if !ok {
+		s.sequence++
+		beneficiaryID = fmt.Sprintf("B%010d", s.sequence)
+		s.whitelist[key] = beneficiaryID
+	}
+
+	writeFakeJSON(w, http.StatusOK, WhitelistResponse{BeneficiaryID: beneficiaryID, Registered: true})
+}
+
+func (s *FakeBankServer) handleTransfer(w http.ResponseWriter, r *http.Request) {
+	idempotencyKey := r.Header.Get(idempotencyKeyHeader)
+	if idempotencyKey == "" {
+		writeFakeError(w, http.StatusBadRequest, FakeErrorCodeInvalidRequest, "idempotency key is required")
+		return
+	}
+
+	var request TransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || len(request.Transfers) == 0 {
+		writeFakeError(w, http.StatusBadRequest, FakeErrorCodeInvalidRequest, "invalid transfer request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if response, ok := s.idempotentKeys[idempotencyKey]; ok {
+		writeFakeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	for _, item := range request.Transfers {
+		if _, ok := s.whitelist[beneficiaryKey(item.Beneficiary)]; !ok {
+			writeFakeError(w, http.StatusBadRequest, FakeErrorCodeNotWhitelisted, "beneficiary is not whitelisted")
+			return
+		}
+	}
+
+	s.sequence++
+	applyNo := fmt.Sprintf("%016d", s.sequence)
+	response := &TransferResponse{
+		AccountID:        request.AccountID,
+		ResultCode:       ResultCodeCompleted,
+		ApplyNo:          applyNo,
+		ApplyEndDatetime: time.Now().In(fakeBankLocation).Format(fakeDatetimeLayout),
+	}
+	s.transfers[applyNo] = &fakeTransfer{
+		detail: TransferDetail{
+			ApplyNo:            applyNo,
+			TransferStatus:     fakeInitialTransferStatusCode,
+			TransferStatusName: fakeInitialTransferStatusName,
+			TransferAmount:     request.TotalAmount,
+		},
+	}
+	s.idempotentKeys[idempotencyKey] = response
+
+	writeFakeJSON(w, http.StatusOK, response)
+}
+
+func (s *FakeBankServer) handleTransferStatus(w http.ResponseWriter, r *http.Request) {
+	applyNo := r.URL.Query().Get("applyNo")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	transfer, ok := s.transfers[applyNo]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, FakeErrorCodeTransferNotFound, "transfer not found")
+		return
+	}
+
+	writeFakeJSON(w, http.StatusOK, TransferStatusResponse{
+		AccountID:       r.URL.Query().Get("accountId"),
+		TransferDetails: []TransferDetail{transfer.detail},
+	})
+}
+
+// takeFailure returns and clears the injected failure for the path
+func (s *FakeBankServer) takeFailure(path string) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, ok := s.failures[path]
+	if ok {
+		delete(s.failures, path)
+	}
+	return status, ok
+}
+
+func beneficiaryKey(b Beneficiary) string {
+	return strings.Join([]string{b.BankCode, b.BranchCode, b.AccountTypeCode, b.AccountNumber}, "-")
+}
+
+func isValidBeneficiary(b Beneficiary) bool {
+	return b.BankCode != "" && b.BranchCode != "" && b.AccountTypeCode != "" && b.AccountNumber != "" && b.Name != ""
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, code, message string) {
+	writeFakeJSON(w, status, ErrorResponse{ErrorCode: code, ErrorMessage: message})
+}
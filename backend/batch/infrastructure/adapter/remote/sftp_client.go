@@ -0,0 +1,434 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// TargetDateLayout is the layout of the target date, which is also the name of the dated folder on the remote server
+const TargetDateLayout = "20060102"
+
+// targetExtensions are the report files fetched from the remote server
+var targetExtensions = []string{".csv", ".pdf", ".zip"}
+
+type SFTPClient struct {
+	Config SSHConfig
+
+	mu         sync.Mutex
+	sshClient  *ssh.Client
+	sftpClient *sftp.Client
+}
+
+func NewSFTPClient(cfg SSHConfig) SSHService {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	return &SFTPClient{Config: cfg}
+}
+
+// targetPath is the path of a report folder to fetch
+type targetPath struct {
+	Path  string
+	Dated bool
+}
+
+// buildTargetPaths lists the paths of the report folders to fetch; folders without a path are skipped instead of
+// falling back to the whole remote directory, and dated folders are looked up under the folder of the target date
+func buildTargetPaths(folders []RemoteFolder, targetDate string) []targetPath {
+	var datedPaths, paths []targetPath
+	for _, folder := range folders {
+		folderPath := strings.Trim(folder.Path, "/")
+		if folderPath == "" {
+			continue
+		}
+		if folder.Dated {
+			datedPaths = append(datedPaths, targetPath{Path: fmt.Sprintf("/%s/%s/", targetDate, folderPath), Dated: true})
+		} else {
+			paths = append(paths, targetPath{Path: fmt.Sprintf("/%s/", folderPath)})
+		}
+	}
+
+	return append(datedPaths, paths...)
+}
+
+func (c *SFTPClient) StreamFolderFilesPaginated(ctx context.Context, remoteDir string, folders []RemoteFolder, pageSize int, targetDate string) (<-chan FileGroup, error) {
+	modifiedSince, err := time.ParseInLocation(TargetDateLayout, targetDate, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target date %q: %w", targetDate, err)
+	}
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("page size must be positive: %d", pageSize)
+	}
+
+	client, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outCh := make(chan FileGroup)
+	targetedPaths := buildTargetPaths(folders, targetDate)
+
+	go func() {
+		defer close(outCh)
+
+		for _, target := range targetedPaths {
+			folder := target.Path
+			fullPath := path.Join(remoteDir, folder)
+			log.Printf("[Stream] Searching in folder: %s", fullPath)
+
+			files, err := findFiles(ctx, client, fullPath, modifiedSince)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				// The folder of the target date is only created when there are reports for the date
+				if target.Dated && errors.Is(err, os.ErrNotExist) {
+					log.Printf("[Stream] No folder %s for the target date", folder)
+					continue
+				}
+				log.Printf("[Stream] Failed to search folder %s: %v", folder, err)
+				select {
+				case outCh <- FileGroup{Folder: folder, Err: fmt.Errorf("search folder %s: %w", folder, err)}:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			if len(files) > 0 {
+				log.Printf("[Stream] Folder %s: Found %d files", folder, len(files))
+			}
+
+			for start := 0; start < len(files); start += pageSize {
+				end := min(start+pageSize, len(files))
+				select {
+				case outCh <- FileGroup{Folder: folder, Files: files[start:end]}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return outCh, nil
+}
+
+// findFiles walks dir and returns the sorted paths of target files modified after modifiedSince
+func findFiles(ctx context.Context, client *sftp.Client, dir string, modifiedSince time.Time) ([]string, error) {
+	files := make([]string, 0)
+
+	walker := client.Walk(dir)
+	for walker.Step() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := walker.Err(); err != nil {
+			if walker.Path() == dir {
+				return nil, err
+			}
+			log.Printf("[Stream] Skipping %s: %v", walker.Path(), err)
+			continue
+		}
+
+		info := walker.Stat()
+		if !info.Mode().IsRegular() || !hasTargetExtension(info.Name()) || !info.ModTime().After(modifiedSince) {
+			continue
+		}
+		files = append(files, walker.Path())
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func hasTargetExtension(name string) bool {
+	lowerName := strings.ToLower(name)
+	for _, ext := range targetExtensions {
+		if strings.HasSuffix(lowerName, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *SFTPClient) List(ctx context.Context, remoteDir string) ([]RemoteFile, error) {
+	client, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := client.ReadDir(remoteDir)
+	if err != nil {
+		return nil, c.handleError(fmt.Errorf("failed to list %s: %w", remoteDir, err))
+	}
+
+	files := make([]RemoteFile, len(entries))
+	for i, entry := range entries {
+		files[i] = toRemoteFile(path.Join(remoteDir, entry.Name()), entry)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	return files, nil
+}
+
+func (c *SFTPClient) Stat(ctx context.Context, remotePath string) (*RemoteFile, error) {
+	client, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := client.Stat(remotePath)
+	if err != nil {
+		return nil, c.handleError(fmt.Errorf("failed to stat %s: %w", remotePath, err))
+	}
+
+	file := toRemoteFile(remotePath, info)
+	return &file, nil
+}
+
+func (c *SFTPClient) Open(ctx context.Context, remotePath string) (io.ReadCloser, error) {
+	client, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := client.Open(remotePath)
+	if err != nil {
+		return nil, c.handleError(fmt.Errorf("failed to open %s: %w", remotePath, err))
+	}
+
+	return &contextReader{ctx: ctx, file: file}, nil
+}
+
+// Download copies a remote file to localPath; the file only appears at localPath once it is complete
+func (c *SFTPClient) Download(ctx context.Context, remotePath, localPath string) error {
+	reader, err := c.Open(ctx, remotePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(filepath.Dir(localPath), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(localPath), "."+filepath.Base(localPath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		return c.handleError(fmt.Errorf("failed to download %s: %w", remotePath, err))
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), localPath)
+}
+
+func (c *SFTPClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closeLocked()
+}
+
+// connect returns the shared SFTP session, dialling the server on first use or after the connection was lost
+func (c *SFTPClient) connect(ctx context.Context) (*sftp.Client, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sftpClient != nil {
+		return c.sftpClient, nil
+	}
+
+	clientConfig, err := c.clientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	addr := net.JoinHostPort(c.Config.Host, strconv.Itoa(c.Config.Port))
+	sshClient, err := dialContext(ctx, addr, clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, fmt.Errorf("failed to start sftp session: %w", err)
+	}
+
+	c.sshClient = sshClient
+	c.sftpClient = sftpClient
+	return sftpClient, nil
+}
+
+func (c *SFTPClient) clientConfig() (*ssh.ClientConfig, error) {
+	auth, err := c.authMethods()
+	if err != nil {
+		return nil, err
+	}
+
+	knownHostsPath := c.Config.KnownHostsPath
+	if knownHostsPath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("known_hosts path is not configured: %w", err)
+		}
+		knownHostsPath = filepath.Join(home, ".ssh", "known_hosts")
+	}
+
+	hostKeyCallback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known_hosts %s: %w", knownHostsPath, err)
+	}
+
+	return &ssh.ClientConfig{
+		User:            c.Config.User,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         c.Config.Timeout,
+	}, nil
+}
+
+func (c *SFTPClient) authMethods() ([]ssh.AuthMethod, error) {
+	methods := make([]ssh.AuthMethod, 0, 2)
+
+	if c.Config.PrivateKeyPath != "" {
+		key, err := os.ReadFile(c.Config.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read private key: %w", err)
+		}
+
+		var signer ssh.Signer
+		if c.Config.PrivateKeyPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(c.Config.PrivateKeyPassphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey(key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if c.Config.Password != "" {
+		methods = append(methods, ssh.Password(c.Config.Password))
+	}
+
+	if len(methods) == 0 {
+		return nil, errors.New("either a private key or a password is required for ssh authentication")
+	}
+
+	return methods, nil
+}
+
+// dialContext opens an SSH connection, aborting the TCP dial and handshake when ctx is cancelled
+func dialContext(ctx context.Context, addr string, clientConfig *ssh.ClientConfig) (*ssh.Client, error) {
+	dialer := net.Dialer{Timeout: clientConfig.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	handshakeDone := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-handshakeDone:
+		}
+	}()
+
+	_ = conn.SetDeadline(time.Now().Add(clientConfig.Timeout))
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, clientConfig)
+	close(handshakeDone)
+	if err != nil {
+		conn.Close()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
+
+// handleError drops the session when the connection was lost so that the next call reconnects
+func (c *SFTPClient) handleError(err error) error {
+	if errors.Is(err, sftp.ErrSSHFxConnectionLost) {
+		c.mu.Lock()
+		_ = c.closeLocked()
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func (c *SFTPClient) closeLocked() error {
+	var err error
+	if c.sftpClient != nil {
+		err = c.sftpClient.Close()
+		c.sftpClient = nil
+	}
+	if c.sshClient != nil {
+		if closeErr := c.sshClient.Close(); err == nil && !errors.Is(closeErr, net.ErrClosed) {
+			err = closeErr
+		}
+		c.sshClient = nil
+	}
+	return err
+}
+
+func toRemoteFile(remotePath string, info os.FileInfo) RemoteFile {
+	return RemoteFile{
+		Path:    remotePath,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+		IsDir:   info.IsDir(),
+	}
+}
+
+// contextReader stops reading a remote file once its context is cancelled
+type contextReader struct {
+	ctx  context.Context
+	file *sftp.File
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.file.Read(p)
+}
+
+func (r *contextReader) Close() error {
+	return r.file.Close()
+}
@@ -0,0 +1,387 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	testUser       = "paypay"
+	testPassword   = "secret"
+	testTargetDate = "20250520"
+)
+
+// testFolders is the folder layout the files are streamed from
+var testFolders = []RemoteFolder{
+	{Path: "no_shipping", Dated: true},
+	{Path: "summary", Dated: true},
+	{Path: "topup"},
+	{Path: ""},
+}
+
+// testSFTPServer is an in-process SFTP server serving a temporary directory
+type testSFTPServer struct {
+	Addr      string
+	Root      string
+	HostKey   ssh.Signer
+	ClientKey ssh.Signer
+
+	listener net.Listener
+}
+
+func newTestSFTPServer(t *testing.T) *testSFTPServer {
+	t.Helper()
+
+	server := &testSFTPServer{
+		Root:      t.TempDir(),
+		HostKey:   newTestSigner(t),
+		ClientKey: newTestSigner(t),
+	}
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() == testUser && bytes.Equal(key.Marshal(), server.ClientKey.PublicKey().Marshal()) {
+				return nil, nil
+			}
+			return nil, errors.New("unknown public key")
+		},
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == testUser && string(password) == testPassword {
+				return nil, nil
+			}
+			return nil, errors.New("invalid password")
+		},
+	}
+	config.AddHostKey(server.HostKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server.listener = listener
+	server.Addr = listener.Addr().String()
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSFTP(conn, config)
+		}
+	}()
+
+	return server
+}
+
+func serveSFTP(conn net.Conn, config *ssh.ServerConfig) {
+	defer conn.Close()
+
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			for req := range requests {
+				isSFTP := req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+				req.Reply(isSFTP, nil)
+				if !isSFTP {
+					continue
+				}
+
+				server, err := sftp.NewServer(channel)
+				if err != nil {
+					channel.Close()
+					return
+				}
+				_ = server.Serve()
+				server.Close()
+				return
+			}
+		}()
+	}
+}
+
+func (s *testSFTPServer) writeFile(t *testing.T, relPath string, content string, modTime time.Time) string {
+	t.Helper()
+
+	fullPath := filepath.Join(s.Root, relPath)
+	require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o755))
+	require.NoError(t, os.WriteFile(fullPath, []byte(content), 0o644))
+	require.NoError(t, os.Chtimes(fullPath, modTime, modTime))
+	return fullPath
+}
+
+func (s *testSFTPServer) knownHosts(t *testing.T, hostKey ssh.PublicKey) string {
+	t.Helper()
+
+	line := knownhosts.Line([]string{knownhosts.Normalize(s.Addr)}, hostKey)
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(path, []byte(line+"\n"), 0o600))
+	return path
+}
+
+func (s *testSFTPServer) clientKeyPath(t *testing.T) string {
+	t.Helper()
+
+	signer, ok := s.ClientKey.(interface{ PrivateKey() ed25519.PrivateKey })
+	require.True(t, ok)
+	block, err := ssh.MarshalPrivateKey(signer.PrivateKey(), "")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0o600))
+	return path
+}
+
+func (s *testSFTPServer) config(t *testing.T) SSHConfig {
+	t.Helper()
+
+	host, portStr, err := net.SplitHostPort(s.Addr)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	return SSHConfig{
+		User:           testUser,
+		Host:           host,
+		Port:           port,
+		PrivateKeyPath: s.clientKeyPath(t),
+		KnownHostsPath: s.knownHosts(t, s.HostKey.PublicKey()),
+		Timeout:        5 * time.Second,
+	}
+}
+
+// testSigner keeps the private key so that it can be written out for the client
+type testSigner struct {
+	ssh.Signer
+	key ed25519.PrivateKey
+}
+
+func (s *testSigner) PrivateKey() ed25519.PrivateKey {
+	return s.key
+}
+
+func newTestSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	return &testSigner{Signer: signer, key: key}
+}
+
+func newTestSFTPClient(t *testing.T, cfg SSHConfig) SSHService {
+	t.Helper()
+
+	client := NewSFTPClient(cfg)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestSFTPClient_StreamFolderFilesPaginated(t *testing.T) {
+	server := newTestSFTPServer(t)
+	client := newTestSFTPClient(t, server.config(t))
+
+	recent := time.Date(2025, 5, 20, 9, 0, 0, 0, time.Local)
+	old := time.Date(2025, 5, 19, 9, 0, 0, 0, time.Local)
+
+	summaryA := server.writeFile(t, "20250520/summary/a.csv", "a", recent)
+	summaryB := server.writeFile(t, "20250520/summary/b.PDF", "b", recent)
+	summaryC := server.writeFile(t, "20250520/summary/nested/c.zip", "c", recent)
+	server.writeFile(t, "20250520/summary/ignored.txt", "x", recent)
+	server.writeFile(t, "20250520/summary/old.csv", "x", old)
+	topUp := server.writeFile(t, "topup/top_up.csv", "t", recent)
+
+	stream, err := client.StreamFolderFilesPaginated(context.Background(), server.Root, testFolders, 2, testTargetDate)
+	require.NoError(t, err)
+
+	var groups []FileGroup
+	for group := range stream {
+		groups = append(groups, group)
+	}
+
+	require.Len(t, groups, 3)
+	assert.Equal(t, FileGroup{Folder: "/20250520/summary/", Files: []string{summaryA, summaryB}}, groups[0])
+	assert.Equal(t, FileGroup{Folder: "/20250520/summary/", Files: []string{summaryC}}, groups[1])
+	assert.Equal(t, FileGroup{Folder: "/topup/", Files: []string{topUp}}, groups[2])
+}
+
+func TestSFTPClient_StreamFolderFilesPaginated_SearchError(t *testing.T) {
+	server := newTestSFTPServer(t)
+	client := newTestSFTPClient(t, server.config(t))
+
+	topUp := server.writeFile(t, "topup/top_up.csv", "t", time.Date(2025, 5, 20, 9, 0, 0, 0, time.Local))
+	folders := []RemoteFolder{
+		{Path: "no_shipping", Dated: true},
+		{Path: "settlement"},
+		{Path: "topup"},
+	}
+
+	stream, err := client.StreamFolderFilesPaginated(context.Background(), server.Root, folders, 10, testTargetDate)
+	require.NoError(t, err)
+
+	var groups []FileGroup
+	for group := range stream {
+		groups = append(groups, group)
+	}
+
+	// The missing dated folder has no files for the date, the missing undated folder could not be searched
+	require.Len(t, groups, 2)
+	assert.Equal(t, "/settlement/", groups[0].Folder)
+	assert.Empty(t, groups[0].Files)
+	assert.ErrorIs(t, groups[0].Err, os.ErrNotExist)
+	assert.Equal(t, FileGroup{Folder: "/topup/", Files: []string{topUp}}, groups[1])
+}
+
+func TestSFTPClient_StreamFolderFilesPaginated_InvalidTargetDate(t *testing.T) {
+	server := newTestSFTPServer(t)
+	client := newTestSFTPClient(t, server.config(t))
+
+	_, err := client.StreamFolderFilesPaginated(context.Background(), server.Root, testFolders, 10, "2025-05-20")
+	assert.Error(t, err)
+}
+
+func TestSFTPClient_StatListAndOpen(t *testing.T) {
+	server := newTestSFTPServer(t)
+	client := newTestSFTPClient(t, server.config(t))
+	ctx := context.Background()
+
+	modTime := time.Date(2025, 5, 20, 9, 0, 0, 0, time.Local)
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	filePath := server.writeFile(t, "reports/detail.csv", string(content), modTime)
+	server.writeFile(t, "reports/summary.csv", "summary", modTime)
+	require.NoError(t, os.Mkdir(filepath.Join(server.Root, "reports", "archive"), 0o755))
+
+	stat, err := client.Stat(ctx, filePath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), stat.Size)
+	assert.True(t, stat.ModTime.Equal(modTime))
+	assert.False(t, stat.IsDir)
+
+	_, err = client.Stat(ctx, filepath.Join(server.Root, "reports", "missing.csv"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	entries, err := client.List(ctx, filepath.Join(server.Root, "reports"))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, filepath.Join(server.Root, "reports", "archive"), entries[0].Path)
+	assert.True(t, entries[0].IsDir)
+	assert.Equal(t, filePath, entries[1].Path)
+
+	reader, err := client.Open(ctx, filePath)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	read, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, content, read)
+}
+
+func TestSFTPClient_Download(t *testing.T) {
+	server := newTestSFTPServer(t)
+	client := newTestSFTPClient(t, server.config(t))
+
+	filePath := server.writeFile(t, "reports/detail.csv", "id,amount\n1,100\n", time.Now())
+	localPath := filepath.Join(t.TempDir(), "nested", "detail.csv")
+
+	require.NoError(t, client.Download(context.Background(), filePath, localPath))
+
+	downloaded, err := os.ReadFile(localPath)
+	require.NoError(t, err)
+	assert.Equal(t, "id,amount\n1,100\n", string(downloaded))
+
+	entries, err := os.ReadDir(filepath.Dir(localPath))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary file is left behind")
+}
+
+func TestSFTPClient_PasswordAuth(t *testing.T) {
+	server := newTestSFTPServer(t)
+	cfg := server.config(t)
+	cfg.PrivateKeyPath = ""
+	cfg.Password = testPassword
+	client := newTestSFTPClient(t, cfg)
+
+	server.writeFile(t, "file.csv", "x", time.Now())
+	_, err := client.Stat(context.Background(), filepath.Join(server.Root, "file.csv"))
+	assert.NoError(t, err)
+}
+
+func TestSFTPClient_RejectsUnknownHostKey(t *testing.T) {
+	server := newTestSFTPServer(t)
+	cfg := server.config(t)
+	cfg.KnownHostsPath = server.knownHosts(t, newTestSigner(t).PublicKey())
+	client := newTestSFTPClient(t, cfg)
+
+	_, err := client.Stat(context.Background(), server.Root)
+
+	var keyErr *knownhosts.KeyError
+	require.ErrorAs(t, err, &keyErr)
+	assert.NotEmpty(t, keyErr.Want, "the host is known with a different key")
+}
+
+func TestSFTPClient_RequiresAuthMethod(t *testing.T) {
+	server := newTestSFTPServer(t)
+	cfg := server.config(t)
+	cfg.PrivateKeyPath = ""
+	client := newTestSFTPClient(t, cfg)
+
+	_, err := client.Stat(context.Background(), server.Root)
+	assert.Error(t, err)
+}
+
+func TestSFTPClient_ContextCancellation(t *testing.T) {
+	server := newTestSFTPServer(t)
+	client := newTestSFTPClient(t, server.config(t))
+
+	filePath := server.writeFile(t, "reports/detail.csv", "id,amount\n1,100\n", time.Now())
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.Stat(cancelled, filePath)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reader, err := client.Open(ctx, filePath)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	cancel()
+	_, err = io.ReadAll(reader)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	stream, err := client.StreamFolderFilesPaginated(cancelled, server.Root, testFolders, 10, testTargetDate)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, stream)
+}
@@ -1,23 +1,62 @@
 package remote
 
+import (
+	"context"
+	"io"
+	"time"
+)
+
 type SSHConfig struct {
-	User     string
-	Host     string
-	Port     int
-	Password string
+	User string
+	Host string
+	Port int
+
+	// Password is used when set; key-based auth is used when PrivateKeyPath is set. At least one is required.
+	Password             string
+	PrivateKeyPath       string
+	PrivateKeyPassphrase string
+
+	// KnownHostsPath is the known_hosts file the server's host key is verified against (defaults to ~/.ssh/known_hosts)
+	KnownHostsPath string
+
+	Timeout time.Duration
+}
+
+// RemoteFolder is a folder of reports on the remote server, relative to the remote directory
+type RemoteFolder struct {
+	Path  string
+	Dated bool // The folder is under a folder named after the target date
 }
 
 type FileGroup struct {
 	Folder string
 	Files  []string
+	Err    error // The folder could not be searched, the group has no files
+}
+
+// RemoteFile describes a file or directory on the remote server
+type RemoteFile struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+	IsDir   bool
 }
 
 type SSHService interface {
-	StreamFolderFilesPaginated(remoteDir string, pageSize int, targetDate string) (<-chan FileGroup, error)
+	// StreamFolderFilesPaginated streams the csv/pdf/zip files modified since targetDate (yyyymmdd) under each of the
+	// folders, in groups of at most pageSize files. A folder that cannot be searched is streamed as a group with Err set,
+	// apart from a missing dated folder, which has no files for the date.
+	StreamFolderFilesPaginated(ctx context.Context, remoteDir string, folders []RemoteFolder, pageSize int, targetDate string) (<-chan FileGroup, error)
+
+	// List lists the entries of a remote directory sorted by name
+	List(ctx context.Context, remoteDir string) ([]RemoteFile, error)
+
+	Stat(ctx context.Context, remotePath string) (*RemoteFile, error)
 
-	Download(remotePath, localPath string) error
+	// Open streams a remote file; reads fail once ctx is cancelled
+	Open(ctx context.Context, remotePath string) (io.ReadCloser, error)
 
-	GetRemoteFileSize(remotePath string) (int64, error)
+	Download(ctx context.Context, remotePath, localPath string) error
 
-	ReadRemoteFile(remotePath string) ([]byte, error)
+	Close() error
 }
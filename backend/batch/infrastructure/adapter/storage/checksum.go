@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+)
+
+// UploadResult describes an object as it was written to storage
+type UploadResult struct {
+	Key    string
+	Size   int64
+	MD5    string // hex encoded
+	SHA256 string // hex encoded
+}
+
+// checksumReader hashes and counts everything read through it
+type checksumReader struct {
+	reader io.Reader
+	md5    hash.Hash
+	sha256 hash.Hash
+	size   int64
+}
+
+func newChecksumReader(r io.Reader) *checksumReader {
+	return &checksumReader{
+		reader: r,
+		md5:    md5.New(),
+		sha256: sha256.New(),
+	}
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.md5.Write(p[:n])
+		r.sha256.Write(p[:n])
+		r.size += int64(n)
+	}
+	return n, err
+}
+
+func (r *checksumReader) result(key string) *UploadResult {
+	return &UploadResult{
+		Key:    key,
+		Size:   r.size,
+		MD5:    hex.EncodeToString(r.md5.Sum(nil)),
+		SHA256: hex.EncodeToString(r.sha256.Sum(nil)),
+	}
+}
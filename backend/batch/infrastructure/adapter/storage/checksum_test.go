@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func md5Hex(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestChecksumReader(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":       {},
+		"text":        []byte("payin,report\r\n1,2\r\n"),
+		"binary":      {0x00, 0xff, 0x50, 0x4b, 0x03, 0x04},
+		"large":       bytes.Repeat([]byte("0123456789abcdef"), 64*1024),
+		"shift_jis":   {0x83, 0x65, 0x83, 0x58, 0x83, 0x67},
+		"single byte": []byte("x"),
+	}
+
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			reader := newChecksumReader(bytes.NewReader(content))
+
+			read, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, content, read)
+
+			result := reader.result("report/a.zip")
+			assert.Equal(t, "report/a.zip", result.Key)
+			assert.Equal(t, int64(len(content)), result.Size)
+			assert.Equal(t, md5Hex(content), result.MD5)
+			assert.Equal(t, sha256Hex(content), result.SHA256)
+		})
+	}
+}
+
+func TestChecksumReader_KnownDigests(t *testing.T) {
+	reader := newChecksumReader(strings.NewReader("abc"))
+	_, err := io.Copy(io.Discard, reader)
+	require.NoError(t, err)
+
+	result := reader.result("abc.txt")
+	assert.Equal(t, "900150983cd24fb0d6963f7d28e17f72", result.MD5)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", result.SHA256)
+	assert.Equal(t, int64(3), result.Size)
+}
+
+func TestChecksumReader_ShortReads(t *testing.T) {
+	content := bytes.Repeat([]byte("payin"), 1000)
+	reader := newChecksumReader(iotest.OneByteReader(bytes.NewReader(content)))
+
+	_, err := io.Copy(io.Discard, reader)
+	require.NoError(t, err)
+
+	result := reader.result("a.zip")
+	assert.Equal(t, int64(len(content)), result.Size)
+	assert.Equal(t, md5Hex(content), result.MD5)
+	assert.Equal(t, sha256Hex(content), result.SHA256)
+}
+
+func TestChecksumReader_ReadError(t *testing.T) {
+	errBroken := errors.New("connection reset")
+	reader := newChecksumReader(io.MultiReader(strings.NewReader("part"), iotest.ErrReader(errBroken)))
+
+	_, err := io.ReadAll(reader)
+	require.ErrorIs(t, err, errBroken)
+
+	// Only the bytes that were read are counted and hashed
+	result := reader.result("a.zip")
+	assert.Equal(t, int64(4), result.Size)
+	assert.Equal(t, md5Hex([]byte("part")), result.MD5)
+}
+
+func TestLocalClient_UploadStreamWithChecksum(t *testing.T) {
+	client, rootDir := newTestLocalClient(t)
+	ctx := context.Background()
+	content := bytes.Repeat([]byte("PK\x03\x04payin"), 4096)
+
+	result, err := client.UploadStreamWithChecksum(ctx, "20250610/report/a.zip", bytes.NewReader(content))
+	require.NoError(t, err)
+
+	assert.Equal(t, "20250610/report/a.zip", result.Key)
+	assert.Equal(t, int64(len(content)), result.Size)
+	assert.Equal(t, md5Hex(content), result.MD5)
+	assert.Equal(t, sha256Hex(content), result.SHA256)
+
+	stored, err := os.ReadFile(filepath.Join(rootDir, testBucket, "20250610", "report", "a.zip"))
+	require.NoError(t, err)
+	assert.Equal(t, content, stored)
+}
+
+func TestLocalClient_UploadStreamWithChecksum_ReadError(t *testing.T) {
+	client, rootDir := newTestLocalClient(t)
+	errBroken := errors.New("connection reset")
+
+	result, err := client.UploadStreamWithChecksum(context.Background(), "a.zip",
+		io.MultiReader(strings.NewReader("part"), iotest.ErrReader(errBroken)))
+	require.ErrorIs(t, err, errBroken)
+	assert.Nil(t, result)
+
+	// A failed upload leaves no object behind
+	_, statErr := os.Stat(filepath.Join(rootDir, testBucket, "a.zip"))
+	assert.True(t, errors.Is(statErr, os.ErrNotExist))
+}
@@ -0,0 +1,294 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Directories under the root that are not buckets: object tags are kept in tagsDirName/<bucket>/<key>.json and
+// uploads are written to tmpDirName before being renamed into place
+const (
+	tagsDirName = ".tags"
+	tmpDirName  = ".tmp"
+	tagsFileExt = ".json"
+)
+
+// LocalClient stores objects as files under a local directory, for development and environments without S3
+type LocalClient struct {
+	rootDir string
+	bucket  string
+}
+
+func NewLocalClient(cfg LocalConfig) (StorageService, error) {
+	if cfg.RootDir == "" {
+		return nil, errors.New("local storage root directory is not configured")
+	}
+	rootDir, err := filepath.Abs(cfg.RootDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(rootDir, tmpDirName), 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalClient{
+		rootDir: rootDir,
+		bucket:  cfg.Bucket,
+	}, nil
+}
+
+func (c *LocalClient) Upload(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = c.write(ctx, filepath.Base(path), f, -1)
+	return err
+}
+
+func (c *LocalClient) UploadStream(ctx context.Context, key string, body io.Reader) error {
+	_, err := c.write(ctx, key, body, -1)
+	return err
+}
+
+func (c *LocalClient) UploadStreamWithContentLength(ctx context.Context, key string, body io.Reader, contentLength int64) error {
+	_, err := c.write(ctx, key, body, contentLength)
+	return err
+}
+
+func (c *LocalClient) UploadStreamWithChecksum(ctx context.Context, key string, body io.Reader) (*UploadResult, error) {
+	reader := newChecksumReader(body)
+	if _, err := c.write(ctx, key, reader, -1); err != nil {
+		return nil, err
+	}
+	return reader.result(key), nil
+}
+
+// StreamKeys streams object keys from the bucket directory
+func (c *LocalClient) StreamKeys(ctx context.Context, bucket string) (<-chan string, error) {
+	bucketDir, err := c.bucketDir(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan string)
+
+	go func() {
+		defer close(ch)
+
+		// Like a failed S3 listing, an unreadable bucket ends the stream early
+		var keys []string
+		err := filepath.WalkDir(bucketDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			rel, err := filepath.Rel(bucketDir, path)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, filepath.ToSlash(rel))
+			return nil
+		})
+		if err != nil {
+			return
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			select {
+			case ch <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// DownloadStream opens the object file for streaming
+func (c *LocalClient) DownloadStream(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	path, err := c.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// GetS3KeyFromRemotePath generates an object key by removing the remote directory prefix from the remote path.
+func (c *LocalClient) GetS3KeyFromRemotePath(remotePath, remoteDir string) string {
+	return keyFromRemotePath(remotePath, remoteDir)
+}
+
+// GetObjectImportStatus returns the value of the 'import_status' tag for the given key, or empty string if not set.
+func (c *LocalClient) GetObjectImportStatus(ctx context.Context, key string) (string, error) {
+	tags, err := c.readTags(key)
+	if err != nil {
+		return "", err
+	}
+	return tags[importStatusTagKey], nil
+}
+
+// SetObjectImportStatus sets the 'import_status' tag for the given key.
+func (c *LocalClient) SetObjectImportStatus(ctx context.Context, key, status string) error {
+	if err := c.ensureObjectExists(key); err != nil {
+		return err
+	}
+	tagsPath, err := c.tagsPath(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(map[string]string{importStatusTagKey: status})
+	if err != nil {
+		return err
+	}
+	_, err = c.writeFile(ctx, tagsPath, bytes.NewReader(data), -1)
+	return err
+}
+
+// write stores body under key in the client bucket. A new object replaces the previous one along with its tags,
+// as PutObject does. contentLength is checked unless it is negative.
+func (c *LocalClient) write(ctx context.Context, key string, body io.Reader, contentLength int64) (int64, error) {
+	path, err := c.objectPath(c.bucket, key)
+	if err != nil {
+		return 0, err
+	}
+	tagsPath, err := c.tagsPath(key)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := c.writeFile(ctx, path, body, contentLength)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Remove(tagsPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+	return size, nil
+}
+
+// writeFile writes to a temporary file first so that readers never see a partially written file
+func (c *LocalClient) writeFile(ctx context.Context, path string, body io.Reader, contentLength int64) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+	tmp, err := os.CreateTemp(filepath.Join(c.rootDir, tmpDirName), "upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	size, err := io.Copy(tmp, &contextReader{ctx: ctx, reader: body})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if contentLength >= 0 && size != contentLength {
+		return 0, fmt.Errorf("content length mismatch for %s: expected %d bytes, got %d", path, contentLength, size)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func (c *LocalClient) readTags(key string) (map[string]string, error) {
+	if err := c.ensureObjectExists(key); err != nil {
+		return nil, err
+	}
+	tagsPath, err := c.tagsPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(tagsPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]string{}
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("invalid tags for %s: %w", key, err)
+	}
+	return tags, nil
+}
+
+func (c *LocalClient) ensureObjectExists(key string) error {
+	path, err := c.objectPath(c.bucket, key)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not an object", key)
+	}
+	return nil
+}
+
+func (c *LocalClient) bucketDir(bucket string) (string, error) {
+	if bucket == "" || bucket == tagsDirName || bucket == tmpDirName || !fs.ValidPath(bucket) || strings.Contains(bucket, "/") {
+		return "", fmt.Errorf("invalid bucket name: %q", bucket)
+	}
+	return filepath.Join(c.rootDir, bucket), nil
+}
+
+func (c *LocalClient) objectPath(bucket, key string) (string, error) {
+	bucketDir, err := c.bucketDir(bucket)
+	if err != nil {
+		return "", err
+	}
+	if !fs.ValidPath(key) || key == "." {
+		return "", fmt.Errorf("invalid object key: %q", key)
+	}
+	return filepath.Join(bucketDir, filepath.FromSlash(key)), nil
+}
+
+func (c *LocalClient) tagsPath(key string) (string, error) {
+	path, err := c.objectPath(c.bucket, key)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(c.rootDir, path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(c.rootDir, tagsDirName, rel+tagsFileExt), nil
+}
+
+// contextReader stops a copy as soon as the context is cancelled
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
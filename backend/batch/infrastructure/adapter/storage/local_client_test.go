@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBucket = "payin-files"
+
+func newTestLocalClient(t *testing.T) (StorageService, string) {
+	t.Helper()
+
+	rootDir := t.TempDir()
+	client, err := NewStorageService(StorageConfig{
+		Backend: BackendLocal,
+		Local:   LocalConfig{RootDir: rootDir, Bucket: testBucket},
+	})
+	require.NoError(t, err)
+	return client, rootDir
+}
+
+func collectKeys(t *testing.T, client StorageService, bucket string) []string {
+	t.Helper()
+
+	ch, err := client.StreamKeys(context.Background(), bucket)
+	require.NoError(t, err)
+
+	var keys []string
+	for key := range ch {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestNewStorageService_Backend(t *testing.T) {
+	_, err := NewStorageService(StorageConfig{Backend: "gcs"})
+	assert.EqualError(t, err, `unsupported storage backend: "gcs"`)
+
+	_, err = NewStorageService(StorageConfig{Backend: BackendLocal})
+	assert.Error(t, err, "local backend requires a root directory")
+
+	client, err := NewStorageService(StorageConfig{Backend: "LOCAL", Local: LocalConfig{RootDir: t.TempDir(), Bucket: testBucket}})
+	require.NoError(t, err)
+	assert.IsType(t, &LocalClient{}, client)
+}
+
+func TestLocalClient_StreamKeys(t *testing.T) {
+	client, _ := newTestLocalClient(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"report/b.zip", "report-2025/a.zip", "report/a/c.zip", "top.csv"} {
+		require.NoError(t, client.UploadStream(ctx, key, strings.NewReader(key)))
+	}
+	require.NoError(t, client.SetObjectImportStatus(ctx, "top.csv", "success"))
+
+	// Same order as an S3 listing; tags and temporary files are not objects
+	assert.Equal(t, []string{"report-2025/a.zip", "report/a/c.zip", "report/b.zip", "top.csv"}, collectKeys(t, client, testBucket))
+	assert.Empty(t, collectKeys(t, client, "missing-bucket"))
+
+	_, err := client.StreamKeys(ctx, "../outside")
+	assert.Error(t, err)
+}
+
+func TestLocalClient_StreamKeys_ContextCancellation(t *testing.T) {
+	client, _ := newTestLocalClient(t)
+	require.NoError(t, client.UploadStream(context.Background(), "a.zip", strings.NewReader("a")))
+	require.NoError(t, client.UploadStream(context.Background(), "b.zip", strings.NewReader("b")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := client.StreamKeys(ctx, testBucket)
+	require.NoError(t, err)
+	cancel()
+
+	// The producer stops instead of blocking forever on an abandoned channel
+	for range ch {
+	}
+}
+
+func TestLocalClient_UploadAndDownloadStream(t *testing.T) {
+	client, rootDir := newTestLocalClient(t)
+	ctx := context.Background()
+	content := strings.Repeat("支払い詳細,1000\n", 1024)
+
+	result, err := client.UploadStreamWithChecksum(ctx, "top_up/report.zip", strings.NewReader(content))
+	require.NoError(t, err)
+
+	md5Sum := md5.Sum([]byte(content))
+	sha256Sum := sha256.Sum256([]byte(content))
+	assert.Equal(t, &UploadResult{
+		Key:    "top_up/report.zip",
+		Size:   int64(len(content)),
+		MD5:    hex.EncodeToString(md5Sum[:]),
+		SHA256: hex.EncodeToString(sha256Sum[:]),
+	}, result)
+
+	r, err := client.DownloadStream(ctx, testBucket, "top_up/report.zip")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, content, string(data))
+
+	tmpEntries, err := os.ReadDir(filepath.Join(rootDir, tmpDirName))
+	require.NoError(t, err)
+	assert.Empty(t, tmpEntries)
+
+	_, err = client.DownloadStream(ctx, testBucket, "top_up/missing.zip")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	_, err = client.DownloadStream(ctx, testBucket, "../../etc/passwd")
+	assert.Error(t, err)
+}
+
+func TestLocalClient_Upload(t *testing.T) {
+	client, _ := newTestLocalClient(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "summary.csv")
+	require.NoError(t, os.WriteFile(path, []byte("summary"), 0o644))
+	require.NoError(t, client.Upload(ctx, path))
+
+	assert.Equal(t, []string{"summary.csv"}, collectKeys(t, client, testBucket))
+}
+
+func TestLocalClient_UploadStreamWithContentLength(t *testing.T) {
+	client, _ := newTestLocalClient(t)
+	ctx := context.Background()
+
+	require.NoError(t, client.UploadStreamWithContentLength(ctx, "ok.csv", strings.NewReader("12345"), 5))
+
+	err := client.UploadStreamWithContentLength(ctx, "short.csv", strings.NewReader("123"), 5)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"ok.csv"}, collectKeys(t, client, testBucket))
+}
+
+func TestLocalClient_ImportStatus(t *testing.T) {
+	client, _ := newTestLocalClient(t)
+	ctx := context.Background()
+	require.NoError(t, client.UploadStream(ctx, "report/a.zip", strings.NewReader("v1")))
+
+	status, err := client.GetObjectImportStatus(ctx, "report/a.zip")
+	require.NoError(t, err)
+	assert.Empty(t, status)
+
+	require.NoError(t, client.SetObjectImportStatus(ctx, "report/a.zip", "processing"))
+	require.NoError(t, client.SetObjectImportStatus(ctx, "report/a.zip", "success"))
+	status, err = client.GetObjectImportStatus(ctx, "report/a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, "success", status)
+
+	// Replacing the object drops its tags, as PutObject does
+	require.NoError(t, client.UploadStream(ctx, "report/a.zip", strings.NewReader("v2")))
+	status, err = client.GetObjectImportStatus(ctx, "report/a.zip")
+	require.NoError(t, err)
+	assert.Empty(t, status)
+
+	_, err = client.GetObjectImportStatus(ctx, "report/missing.zip")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+	err = client.SetObjectImportStatus(ctx, "report/missing.zip", "success")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+}
+
+func TestLocalClient_GetS3KeyFromRemotePath(t *testing.T) {
+	client, _ := newTestLocalClient(t)
+
+	assert.Equal(t, "top_up/report.zip", client.GetS3KeyFromRemotePath("/paypay/top_up/report.zip", "/paypay"))
+	assert.Equal(t, "other/report.zip", client.GetS3KeyFromRemotePath("/other/report.zip", "/paypay"))
+}
@@ -8,17 +8,26 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/huydq/test/internal/pkg/utils"
 )
 
+// Objects larger than one part are uploaded as multipart uploads; each upload buffers at most
+// multipartPartSize * multipartConcurrency bytes at a time
+const (
+	multipartPartSize    = 8 << 20
+	multipartConcurrency = 2
+)
+
 type S3Client struct {
-	client *s3.Client
-	bucket string
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
 }
 
-func NewS3Client(cfg S3Config) (S3Service, error) {
+func NewS3Client(cfg S3Config) (StorageService, error) {
 	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithRegion(cfg.Region),
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, "")),
@@ -26,8 +35,13 @@ func NewS3Client(cfg S3Config) (S3Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	client := s3.NewFromConfig(awsCfg)
 	return &S3Client{
-		client: s3.NewFromConfig(awsCfg),
+		client: client,
+		uploader: manager.NewUploader(client, func(u *manager.Uploader) {
+			u.PartSize = multipartPartSize
+			u.Concurrency = multipartConcurrency
+		}),
 		bucket: cfg.Bucket,
 	}, nil
 }
@@ -69,6 +83,22 @@ func (u *S3Client) UploadStreamWithContentLength(ctx context.Context, key string
 	return err
 }
 
+func (u *S3Client) UploadStreamWithChecksum(ctx context.Context, key string, body io.Reader) (*UploadResult, error) {
+	reader := newChecksumReader(body)
+
+	_, err := u.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: &u.bucket,
+		Key:    utils.ToPtr(key),
+		Body:   reader,
+		ACL:    types.ObjectCannedACLPrivate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.result(key), nil
+}
+
 // StreamKeys streams object keys from S3
 func (d *S3Client) StreamKeys(ctx context.Context, bucket string) (<-chan string, error) {
 	ch := make(chan string)
@@ -113,23 +143,7 @@ func (d *S3Client) DownloadStream(ctx context.Context, bucket, key string) (io.R
 
 // GetS3KeyFromRemotePath generates an S3 key by removing the remote directory prefix from the remote path.
 func (d *S3Client) GetS3KeyFromRemotePath(remotePath, remoteDir string) string {
-	key := remotePath
-	if len(key) > 0 && key[0] == '/' {
-		key = key[1:]
-	}
-	if remoteDir != "" {
-		prefix := remoteDir
-		if len(prefix) > 0 && prefix[0] == '/' {
-			prefix = prefix[1:]
-		}
-		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
-			key = key[len(prefix):]
-			if len(key) > 0 && key[0] == '/' {
-				key = key[1:]
-			}
-		}
-	}
-	return key
+	return keyFromRemotePath(remotePath, remoteDir)
 }
 
 // GetObjectImportStatus returns the value of the 'import_status' tag for the given S3 key, or empty string if not set.
@@ -142,7 +156,7 @@ func (d *S3Client) GetObjectImportStatus(ctx context.Context, key string) (strin
 		return "", err
 	}
 	for _, tag := range resp.TagSet {
-		if tag.Key != nil && *tag.Key == "import_status" && tag.Value != nil {
+		if tag.Key != nil && *tag.Key == importStatusTagKey && tag.Value != nil {
 			return *tag.Value, nil
 		}
 	}
@@ -156,7 +170,7 @@ func (d *S3Client) SetObjectImportStatus(ctx context.Context, key, status string
 		Key:    &key,
 		Tagging: &types.Tagging{
 			TagSet: []types.Tag{{
-				Key:   utils.ToPtr("import_status"),
+				Key:   utils.ToPtr(importStatusTagKey),
 				Value: utils.ToPtr(status),
 			}},
 		},
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Supported storage backends
+const (
+	BackendS3    = "s3"
+	BackendLocal = "local"
+)
+
+// importStatusTagKey is the object tag holding the import status of a payin file
+const importStatusTagKey = "import_status"
+
+type StorageConfig struct {
+	Backend string // BackendS3 (default) or BackendLocal
+	S3      S3Config
+	Local   LocalConfig
+}
+
+type S3Config struct {
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type LocalConfig struct {
+	// RootDir holds one sub directory per bucket
+	RootDir string
+	Bucket  string
+}
+
+// StorageService is the object storage used by the batches. Keys are "/" separated regardless of the backend.
+type StorageService interface {
+	Upload(ctx context.Context, path string) error
+
+	UploadStream(ctx context.Context, key string, body io.Reader) error
+
+	UploadStreamWithContentLength(ctx context.Context, key string, body io.Reader, contentLength int64) error
+
+	// UploadStreamWithChecksum streams body to key without buffering the whole object, switching to a multipart
+	// upload for large objects, and returns the size and checksums of what was uploaded
+	UploadStreamWithChecksum(ctx context.Context, key string, body io.Reader) (*UploadResult, error)
+
+	// StreamKeys streams every object key of the bucket in lexicographical order
+	StreamKeys(ctx context.Context, bucket string) (<-chan string, error)
+
+	DownloadStream(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+
+	GetS3KeyFromRemotePath(remotePath, remoteDir string) string
+
+	// GetObjectImportStatus returns an error when the object does not exist and an empty string when it is not tagged
+	GetObjectImportStatus(ctx context.Context, key string) (string, error)
+
+	// SetObjectImportStatus replaces the tags of the object with the given import status
+	SetObjectImportStatus(ctx context.Context, key, status string) error
+}
+
+// NewStorageService creates the storage service for the configured backend
+func NewStorageService(cfg StorageConfig) (StorageService, error) {
+	switch strings.ToLower(cfg.Backend) {
+	case "", BackendS3:
+		return NewS3Client(cfg.S3)
+	case BackendLocal:
+		return NewLocalClient(cfg.Local)
+	default:
+		return nil, fmt.Errorf("unsupported storage backend: %q", cfg.Backend)
+	}
+}
+
+// keyFromRemotePath generates an object key by removing the remote directory prefix from the remote path.
+func keyFromRemotePath(remotePath, remoteDir string) string {
+	key := remotePath
+	if len(key) > 0 && key[0] == '/' {
+		key = key[1:]
+	}
+	if remoteDir != "" {
+		prefix := remoteDir
+		if len(prefix) > 0 && prefix[0] == '/' {
+			prefix = prefix[1:]
+		}
+		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
+			key = key[len(prefix):]
+			if len(key) > 0 && key[0] == '/' {
+				key = key[1:]
+			}
+		}
+	}
+	return key
+}
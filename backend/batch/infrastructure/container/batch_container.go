@@ -1,10 +1,16 @@
 package container
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"time"
 
+	batchJobPersistence "github.com/huydq/test/batch/infrastructure/persistence/batchjob"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
 	appConfig "github.com/huydq/test/internal/pkg/config"
 	"github.com/huydq/test/internal/pkg/dbconn"
 	"github.com/huydq/test/internal/pkg/logger"
@@ -22,6 +28,7 @@ type BatchService struct {
 	Logger    logger.Logger
 	DB        *gorm.DB
 	AppConfig *appConfig.Config
+	JobLeases *batchJobUsecase.BatchJobLeaseUsecase
 }
 
 func DefaultLogConfig(appConfig *appConfig.Config) *LogBatchConfig {
@@ -56,13 +63,49 @@ func NewBatchContainerWithConfig() (*BatchService, error) {
 		return nil, fmt.Errorf("database connection failed: %w", err)
 	}
 
+	jobLeases := batchJobUsecase.NewBatchJobLeaseUsecase(
+		batchJobPersistence.NewBatchJobLeaseRepository(db),
+		time.Duration(appConfig.BatchLeaseTTLSeconds)*time.Second,
+		cliLogger,
+	)
+
 	return &BatchService{
 		DB:        db,
 		Logger:    cliLogger,
 		AppConfig: appConfig,
+		JobLeases: jobLeases,
 	}, nil
 }
 
+// StartJobRun records the start of a batch command run in the batch_job_run history.
+// ctx must carry the DB; the returned recorder is nil when the start could not be recorded.
+func (s *BatchService) StartJobRun(ctx context.Context, commandName string, flags map[string]string) *batchJobUsecase.JobRunRecorder {
+	usecase := batchJobUsecase.NewBatchJobRunUsecase(batchJobPersistence.NewBatchJobRunRepository(s.DB))
+	return batchJobUsecase.StartJobRunRecorder(ctx, usecase, s.Logger, commandName, flags)
+}
+
+// AcquireCommandLease keeps other processes from running the command at the same time. ctx must carry the DB.
+// ok is false when the lease could not be taken, in which case the reason has been logged and the command should exit.
+func (s *BatchService) AcquireCommandLease(ctx context.Context, commandName string) (lease *batchJobUsecase.JobLease, ok bool) {
+	lease, err := s.JobLeases.Acquire(ctx, batchJobUsecase.CommandLeaseKey(commandName))
+	if errors.Is(err, batchJobUsecase.ErrLeaseHeld) {
+		log.Printf("%s is already running in another process, exiting: %v", commandName, err)
+		s.Logger.Warn("Another run of the command is in progress, exiting", map[string]any{
+			"command": commandName,
+			"reason":  err.Error(),
+		})
+		return nil, false
+	}
+	if err != nil {
+		s.Logger.Error("Failed to acquire the command lease", map[string]any{
+			"command": commandName,
+			"error":   err.Error(),
+		})
+		return nil, false
+	}
+	return lease, true
+}
+
 // Close releases resources when finished
 func (s *BatchService) Close() error {
 	sqlDB, err := s.DB.DB()
@@ -0,0 +1,28 @@
+package persistence
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/auditlog"
+	model "github.com/huydq/test/internal/domain/model/audit_log"
+	"github.com/huydq/test/internal/infrastructure/persistence/audit_log/convert"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type AuditLogPersistence struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &AuditLogPersistence{db: db}
+}
+
+func (r *AuditLogPersistence) Create(ctx context.Context, auditLog *model.AuditLog) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).Create(convert.ToAuditLogDTO(auditLog)).Error
+}
@@ -0,0 +1,95 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repository "github.com/huydq/test/batch/domain/repository/batchjob"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	"github.com/huydq/test/internal/infrastructure/persistence/batch_job_run/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type BatchJobLeasePersistence struct {
+	db *gorm.DB
+}
+
+func NewBatchJobLeaseRepository(db *gorm.DB) repository.BatchJobLeaseRepository {
+	return &BatchJobLeasePersistence{db: db}
+}
+
+func (r *BatchJobLeasePersistence) TryAcquire(ctx context.Context, lease *model.BatchJobLease, now time.Time) (bool, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// The unique lease_key makes the insert fail while any lease exists on the key
+	leaseDTO := dto.ToBatchJobLeaseDTO(lease)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(leaseDTO)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 1 {
+		lease.ID = leaseDTO.ID
+		return true, nil
+	}
+
+	// Take over the existing lease once it has expired
+	result = db.Model(&dto.BatchJobLease{}).
+		Where("lease_key = ? AND expires_at <= ?", lease.LeaseKey, now).
+		Updates(map[string]any{
+			"owner":       lease.Owner,
+			"acquired_at": lease.AcquiredAt,
+			"expires_at":  lease.ExpiresAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *BatchJobLeasePersistence) Renew(ctx context.Context, lease *model.BatchJobLease) (bool, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	result := db.Model(&dto.BatchJobLease{}).
+		Where("lease_key = ? AND owner = ?", lease.LeaseKey, lease.Owner).
+		Update("expires_at", lease.ExpiresAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *BatchJobLeasePersistence) Release(ctx context.Context, lease *model.BatchJobLease) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Where("lease_key = ? AND owner = ?", lease.LeaseKey, lease.Owner).
+		Delete(&dto.BatchJobLease{}).Error
+}
+
+func (r *BatchJobLeasePersistence) FindByKey(ctx context.Context, leaseKey string) (*model.BatchJobLease, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var leaseDTO dto.BatchJobLease
+	if err := db.Where("lease_key = ?", leaseKey).First(&leaseDTO).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return leaseDTO.ToBatchJobLeaseModel(), nil
+}
@@ -0,0 +1,63 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repository "github.com/huydq/test/batch/domain/repository/batchjob"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	"github.com/huydq/test/internal/infrastructure/persistence/batch_job_run/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type BatchJobRunPersistence struct {
+	db *gorm.DB
+}
+
+func NewBatchJobRunRepository(db *gorm.DB) repository.BatchJobRunRepository {
+	return &BatchJobRunPersistence{db: db}
+}
+
+func (r *BatchJobRunPersistence) Create(ctx context.Context, run *model.BatchJobRun) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	runDTO := dto.ToBatchJobRunDTO(run)
+	if err := db.Create(runDTO).Error; err != nil {
+		return err
+	}
+
+	run.ID = runDTO.ID
+	run.CreatedAt = runDTO.CreatedAt
+	run.UpdatedAt = runDTO.UpdatedAt
+	return nil
+}
+
+func (r *BatchJobRunPersistence) UpdateResult(ctx context.Context, run *model.BatchJobRun) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Model(&dto.BatchJobRun{}).
+		Select("status", "finished_at", "processed_count", "failed_count", "error_summary").
+		Where("id = ?", run.ID).
+		Updates(dto.ToBatchJobRunDTO(run)).Error
+}
+
+func (r *BatchJobRunPersistence) AddPayinFile(ctx context.Context, runID int, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&dto.BatchJobRunPayinFile{
+			BatchJobRunID: runID,
+			PayinFileID:   payinFileID,
+		}).Error
+}
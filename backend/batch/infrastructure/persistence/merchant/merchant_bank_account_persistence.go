@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	repository "github.com/huydq/test/batch/domain/repository/merchant"
+	model "github.com/huydq/test/internal/domain/model/merchant"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	"github.com/huydq/test/internal/infrastructure/persistence/merchant/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type MerchantBankAccountPersistence struct {
+	db *gorm.DB
+}
+
+func NewMerchantBankAccountRepository(db *gorm.DB) repository.MerchantBankAccountRepository {
+	return &MerchantBankAccountPersistence{db: db}
+}
+
+func (r *MerchantBankAccountPersistence) FindByMerchantIDs(ctx context.Context, merchantIDs []int) (map[int]*model.MerchantBankAccount, error) {
+	accounts := make(map[int]*model.MerchantBankAccount)
+	if len(merchantIDs) == 0 {
+		return accounts, nil
+	}
+
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountDTOs []*dto.MerchantBankAccount
+	err = db.WithContext(ctx).
+		Where("merchant_id IN ?", merchantIDs).
+		Where("status = ?", merchantObject.BankAccountStatusActive).
+		Order("id ASC").
+		Find(&accountDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one account per merchant is active; should there be more, the latest one wins
+	for _, accountDTO := range accountDTOs {
+		accounts[accountDTO.MerchantID] = accountDTO.ToMerchantBankAccountModel()
+	}
+	return accounts, nil
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	repository "github.com/huydq/test/batch/domain/repository/merchant"
+	model "github.com/huydq/test/internal/domain/model/merchant"
+	"github.com/huydq/test/internal/infrastructure/persistence/merchant/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type MerchantPersistence struct {
+	db *gorm.DB
+}
+
+func NewMerchantRepository(db *gorm.DB) repository.MerchantRepository {
+	return &MerchantPersistence{db: db}
+}
+
+func (r *MerchantPersistence) FindByPaymentMerchantIDs(ctx context.Context, paymentProviderID int, paymentMerchantIDs []string) ([]*model.Merchant, error) {
+	if len(paymentMerchantIDs) == 0 {
+		return nil, nil
+	}
+
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var merchantDTOs []*dto.Merchant
+	err = db.WithContext(ctx).
+		Where("payment_provider_id = ?", paymentProviderID).
+		Where("payment_merchant_id IN ?", paymentMerchantIDs).
+		Find(&merchantDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	merchants := make([]*model.Merchant, len(merchantDTOs))
+	for i, merchantDTO := range merchantDTOs {
+		merchants[i] = merchantDTO.ToMerchantModel()
+	}
+	return merchants, nil
+}
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"errors"
 
 	"gorm.io/gorm"
 
@@ -26,5 +27,37 @@ func (r *PayinFileGroupPersistence) Create(ctx context.Context, fileGroup *model
 	}
 
 	payinFileGroupDTO := dto.ToPayinFileGroupDTO(fileGroup)
-	return db.Create(payinFileGroupDTO).Error
+	if err := db.Create(payinFileGroupDTO).Error; err != nil {
+		return err
+	}
+	fileGroup.ID = payinFileGroupDTO.ID
+	return nil
+}
+
+func (r *PayinFileGroupPersistence) FindByID(ctx context.Context, id int) (*model.PayinFileGroup, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileGroupDTO dto.PayinFileGroup
+	if err := db.First(&fileGroupDTO, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return fileGroupDTO.ToPayinFileGroupModel(), nil
+}
+
+func (r *PayinFileGroupPersistence) UpdateCheckpoint(ctx context.Context, fileGroup *model.PayinFileGroup) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Model(&dto.PayinFileGroup{}).
+		Select("listed_at", "imported_at").
+		Where("id = ?", fileGroup.ID).
+		Updates(dto.ToPayinFileGroupDTO(fileGroup)).Error
 }
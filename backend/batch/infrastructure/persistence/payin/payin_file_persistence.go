@@ -9,6 +9,7 @@ import (
 
 	repository "github.com/huydq/test/batch/domain/repository/payin"
 	model "github.com/huydq/test/internal/domain/model/payin"
+	object "github.com/huydq/test/internal/domain/object/payin"
 	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
 	"github.com/huydq/test/internal/pkg/database"
 )
@@ -42,10 +43,34 @@ func (r *PayinFilePersistence) UpdateStatus(ctx context.Context, file *model.Pay
 		return err
 	}
 
-	return db.Model(&model.PayinFile{}).
+	return db.Model(&dto.PayinFile{}).
 		Select("download_status", "upload_status", "import_status").
 		Where("id = ?", file.ID).
-		Updates(file).Error
+		Updates(dto.ToPayinFileDTO(file)).Error
+}
+
+func (r *PayinFilePersistence) UpdateUploadResult(ctx context.Context, file *model.PayinFile) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Model(&dto.PayinFile{}).
+		Select("download_status", "upload_status", "file_size", "md5_checksum", "sha256_checksum").
+		Where("id = ?", file.ID).
+		Updates(dto.ToPayinFileDTO(file)).Error
+}
+
+func (r *PayinFilePersistence) UpdateEncoding(ctx context.Context, file *model.PayinFile) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Model(&dto.PayinFile{}).
+		Select("encoding").
+		Where("id = ?", file.ID).
+		Updates(dto.ToPayinFileDTO(file)).Error
 }
 
 func (r *PayinFilePersistence) FindByFilename(ctx context.Context, filename string) (*model.PayinFile, error) {
@@ -81,3 +106,39 @@ func (r *PayinFilePersistence) GetByID(ctx context.Context, id int) (*model.Payi
 
 	return fileDTO.ToPayinFileModel(), nil
 }
+
+// unfetchedCondition matches the files whose download or upload has not succeeded
+const unfetchedCondition = "payin_file_group_id = ? AND (download_status <> ? OR upload_status <> ?)"
+
+func (r *PayinFilePersistence) ListUnfetchedByGroupID(ctx context.Context, groupID int) ([]*model.PayinFile, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileDTOs []dto.PayinFile
+	if err := db.Where(unfetchedCondition, groupID, object.StatusSuccess, object.StatusSuccess).
+		Order("id").
+		Find(&fileDTOs).Error; err != nil {
+		return nil, err
+	}
+
+	files := make([]*model.PayinFile, 0, len(fileDTOs))
+	for i := range fileDTOs {
+		files = append(files, fileDTOs[i].ToPayinFileModel())
+	}
+	return files, nil
+}
+
+func (r *PayinFilePersistence) CountUnfetchedByGroupID(ctx context.Context, groupID int) (int64, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = db.Model(&dto.PayinFile{}).
+		Where(unfetchedCondition, groupID, object.StatusSuccess, object.StatusSuccess).
+		Count(&count).Error
+	return count, err
+}
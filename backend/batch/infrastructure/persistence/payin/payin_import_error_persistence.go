@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	repository "github.com/huydq/test/batch/domain/repository/payin"
+	model "github.com/huydq/test/internal/domain/model/payin"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// importErrorBatchSize keeps the inserts of a file with many invalid rows under the placeholder limit
+const importErrorBatchSize = 500
+
+type PayinImportErrorPersistence struct {
+	db *gorm.DB
+}
+
+func NewPayinImportErrorRepository(db *gorm.DB) repository.PayinImportErrorRepository {
+	return &PayinImportErrorPersistence{db: db}
+}
+
+func (r *PayinImportErrorPersistence) BulkCreate(ctx context.Context, importErrors []*model.PayinImportError) error {
+	if len(importErrors) == 0 {
+		return nil
+	}
+
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	importErrorDTOs := make([]*dto.PayinImportError, len(importErrors))
+	for i, importError := range importErrors {
+		importErrorDTOs[i] = dto.ToPayinImportErrorDTO(importError)
+	}
+	return db.WithContext(ctx).CreateInBatches(importErrorDTOs, importErrorBatchSize).Error
+}
+
+func (r *PayinImportErrorPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).Where("payin_file_id = ?", payinFileID).Delete(&dto.PayinImportError{}).Error
+}
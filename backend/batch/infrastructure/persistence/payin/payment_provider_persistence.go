@@ -0,0 +1,37 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	repository "github.com/huydq/test/batch/domain/repository/payin"
+	model "github.com/huydq/test/internal/domain/model/payin"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type PaymentProviderPersistence struct {
+	db *gorm.DB
+}
+
+func NewPaymentProviderRepository(db *gorm.DB) repository.PaymentProviderRepository {
+	return &PaymentProviderPersistence{db: db}
+}
+
+func (r *PaymentProviderPersistence) FindByCode(ctx context.Context, code string) (*model.PaymentProvider, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentProviderDTO dto.PaymentProvider
+	if err := db.Where("code = ?", code).First(&paymentProviderDTO).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return paymentProviderDTO.ToPaymentProviderModel(), nil
+}
@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repository "github.com/huydq/test/batch/domain/repository/payout"
+	model "github.com/huydq/test/internal/domain/model/payout"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	approvalDto "github.com/huydq/test/internal/infrastructure/persistence/approval/dto"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout/convert"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type PayoutPersistence struct {
+	db *gorm.DB
+}
+
+func NewPayoutRepository(db *gorm.DB) repository.PayoutRepository {
+	return &PayoutPersistence{db: db}
+}
+
+func (r *PayoutPersistence) FindByID(ctx context.Context, id int) (*model.Payout, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var payoutDTO dto.Payout
+	if err := db.WithContext(ctx).First(&payoutDTO, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convert.ToPayoutModel(&payoutDTO), nil
+}
+
+func (r *PayoutPersistence) FindApprovedBySendingDate(ctx context.Context, sendingDate time.Time) ([]*model.Payout, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Date(sendingDate.Year(), sendingDate.Month(), sendingDate.Day(), 0, 0, 0, 0, sendingDate.Location())
+	end := start.AddDate(0, 0, 1)
+
+	var payoutDTOs []*dto.Payout
+	err = db.WithContext(ctx).
+		Joins("Approval").
+		Where("payout.payout_status = ?", int(object.PayoutStatusCreated)).
+		Where("payout.sending_date >= ? AND payout.sending_date < ?", start, end).
+		Where("Approval.approval_status = ?", approvalDto.ApprovalStatusApproved).
+		Order("payout.id ASC").
+		Find(&payoutDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ToPayoutModels(payoutDTOs), nil
+}
+
+func (r *PayoutPersistence) Create(ctx context.Context, payout *model.Payout) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	payoutDTO := convert.ToPayoutDTO(payout)
+	if err := db.WithContext(ctx).Omit(clause.Associations).Create(payoutDTO).Error; err != nil {
+		return err
+	}
+
+	payout.ID = payoutDTO.ID
+	payout.CreatedAt = payoutDTO.CreatedAt
+	payout.UpdatedAt = payoutDTO.UpdatedAt
+	return nil
+}
+
+func (r *PayoutPersistence) UpdateStatus(ctx context.Context, payout *model.Payout) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	payoutDTO := convert.ToPayoutDTO(payout)
+	return db.WithContext(ctx).Model(&dto.Payout{}).
+		Select("payout_status", "sent_date").
+		Where("id = ?", payout.ID).
+		Updates(payoutDTO).Error
+}
@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repository "github.com/huydq/test/batch/domain/repository/payout"
+	model "github.com/huydq/test/internal/domain/model/payout_reconciliation"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout_reconciliation/convert"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type PayoutReconciliationPersistence struct {
+	db *gorm.DB
+}
+
+func NewPayoutReconciliationRepository(db *gorm.DB) repository.PayoutReconciliationRepository {
+	return &PayoutReconciliationPersistence{db: db}
+}
+
+func (r *PayoutReconciliationPersistence) Save(ctx context.Context, reconciliation *model.PayoutReconciliation) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	reconciliationDTO := convert.ToPayoutReconciliationDTO(reconciliation)
+	result := db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{
+				"aozora_transfer_apply_no",
+				"local_amount",
+				"bank_amount",
+				"bank_transfer_status",
+				"detected_at",
+				"updated_at",
+			}),
+		}).
+		Create(reconciliationDTO)
+	if result.Error == nil {
+		reconciliation.ID = reconciliationDTO.ID
+	}
+
+	return result.Error
+}
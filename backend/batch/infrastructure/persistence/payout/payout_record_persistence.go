@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repository "github.com/huydq/test/batch/domain/repository/payout"
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout_record/convert"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout_record/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type PayoutRecordPersistence struct {
+	db *gorm.DB
+}
+
+func NewPayoutRecordRepository(db *gorm.DB) repository.PayoutRecordRepository {
+	return &PayoutRecordPersistence{db: db}
+}
+
+func (r *PayoutRecordPersistence) FindByPayoutID(ctx context.Context, payoutID int) ([]*model.PayoutRecord, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordDTOs []*dto.PayoutRecord
+	err = db.WithContext(ctx).
+		Where("payout_id = ?", payoutID).
+		Order("id ASC").
+		Find(&recordDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ToPayoutRecordModels(recordDTOs), nil
+}
+
+func (r *PayoutRecordPersistence) FindByTransferStatuses(ctx context.Context, statuses []object.TransferStatus) ([]*model.PayoutRecord, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statusValues := make([]int, len(statuses))
+	for i, status := range statuses {
+		statusValues[i] = int(status)
+	}
+
+	var recordDTOs []*dto.PayoutRecord
+	err = db.WithContext(ctx).
+		Where("transfer_status IN ?", statusValues).
+		Where("aozora_transfer_apply_no <> ''").
+		Order("payout_id ASC, id ASC").
+		Find(&recordDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ToPayoutRecordModels(recordDTOs), nil
+}
+
+func (r *PayoutRecordPersistence) CreateBatch(ctx context.Context, records []*model.PayoutRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	recordDTOs := convert.ToPayoutRecordDTOs(records)
+	if err := db.WithContext(ctx).Omit(clause.Associations).Create(&recordDTOs).Error; err != nil {
+		return err
+	}
+
+	for i, recordDTO := range recordDTOs {
+		records[i].ID = recordDTO.ID
+	}
+	return nil
+}
+
+func (r *PayoutRecordPersistence) UpdateTransferResult(ctx context.Context, record *model.PayoutRecord) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	recordDTO := convert.ToPayoutRecordDTO(record)
+	return db.WithContext(ctx).Model(&dto.PayoutRecord{}).
+		Select(
+			"transfer_status",
+			"aozora_transfer_apply_no",
+			"transfer_requested_at",
+			"transfer_executed_at",
+			"transfer_request_error",
+		).
+		Where("id = ?", record.ID).
+		Updates(recordDTO).Error
+}
@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/paypay"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	dto "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaypayInvoicePersistence struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) repository.PaypayInvoiceRepository {
+	return &PaypayInvoicePersistence{db: db}
+}
+
+// invoiceUpsertColumns are updated when an invoice of the same payin file already exists
+var invoiceUpsertColumns = []string{
+	"duplicate", "file_name", "storage_key", "registration_number", "issue_date", "payment_merchant_id",
+	"merchant_business_name", "cutoff_date", "taxable_amount", "tax_amount", "total_amount",
+	"paypay_payin_summary_id", "paypay_payin_detail_id", "updated_at", "deleted_at",
+}
+
+func (r *PaypayInvoicePersistence) Upsert(ctx context.Context, invoice *model.PaypayInvoice) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	paypayInvoiceDTO := dto.ToPaypayInvoiceDTO(invoice)
+	err = db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(invoiceUpsertColumns)}).
+		Create(paypayInvoiceDTO).Error
+	if err != nil {
+		return err
+	}
+
+	invoice.ID = paypayInvoiceDTO.ID
+	return nil
+}
+
+func (r *PaypayInvoicePersistence) FindUnlinked(ctx context.Context) ([]*model.PaypayInvoice, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := db.Model(&dto.PaypayPayinDetail{}).Select("id")
+	summaries := db.Model(&dto.PaypayPayinSummary{}).Select("id")
+
+	var paypayInvoiceDTOs []*dto.PaypayInvoice
+	err = db.WithContext(ctx).
+		Where("payment_merchant_id <> '' AND cutoff_date IS NOT NULL").
+		Where(db.Where("paypay_payin_detail_id IS NULL").
+			Or("paypay_payin_detail_id NOT IN (?)", details).
+			Or("paypay_payin_summary_id IS NULL").
+			Or("paypay_payin_summary_id NOT IN (?)", summaries)).
+		Order("id ASC").
+		Find(&paypayInvoiceDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToPaypayInvoiceModels(paypayInvoiceDTOs), nil
+}
+
+func (r *PaypayInvoicePersistence) UpdateLinks(ctx context.Context, invoice *model.PaypayInvoice) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).
+		Model(&dto.PaypayInvoice{}).
+		Where("id = ?", invoice.ID).
+		Updates(map[string]any{
+			"paypay_payin_detail_id":  invoice.PaypayPayinDetailID,
+			"paypay_payin_summary_id": invoice.PaypayPayinSummaryID,
+		}).Error
+}
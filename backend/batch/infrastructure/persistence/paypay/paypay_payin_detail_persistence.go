@@ -2,13 +2,17 @@ package persistence
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	repository "github.com/huydq/test/batch/domain/repository/paypay"
 	model "github.com/huydq/test/internal/domain/model/paypay"
 	dto "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+	transactionDto "github.com/huydq/test/internal/infrastructure/persistence/transaction/dto"
 
 	"github.com/huydq/test/internal/pkg/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaypayPayinDetailPersistence struct {
@@ -19,12 +23,118 @@ func NewPayinDetailRepository(db *gorm.DB) repository.PaypayPayinDetailRepositor
 	return &PaypayPayinDetailPersistence{db: db}
 }
 
-func (r *PaypayPayinDetailPersistence) BulkInsert(ctx context.Context, details []*model.PaypayPayinDetail) error {
+// detailUpsertColumns are updated when a detail with the same merchant and cutoff date already exists
+var detailUpsertColumns = []string{
+	"payin_file_id", "merchant_business_name", "transaction_amount", "refund_amount", "usage_fee", "platform_fee",
+	"initial_fee", "tax", "cashback", "adjustment", "fee", "amount", "updated_at", "deleted_at",
+}
+
+func (r *PaypayPayinDetailPersistence) BulkUpsert(ctx context.Context, details []*model.PaypayPayinDetail) error {
 	db, err := database.GetTxOrDB(ctx)
 	if err != nil {
 		return err
 	}
 
 	paypayPayinDetailDTOs := dto.ToPaypayPayinDetailDTOs(details)
-	return db.WithContext(ctx).Create(&paypayPayinDetailDTOs).Error
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(detailUpsertColumns)}).
+		Create(&paypayPayinDetailDTOs).Error
+}
+
+func (r *PaypayPayinDetailPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Hard delete, as the rows of the file are imported again under the same natural keys
+	return db.WithContext(ctx).Unscoped().Where("payin_file_id = ?", payinFileID).Delete(&dto.PaypayPayinDetail{}).Error
+}
+
+func (r *PaypayPayinDetailPersistence) FindAggregatedByNaturalKeys(ctx context.Context, details []*model.PaypayPayinDetail) ([]*model.PaypayPayinDetail, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys [][]any
+	for _, detail := range details {
+		if detail.CutoffDate != nil {
+			keys = append(keys, []any{detail.PaymentMerchantID, detail.CutoffDate.Format("2006-01-02")})
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	aggregated := db.Model(&transactionDto.TransactionRecord{}).Select("payin_detail_id")
+
+	// Locked so that the details are not aggregated while the rows of the file are upserted
+	var paypayPayinDetailDTOs []*dto.PaypayPayinDetail
+	err = db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("(payment_merchant_id, cutoff_date) IN ?", keys).
+		Where("id IN (?)", aggregated).
+		Find(&paypayPayinDetailDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToPaypayPayinDetailModels(paypayPayinDetailDTOs), nil
+}
+
+func (r *PaypayPayinDetailPersistence) CountAggregatedByPayinFileID(ctx context.Context, payinFileID int) (int64, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = db.WithContext(ctx).
+		Model(&transactionDto.TransactionRecord{}).
+		Joins("JOIN paypay_payin_detail ON paypay_payin_detail.id = transaction_record.payin_detail_id").
+		Where("paypay_payin_detail.payin_file_id = ?", payinFileID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *PaypayPayinDetailPersistence) FindUnaggregatedByCutoffDate(ctx context.Context, cutoffDate time.Time) ([]*model.PaypayPayinDetail, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregated := db.Model(&transactionDto.TransactionRecord{}).Select("payin_detail_id")
+
+	var paypayPayinDetailDTOs []*dto.PaypayPayinDetail
+	err = db.WithContext(ctx).
+		Where("cutoff_date = ?", cutoffDate.Format("2006-01-02")).
+		Where("id NOT IN (?)", aggregated).
+		Order("payment_merchant_id ASC, id ASC").
+		Find(&paypayPayinDetailDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToPaypayPayinDetailModels(paypayPayinDetailDTOs), nil
+}
+
+func (r *PaypayPayinDetailPersistence) FindByMerchantAndCutoffDate(ctx context.Context, merchantID string, cutoffDate time.Time) (*model.PaypayPayinDetail, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var paypayPayinDetailDTO dto.PaypayPayinDetail
+	err = db.WithContext(ctx).
+		Where("payment_merchant_id = ? AND cutoff_date = ?", merchantID, cutoffDate.Format("2006-01-02")).
+		First(&paypayPayinDetailDTO).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return paypayPayinDetailDTO.ToPaypayPayinDetailModel(), nil
 }
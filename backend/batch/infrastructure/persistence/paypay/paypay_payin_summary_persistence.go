@@ -2,6 +2,8 @@ package persistence
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	repository "github.com/huydq/test/batch/domain/repository/paypay"
 	model "github.com/huydq/test/internal/domain/model/paypay"
@@ -9,6 +11,7 @@ import (
 
 	"github.com/huydq/test/internal/pkg/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaypayPayinSummaryPersistence struct {
@@ -19,12 +22,50 @@ func NewPayinSummaryRepository(db *gorm.DB) repository.PaypayPayinSummaryReposit
 	return &PaypayPayinSummaryPersistence{db: db}
 }
 
-func (r *PaypayPayinSummaryPersistence) BulkInsert(ctx context.Context, summaries []*model.PaypayPayinSummary) error {
+// summaryUpsertColumns are updated when a summary with the same corporate name, cutoff date and payment date already exists
+var summaryUpsertColumns = []string{
+	"payin_file_id", "transaction_amount", "refund_amount", "usage_fee", "platform_fee", "initial_fee", "tax",
+	"cashback", "adjustment", "fee", "amount", "updated_at", "deleted_at",
+}
+
+func (r *PaypayPayinSummaryPersistence) BulkUpsert(ctx context.Context, summaries []*model.PaypayPayinSummary) error {
 	db, err := database.GetTxOrDB(ctx)
 	if err != nil {
 		return err
 	}
 
 	paypayPayinSummaryDTOs := dto.ToPaypayPayinSummaryDTOs(summaries)
-	return db.WithContext(ctx).Create(&paypayPayinSummaryDTOs).Error
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(summaryUpsertColumns)}).
+		Create(&paypayPayinSummaryDTOs).Error
+}
+
+func (r *PaypayPayinSummaryPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Hard delete, as the rows of the file are imported again under the same natural keys
+	return db.WithContext(ctx).Unscoped().Where("payin_file_id = ?", payinFileID).Delete(&dto.PaypayPayinSummary{}).Error
+}
+
+func (r *PaypayPayinSummaryPersistence) FindByPayinFileIDAndCutoffDate(ctx context.Context, payinFileID int, cutoffDate time.Time) (*model.PaypayPayinSummary, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var paypayPayinSummaryDTO dto.PaypayPayinSummary
+	err = db.WithContext(ctx).
+		Where("payin_file_id = ? AND cutoff_date = ?", payinFileID, cutoffDate.Format("2006-01-02")).
+		First(&paypayPayinSummaryDTO).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return paypayPayinSummaryDTO.ToPaypayPayinSummaryModel(), nil
 }
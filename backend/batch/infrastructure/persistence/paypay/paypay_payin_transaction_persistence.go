@@ -5,10 +5,12 @@ import (
 
 	repository "github.com/huydq/test/batch/domain/repository/paypay"
 	model "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/paypay"
 	dto "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
 
 	"github.com/huydq/test/internal/pkg/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaypayPayinTransactionPersistence struct {
@@ -19,12 +21,85 @@ func NewPayinTransactionRepository(db *gorm.DB) repository.PaypayPayinTransactio
 	return &PaypayPayinTransactionPersistence{db: db}
 }
 
-func (r *PaypayPayinTransactionPersistence) BulkInsert(ctx context.Context, transactions []*model.PaypayPayinTransaction) error {
+// transactionUpsertColumns are updated when a transaction with the same payment transaction ID and status already exists
+var transactionUpsertColumns = []string{
+	"payin_file_id", "payment_merchant_id", "merchant_business_name", "shop_id", "shop_name", "terminal_code",
+	"transaction_at", "transaction_amount", "receipt_number", "ssid", "merchant_order_id", "payment_detail",
+	"paypay_payment_method", "paypay_payment_method_label", "updated_at", "deleted_at",
+}
+
+func (r *PaypayPayinTransactionPersistence) BulkUpsert(ctx context.Context, transactions []*model.PaypayPayinTransaction) error {
 	db, err := database.GetTxOrDB(ctx)
 	if err != nil {
 		return err
 	}
 
 	paypayPayinTransactionDTOs := dto.ToPaypayPayinTransactionDTOs(transactions)
-	return db.WithContext(ctx).Create(&paypayPayinTransactionDTOs).Error
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(transactionUpsertColumns)}).
+		Create(&paypayPayinTransactionDTOs).Error
+}
+
+func (r *PaypayPayinTransactionPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Hard delete, as the rows of the file are imported again under the same natural keys
+	return db.WithContext(ctx).Unscoped().Where("payin_file_id = ?", payinFileID).Delete(&dto.PaypayPayinTransaction{}).Error
+}
+
+func (r *PaypayPayinTransactionPersistence) CountUnmappedPaymentMethodLabels(ctx context.Context) (map[string]int64, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Label string
+		Count int64
+	}
+	err = db.WithContext(ctx).Model(&dto.PaypayPayinTransaction{}).
+		Select("paypay_payment_method_label AS label, COUNT(*) AS count").
+		Where("paypay_payment_method IS NULL AND paypay_payment_method_label IS NOT NULL AND paypay_payment_method_label <> ''").
+		Group("paypay_payment_method_label").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Label] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *PaypayPayinTransactionPersistence) UpdatePaymentMethodByLabel(ctx context.Context, label string, method object.PaypayPaymentMethod) (int64, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	result := db.WithContext(ctx).Model(&dto.PaypayPayinTransaction{}).
+		Where("paypay_payment_method IS NULL AND paypay_payment_method_label = ?", label).
+		Update("paypay_payment_method", method)
+	return result.RowsAffected, result.Error
+}
+
+func (r *PaypayPayinTransactionPersistence) ListPayinFileIDsWithoutPaymentMethodLabel(ctx context.Context) ([]int, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The import keeps an empty label for the rows without one, so only the rows imported earlier have none
+	var payinFileIDs []int
+	err = db.WithContext(ctx).Model(&dto.PaypayPayinTransaction{}).
+		Distinct("payin_file_id").
+		Where("paypay_payment_method IS NULL AND paypay_payment_method_label IS NULL").
+		Order("payin_file_id").
+		Pluck("payin_file_id", &payinFileIDs).Error
+	return payinFileIDs, err
 }
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/paypay"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	dto "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaypayTopUpDetailPersistence struct {
+	db *gorm.DB
+}
+
+func NewTopUpDetailRepository(db *gorm.DB) repository.PaypayTopUpDetailRepository {
+	return &PaypayTopUpDetailPersistence{db: db}
+}
+
+// topUpDetailUpsertColumns are updated when a detail with the same merchant, cutoff date and payment date already exists
+var topUpDetailUpsertColumns = []string{
+	"payin_file_id", "merchant_business_name", "amount", "bank_name", "branch_name", "account_type",
+	"account_number", "account_holder", "updated_at", "deleted_at",
+}
+
+func (r *PaypayTopUpDetailPersistence) BulkUpsert(ctx context.Context, details []*model.PaypayTopUpDetail) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	paypayTopUpDetailDTOs := dto.ToPaypayTopUpDetailDTOs(details)
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(topUpDetailUpsertColumns)}).
+		Create(&paypayTopUpDetailDTOs).Error
+}
+
+func (r *PaypayTopUpDetailPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Hard delete, as the rows of the file are imported again under the same natural keys
+	return db.WithContext(ctx).Unscoped().Where("payin_file_id = ?", payinFileID).Delete(&dto.PaypayTopUpDetail{}).Error
+}
@@ -0,0 +1,89 @@
+package persistence
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/paypay"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/paypay"
+	dto "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaypayTransactionDetailPersistence struct {
+	db *gorm.DB
+}
+
+func NewTransactionDetailRepository(db *gorm.DB) repository.PaypayTransactionDetailRepository {
+	return &PaypayTransactionDetailPersistence{db: db}
+}
+
+// transactionDetailUpsertColumns are updated when a detail with the same transaction ID and status already exists
+var transactionDetailUpsertColumns = []string{
+	"payin_file_id", "shipping_related", "payment_merchant_id", "merchant_business_name", "shop_id", "shop_name",
+	"terminal_code", "transaction_at", "transaction_amount", "receipt_number", "paypay_payment_method",
+	"paypay_payment_method_label", "merchant_order_id", "shipping_fee", "shipped_at", "updated_at", "deleted_at",
+}
+
+func (r *PaypayTransactionDetailPersistence) BulkUpsert(ctx context.Context, details []*model.PaypayTransactionDetail) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	paypayTransactionDetailDTOs := dto.ToPaypayTransactionDetailDTOs(details)
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(transactionDetailUpsertColumns)}).
+		Create(&paypayTransactionDetailDTOs).Error
+}
+
+func (r *PaypayTransactionDetailPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Hard delete, as the rows of the file are imported again under the same natural keys
+	return db.WithContext(ctx).Unscoped().Where("payin_file_id = ?", payinFileID).Delete(&dto.PaypayTransactionDetail{}).Error
+}
+
+func (r *PaypayTransactionDetailPersistence) CountUnmappedPaymentMethodLabels(ctx context.Context) (map[string]int64, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Label string
+		Count int64
+	}
+	err = db.WithContext(ctx).Model(&dto.PaypayTransactionDetail{}).
+		Select("paypay_payment_method_label AS label, COUNT(*) AS count").
+		Where("paypay_payment_method IS NULL AND paypay_payment_method_label IS NOT NULL AND paypay_payment_method_label <> ''").
+		Group("paypay_payment_method_label").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Label] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *PaypayTransactionDetailPersistence) UpdatePaymentMethodByLabel(ctx context.Context, label string, method object.PaypayPaymentMethod) (int64, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	result := db.WithContext(ctx).Model(&dto.PaypayTransactionDetail{}).
+		Where("paypay_payment_method IS NULL AND paypay_payment_method_label = ?", label).
+		Update("paypay_payment_method", method)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/paypay"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	dto "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaypayTransactionSummaryPersistence struct {
+	db *gorm.DB
+}
+
+func NewTransactionSummaryRepository(db *gorm.DB) repository.PaypayTransactionSummaryRepository {
+	return &PaypayTransactionSummaryPersistence{db: db}
+}
+
+// transactionSummaryUpsertColumns are updated when a summary with the same merchant and transaction date already exists
+var transactionSummaryUpsertColumns = []string{
+	"payin_file_id", "merchant_business_name", "transaction_count", "transaction_amount", "refund_count",
+	"refund_amount", "usage_fee", "updated_at", "deleted_at",
+}
+
+func (r *PaypayTransactionSummaryPersistence) BulkUpsert(ctx context.Context, summaries []*model.PaypayTransactionSummary) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	paypayTransactionSummaryDTOs := dto.ToPaypayTransactionSummaryDTOs(summaries)
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(transactionSummaryUpsertColumns)}).
+		Create(&paypayTransactionSummaryDTOs).Error
+}
+
+func (r *PaypayTransactionSummaryPersistence) DeleteByPayinFileID(ctx context.Context, payinFileID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Hard delete, as the rows of the file are imported again under the same natural keys
+	return db.WithContext(ctx).Unscoped().Where("payin_file_id = ?", payinFileID).Delete(&dto.PaypayTransactionSummary{}).Error
+}
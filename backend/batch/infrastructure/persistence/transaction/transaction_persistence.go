@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	repository "github.com/huydq/test/batch/domain/repository/transaction"
+	model "github.com/huydq/test/internal/domain/model/transaction"
+	"github.com/huydq/test/internal/infrastructure/persistence/transaction/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type TransactionPersistence struct {
+	db *gorm.DB
+}
+
+func NewTransactionRepository(db *gorm.DB) repository.TransactionRepository {
+	return &TransactionPersistence{db: db}
+}
+
+func (r *TransactionPersistence) Create(ctx context.Context, transaction *model.Transaction) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	transactionDTO := dto.ToTransactionDTO(transaction)
+	if err := db.WithContext(ctx).Create(transactionDTO).Error; err != nil {
+		return err
+	}
+
+	transaction.ID = transactionDTO.ID
+	for i := range transaction.TransactionRecords {
+		transaction.TransactionRecords[i].TransactionID = transaction.ID
+	}
+	return nil
+}
+
+func (r *TransactionPersistence) UpdatePayoutRecordID(ctx context.Context, transaction *model.Transaction) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).Model(&dto.Transaction{}).
+		Where("id = ?", transaction.ID).
+		Update("payout_record_id", transaction.PayoutRecordID).Error
+}
@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	repository "github.com/huydq/test/batch/domain/repository/transaction"
+	model "github.com/huydq/test/internal/domain/model/transaction"
+	"github.com/huydq/test/internal/infrastructure/persistence/transaction/dto"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+type TransactionRecordPersistence struct {
+	db *gorm.DB
+}
+
+func NewTransactionRecordRepository(db *gorm.DB) repository.TransactionRecordRepository {
+	return &TransactionRecordPersistence{db: db}
+}
+
+func (r *TransactionRecordPersistence) BulkInsert(ctx context.Context, records []model.TransactionRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	transactionRecordDTOs := dto.ToTransactionRecordDTOs(records)
+	if err := db.WithContext(ctx).Create(&transactionRecordDTOs).Error; err != nil {
+		return err
+	}
+
+	for i, recordDTO := range transactionRecordDTOs {
+		records[i].ID = recordDTO.ID
+	}
+	return nil
+}
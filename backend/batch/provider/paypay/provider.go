@@ -0,0 +1,229 @@
+package paypay
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	csvService "github.com/huydq/test/batch/domain/service/shared/csv"
+	auditLogPersistence "github.com/huydq/test/batch/infrastructure/persistence/auditlog"
+	paypayPersistence "github.com/huydq/test/batch/infrastructure/persistence/paypay"
+	"github.com/huydq/test/batch/provider"
+	task "github.com/huydq/test/batch/task/paypay/import_payin_file"
+	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/config"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// ProviderCode is the code of PayPay in the payment_provider table
+const ProviderCode = "PAYPAY"
+
+// Provider is the PayPay payin provider: zipped CSV reports under the top-up and dated transaction details folders,
+// and the qualified invoice PDFs
+type Provider struct {
+	headerMapping *paypayService.CSVHeaderMappingService
+	validator     *paypayService.ValidateCSVFieldsService
+}
+
+// NewProvider creates a new instance of Provider
+func NewProvider() *Provider {
+	return &Provider{
+		headerMapping: paypayService.NewCSVHeaderMappingService(),
+		validator:     paypayService.NewValidateCSVFieldsService(),
+	}
+}
+
+// Code returns the code of PayPay in the payment_provider table
+func (p *Provider) Code() string {
+	return ProviderCode
+}
+
+// Layout returns the report folders of PayPay configured in the application config. The encodings of the CSV files
+// are detected per file unless configured, and the invoice spreadsheets are fetched but not imported.
+func (p *Provider) Layout(appConfig *config.Config) (*provider.ReportFolders, error) {
+	topUpReportEncoding, err := payinObject.ParsePayinFileEncoding(appConfig.TopUpReportEncoding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid top-up report encoding: %w", err)
+	}
+	topUpSummaryDetailsEncoding, err := payinObject.ParsePayinFileEncoding(appConfig.TopUpSummaryDetailsEncoding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid top-up summary details encoding: %w", err)
+	}
+	topUpDetailsEncoding, err := payinObject.ParsePayinFileEncoding(appConfig.TopUpDetailsEncoding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid top-up details encoding: %w", err)
+	}
+	transactionDetailsEncoding, err := payinObject.ParsePayinFileEncoding(appConfig.TransactionDetailsEncoding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction details encoding: %w", err)
+	}
+
+	return provider.NewReportFolders(
+		appConfig.RemoteDir,
+		provider.ReportFolder{Path: appConfig.TransactionDetailsNoShippingRelatedPath, Dated: true, FileType: payinObject.PayinFileTypeTransactionDetail, Encoding: transactionDetailsEncoding},
+		provider.ReportFolder{Path: appConfig.TransactionDetailsSummaryPath, Dated: true, FileType: payinObject.PayinFileTypeTransactionSummary, Encoding: transactionDetailsEncoding},
+		provider.ReportFolder{Path: appConfig.TransactionDetailsShippingRelatedPath, Dated: true, FileType: payinObject.PayinFileTypeShippingTransactionDetail, Encoding: transactionDetailsEncoding},
+		provider.ReportFolder{Path: appConfig.TopUpDetailsPath, FileType: payinObject.PayinFileTypeTopUpDetail, Encoding: topUpDetailsEncoding},
+		provider.ReportFolder{Path: appConfig.TopUpSummaryDetailsPath, FileType: payinObject.PayinFileTypePaymentTransaction, Encoding: topUpSummaryDetailsEncoding},
+		provider.ReportFolder{Path: appConfig.TopUpReportPath, FileType: payinObject.PayinFileTypePaymentSummary, Encoding: topUpReportEncoding},
+		provider.ReportFolder{Path: appConfig.ValidInvoicesPath, FileType: payinObject.PayinFileTypeInvoice},
+		provider.ReportFolder{Path: appConfig.ValidInvoicesDuplicatePath, FileType: payinObject.PayinFileTypeDuplicateInvoice},
+		provider.ReportFolder{Path: appConfig.ValidInvoicesSpreadsheetsPath, FetchOnly: true},
+	), nil
+}
+
+// HeaderMapping returns the mapping of the Japanese report headers to the column names
+func (p *Provider) HeaderMapping() provider.HeaderMapping {
+	return p.headerMapping
+}
+
+// Validator returns the validator of the headers required by each type of report
+func (p *Provider) Validator() provider.HeaderValidator {
+	return p.validator
+}
+
+// TargetTables returns the tables the reports of each file type are imported into. The top-up report holds both the
+// payin summary and the payin detail sections.
+func (p *Provider) TargetTables() map[payinObject.PayinFileType][]string {
+	return map[payinObject.PayinFileType][]string{
+		payinObject.PayinFileTypePaymentSummary:            {"paypay_payin_summary", "paypay_payin_detail"},
+		payinObject.PayinFileTypePaymentTransaction:        {"paypay_payin_transaction"},
+		payinObject.PayinFileTypeTransactionDetail:         {"paypay_transaction_detail"},
+		payinObject.PayinFileTypeShippingTransactionDetail: {"paypay_transaction_detail"},
+		payinObject.PayinFileTypeTransactionSummary:        {"paypay_transaction_summary"},
+		payinObject.PayinFileTypeTopUpDetail:               {"paypay_top_up_detail"},
+		payinObject.PayinFileTypeInvoice:                   {"paypay_invoice"},
+		payinObject.PayinFileTypeDuplicateInvoice:          {"paypay_invoice"},
+	}
+}
+
+// NewImporter wires the importers of the zipped CSV reports and of the invoice PDFs
+func (p *Provider) NewImporter(ctx context.Context, env provider.ImportEnv) (provider.Importer, error) {
+	batchService := env.BatchService
+	appConfig := batchService.AppConfig
+
+	// Labels of the payment methods added to the default ones
+	paymentMethodAliases, err := paypayService.ParsePaymentMethodAliases(appConfig.PaypayPaymentMethodAliases)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment method aliases: %w", err)
+	}
+
+	// Initialize repositories
+	paypayPayinDetailRepo := paypayPersistence.NewPayinDetailRepository(batchService.DB)
+	paypayPayinSummaryRepo := paypayPersistence.NewPayinSummaryRepository(batchService.DB)
+	paypayPayinTransactionRepo := paypayPersistence.NewPayinTransactionRepository(batchService.DB)
+	paypayTransactionDetailRepo := paypayPersistence.NewTransactionDetailRepository(batchService.DB)
+	paypayTransactionSummaryRepo := paypayPersistence.NewTransactionSummaryRepository(batchService.DB)
+	paypayTopUpDetailRepo := paypayPersistence.NewTopUpDetailRepository(batchService.DB)
+	paypayInvoiceRepo := paypayPersistence.NewInvoiceRepository(batchService.DB)
+	auditLogRepo := auditLogPersistence.NewAuditLogRepository(batchService.DB)
+
+	// The payment methods are mapped while the rows are inserted, so the services are needed by the usecases
+	paymentMethodMappingService := paypayService.NewPaymentMethodMappingService(paymentMethodAliases)
+	paymentDetailParseService := paypayService.NewPaymentDetailParseService(paymentMethodMappingService)
+
+	// Initialize usecases
+	logger := batchService.Logger
+	detailUC := paypayUsecase.NewPayinDetailUsecase(paypayPayinDetailRepo, logger)
+	summaryUC := paypayUsecase.NewPayinSummaryUsecase(paypayPayinSummaryRepo, logger)
+	transactionUC := paypayUsecase.NewPayinTransactionUsecase(paypayPayinTransactionRepo, paymentMethodMappingService, paymentDetailParseService, logger)
+	transactionDetailUC := paypayUsecase.NewTransactionDetailUsecase(paypayTransactionDetailRepo, paymentMethodMappingService, logger)
+	transactionSummaryUC := paypayUsecase.NewTransactionSummaryUsecase(paypayTransactionSummaryRepo, logger)
+	topUpDetailUC := paypayUsecase.NewTopUpDetailUsecase(paypayTopUpDetailRepo, logger)
+	invoiceUC := paypayUsecase.NewInvoiceUsecase(paypayInvoiceRepo, paypayPayinDetailRepo, paypayPayinSummaryRepo, logger)
+	reimportUC := paypayUsecase.NewPayinReimportUsecase(
+		paypayPayinSummaryRepo,
+		paypayPayinDetailRepo,
+		paypayPayinTransactionRepo,
+		paypayTransactionDetailRepo,
+		paypayTransactionSummaryRepo,
+		paypayTopUpDetailRepo,
+		auditLogRepo,
+	)
+
+	// Initialize domain services
+	csvReaderService := csvService.NewCsvReaderServiceWithHeaderMapping(p.headerMapping)
+	multiSectionImportService := paypayService.NewMultiSectionCSVImportService(
+		summaryUC.ProcessAndInsertSummaries,
+		detailUC.ProcessAndInsertDetails,
+	)
+	multiSectionImportService.HeaderMapping = p.headerMapping
+	multiSectionImportService.Validator = p.validator
+	invoiceParseService := paypayService.NewInvoiceTextParseService()
+
+	rowImporters := map[payinObject.PayinFileType]task.RowImportFunc{
+		payinObject.PayinFileTypePaymentTransaction:        transactionUC.ProcessAndInsertTransactions,
+		payinObject.PayinFileTypeTopUpDetail:               topUpDetailUC.ProcessAndInsertTopUpDetails,
+		payinObject.PayinFileTypeTransactionDetail:         transactionDetailUC.ProcessAndInsertTransactionDetails,
+		payinObject.PayinFileTypeShippingTransactionDetail: transactionDetailUC.ProcessAndInsertShippingTransactionDetails,
+		payinObject.PayinFileTypeTransactionSummary:        transactionSummaryUC.ProcessAndInsertTransactionSummaries,
+	}
+
+	// Initialize tasks
+	zipProcessor := task.NewProcessZipFileTask(
+		env.StorageClient,
+		env.PayinFileUC,
+		csvReaderService,
+		p.validator,
+		multiSectionImportService,
+		rowImporters,
+		env.ImportErrorUC,
+		reimportUC,
+		appConfig.S3Bucket,
+		env.ReportFolders,
+		logger,
+		env.JobRun,
+	)
+	invoiceProcessor := task.NewProcessInvoiceFileTask(
+		env.StorageClient,
+		env.PayinFileUC,
+		invoiceParseService,
+		invoiceUC,
+		reimportUC,
+		appConfig.S3Bucket,
+		env.ReportFolders,
+		logger,
+		env.JobRun,
+	)
+
+	return &importer{
+		reportFolders:    env.ReportFolders,
+		zipProcessor:     zipProcessor,
+		invoiceProcessor: invoiceProcessor,
+		invoiceUC:        invoiceUC,
+		logger:           logger,
+	}, nil
+}
+
+// importer imports the PayPay reports, the invoice PDFs apart from the zipped CSV reports
+type importer struct {
+	reportFolders    *provider.ReportFolders
+	zipProcessor     *task.ProcessZipFileTask
+	invoiceProcessor *task.ProcessInvoiceFileTask
+	invoiceUC        *paypayUsecase.InvoiceUsecase
+	logger           logger.Logger
+}
+
+// ImporterFor returns the invoice importer for the keys of the invoice folders, the report importer otherwise
+func (i *importer) ImporterFor(key string) provider.FileImporter {
+	if folder, ok := i.reportFolders.Match(key); ok && folder.FileType.IsInvoice() {
+		return i.invoiceProcessor
+	}
+	return i.zipProcessor
+}
+
+// Finish links the invoices imported before their payin report, or whose payin report was imported again, to it
+func (i *importer) Finish(ctx context.Context) {
+	linked, err := i.invoiceUC.RelinkInvoices(ctx)
+	if err != nil {
+		i.logger.Error("Failed to link invoices to their payin:", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+	if linked > 0 {
+		log.Printf("Linked %d invoices to their payin", linked)
+	}
+}
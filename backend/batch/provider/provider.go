@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	storageAdapter "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	"github.com/huydq/test/batch/infrastructure/container"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	model "github.com/huydq/test/internal/domain/model/payin"
+	object "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/config"
+)
+
+// Provider is a payment provider whose payin reports are fetched and imported by the payin commands. Each provider
+// declares where its reports are on the remote server, how their headers are read and checked, and which tables they
+// are imported into.
+type Provider interface {
+	// Code returns the code of the provider in the payment_provider table, also the value of the --provider flag
+	Code() string
+
+	// Layout returns the report folders fetched from the remote server and the type of the reports in each of them
+	Layout(appConfig *config.Config) (*ReportFolders, error)
+
+	// HeaderMapping returns the mapping of the report headers to the normalized column names
+	HeaderMapping() HeaderMapping
+
+	// Validator returns the validator of the normalized headers of each type of report
+	Validator() HeaderValidator
+
+	// TargetTables returns the tables the reports of each file type are imported into
+	TargetTables() map[object.PayinFileType][]string
+
+	// NewImporter wires the importers of the reports fetched to the storage
+	NewImporter(ctx context.Context, env ImportEnv) (Importer, error)
+}
+
+// HeaderMapping normalizes the headers of the reports of a provider
+type HeaderMapping interface {
+	NormalizeHeader(header string) string
+	MapRows(headers []string, records [][]string, lines []int) ([]string, []object.PayinFileRow)
+}
+
+// HeaderValidator checks the normalized headers of a report against the ones required by its file type
+type HeaderValidator interface {
+	ValidateHeaders(headers []string, fileType object.PayinFileType) (bool, []string)
+}
+
+// ImportEnv is what the import command shares with the importers of a provider
+type ImportEnv struct {
+	BatchService  *container.BatchService
+	StorageClient storageAdapter.StorageService
+	ReportFolders *ReportFolders
+	PayinFileUC   *payinUsecase.PayinFileUsecase
+	ImportErrorUC *payinUsecase.PayinImportErrorUsecase
+	JobRun        *batchJobUsecase.JobRunRecorder
+}
+
+// FileImporter imports the files of a kind of report folder
+type FileImporter interface {
+	Do(ctx context.Context, key string) (bool, error)
+	Reimport(ctx context.Context, key string, payinFile *model.PayinFile, userID *int) (bool, error)
+}
+
+// Importer imports the reports of a provider
+type Importer interface {
+	// ImporterFor returns the importer of the file with the storage key
+	ImporterFor(key string) FileImporter
+
+	// Finish runs once the files of the run are imported
+	Finish(ctx context.Context)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnknownProvider   = errors.New("unknown payin provider")
+	ErrDuplicateProvider = errors.New("payin provider already registered")
+)
+
+// Registry holds the providers the payin commands can be run for, by code
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry creates a new instance of Registry with the providers; it panics when two of them share a code
+func NewRegistry(providers ...Provider) *Registry {
+	registry := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		if err := registry.Register(provider); err != nil {
+			panic(err)
+		}
+	}
+	return registry
+}
+
+// Register adds the provider to the registry
+func (r *Registry) Register(provider Provider) error {
+	code := normalizeCode(provider.Code())
+	if _, ok := r.providers[code]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateProvider, provider.Code())
+	}
+	r.providers[code] = provider
+	return nil
+}
+
+// Lookup returns the provider of the code, which is matched regardless of case
+func (r *Registry) Lookup(code string) (Provider, error) {
+	provider, ok := r.providers[normalizeCode(code)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (available: %s)", ErrUnknownProvider, code, strings.Join(r.Codes(), ", "))
+	}
+	return provider, nil
+}
+
+// Codes returns the codes of the registered providers in order
+func (r *Registry) Codes() []string {
+	codes := make([]string, 0, len(r.providers))
+	for _, provider := range r.providers {
+		codes = append(codes, strings.ToLower(provider.Code()))
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// CommandName returns the name the command runs under for the provider, such as paypay_import_payin_file, which is
+// also the name of its lease and job run history
+func CommandName(provider Provider, command string) string {
+	return strings.ToLower(provider.Code()) + "_" + command
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
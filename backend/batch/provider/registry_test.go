@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubProvider is a provider that only has a code
+type stubProvider struct {
+	Provider
+	code string
+}
+
+func (p *stubProvider) Code() string {
+	return p.code
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	paypay := &stubProvider{code: "PAYPAY"}
+	registry := NewRegistry(paypay, &stubProvider{code: "aupay"})
+
+	for _, code := range []string{"PAYPAY", "paypay", "PayPay", " paypay "} {
+		found, err := registry.Lookup(code)
+		require.NoError(t, err, code)
+		assert.Same(t, paypay, found, code)
+	}
+
+	_, err := registry.Lookup("rakutenpay")
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+	assert.ErrorContains(t, err, "aupay, paypay", "the error lists the available providers")
+
+	assert.Equal(t, []string{"aupay", "paypay"}, registry.Codes())
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := NewRegistry()
+
+	require.NoError(t, registry.Register(&stubProvider{code: "PAYPAY"}))
+	err := registry.Register(&stubProvider{code: "paypay"})
+	assert.ErrorIs(t, err, ErrDuplicateProvider, "codes differing only in case are the same provider")
+
+	_, err = registry.Lookup("paypay")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"paypay"}, registry.Codes())
+}
+
+func TestNewRegistry_PanicsOnDuplicateCode(t *testing.T) {
+	assert.Panics(t, func() {
+		NewRegistry(&stubProvider{code: "PAYPAY"}, &stubProvider{code: "PayPay"})
+	})
+}
+
+func TestCommandName(t *testing.T) {
+	assert.Equal(t, "paypay_import_payin_file", CommandName(&stubProvider{code: "PAYPAY"}, "import_payin_file"))
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"strings"
+
+	remoteAdapter "github.com/huydq/test/batch/infrastructure/adapter/remote"
+	object "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// ReportFolder is a report folder of a provider and how the files in it are imported
+type ReportFolder struct {
+	Path      string                   // Folder of the reports, relative to the remote directory
+	Dated     bool                     // The folder is fetched under a folder named after the target date
+	FileType  object.PayinFileType     // Type of the reports in the folder
+	Encoding  object.PayinFileEncoding // Encoding of the reports, detected when empty
+	FetchOnly bool                     // The files of the folder are fetched to the storage but not imported
+}
+
+// Extension returns the extension of the files imported from the folder: the invoices are PDFs, the reports zipped CSVs
+func (f ReportFolder) Extension() string {
+	if f.FileType.IsInvoice() {
+		return ".pdf"
+	}
+	return ".zip"
+}
+
+// ReportFolders is the remote layout of a provider. It lists the folders fetched from the remote directory and
+// matches storage keys to the report folder they were fetched from.
+type ReportFolders struct {
+	RemoteDir string
+	Folders   []ReportFolder
+}
+
+// NewReportFolders creates a new instance of ReportFolders; folders that are not configured are left out
+func NewReportFolders(remoteDir string, folders ...ReportFolder) *ReportFolders {
+	configured := make([]ReportFolder, 0, len(folders))
+	for _, folder := range folders {
+		if strings.Trim(folder.Path, "/") != "" {
+			configured = append(configured, folder)
+		}
+	}
+	return &ReportFolders{RemoteDir: remoteDir, Folders: configured}
+}
+
+// RemoteFolders returns the folders to fetch from the remote server
+func (f *ReportFolders) RemoteFolders() []remoteAdapter.RemoteFolder {
+	folders := make([]remoteAdapter.RemoteFolder, 0, len(f.Folders))
+	for _, folder := range f.Folders {
+		folders = append(folders, remoteAdapter.RemoteFolder{Path: folder.Path, Dated: folder.Dated})
+	}
+	return folders
+}
+
+// Match returns the imported report folder of the key. Keys are matched both with and without the remote directory
+// prefix, and the keys of a dated folder start with a yyyymmdd folder.
+func (f *ReportFolders) Match(key string) (ReportFolder, bool) {
+	key = strings.TrimLeft(key, "/")
+	if remoteDir := strings.Trim(f.RemoteDir, "/"); remoteDir != "" {
+		key = strings.TrimPrefix(key, remoteDir+"/")
+	}
+
+	for _, folder := range f.Folders {
+		if folder.FetchOnly {
+			continue
+		}
+		rest := key
+		if folder.Dated {
+			date, after, ok := strings.Cut(rest, "/")
+			if !ok || !isTargetDate(date) {
+				continue
+			}
+			rest = after
+		}
+		if strings.HasPrefix(rest, strings.Trim(folder.Path, "/")+"/") {
+			return folder, true
+		}
+	}
+	return ReportFolder{}, false
+}
+
+// isTargetDate reports whether the folder name is a yyyymmdd target date
+func isTargetDate(name string) bool {
+	if len(name) != len("20060102") {
+		return false
+	}
+	for _, c := range name {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,9 @@ package batch
 import (
 	"os"
 
+	aozoraCommand "github.com/huydq/test/batch/command/aozora"
+	payinCommand "github.com/huydq/test/batch/command/payin"
+	payoutCommand "github.com/huydq/test/batch/command/payout"
 	command "github.com/huydq/test/batch/command/paypay"
 	"github.com/spf13/cobra"
 )
@@ -27,6 +30,11 @@ func init() {
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
-	command.InitImportPaypayPayinDataBatch(rootBatch)
-	command.InitUploadPaypayCSVToS3Batch(rootBatch)
+	payinCommand.InitImportPayinFileBatch(rootBatch)
+	payinCommand.InitFetchPayinFileBatch(rootBatch)
+	command.InitBackfillPaypayPaymentMethodBatch(rootBatch)
+	aozoraCommand.InitAozoraSubmitPayoutsBatch(rootBatch)
+	aozoraCommand.InitAozoraPollTransferStatusBatch(rootBatch)
+	payoutCommand.InitAggregatePayinBatch(rootBatch)
+	payoutCommand.InitExportZenginFileBatch(rootBatch)
 }
@@ -0,0 +1,128 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	service "github.com/huydq/test/batch/domain/service/aozora"
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// PollTransferStatusTask fetches the bank status of a requested transfer and applies it to the payout record
+type PollTransferStatusTask struct {
+	BankService           aozora.AozoraTransferService
+	TransferStatusService *service.TransferStatusService
+	PayoutUsecase         *payoutUsecase.PayoutUsecase
+	ReconciliationUsecase *payoutUsecase.PayoutReconciliationUsecase
+	DryRun                bool
+	Logger                logger.Logger
+
+	settledPayoutIDs sync.Map
+}
+
+// NewPollTransferStatusTask creates a new instance of PollTransferStatusTask
+func NewPollTransferStatusTask(
+	bankService aozora.AozoraTransferService,
+	transferStatusService *service.TransferStatusService,
+	payoutUC *payoutUsecase.PayoutUsecase,
+	reconciliationUC *payoutUsecase.PayoutReconciliationUsecase,
+	dryRun bool,
+	logger logger.Logger,
+) *PollTransferStatusTask {
+	return &PollTransferStatusTask{
+		BankService:           bankService,
+		TransferStatusService: transferStatusService,
+		PayoutUsecase:         payoutUC,
+		ReconciliationUsecase: reconciliationUC,
+		DryRun:                dryRun,
+		Logger:                logger,
+	}
+}
+
+/**
+* Do queries the bank for the transfer of the record and saves the reported status.
+* Amounts that differ from the record amount are flagged for reconciliation.
+*
+* @param ctx The context for the operation.
+* @param record The payout record to poll.
+* @return error Error if the status could not be fetched or saved.
+ */
+func (t *PollTransferStatusTask) Do(ctx context.Context, record *model.PayoutRecord) error {
+	response, err := t.BankService.GetTransferStatus(ctx, record.AozoraTransferApplyNo)
+	if err != nil {
+		return fmt.Errorf("failed to get transfer status: %w", err)
+	}
+
+	detail, ok := findTransferDetail(response, record.AozoraTransferApplyNo)
+	if !ok {
+		return fmt.Errorf("transfer %s was not reported by the bank", record.AozoraTransferApplyNo)
+	}
+
+	mismatch, err := t.TransferStatusService.ReconcileAmount(record, detail, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := t.TransferStatusService.ApplyTransferDetail(record, detail); err != nil {
+		return err
+	}
+
+	if t.DryRun {
+		t.Logger.Info("[dry-run] Transfer status would be updated", map[string]any{
+			"payoutID":       record.PayoutID,
+			"payoutRecordID": record.ID,
+			"applyNo":        record.AozoraTransferApplyNo,
+			"transferStatus": record.TransferStatus.String(),
+			"amountMismatch": mismatch != nil,
+		})
+		return nil
+	}
+
+	if mismatch != nil {
+		t.Logger.Warn("Transfer amount does not match the bank", map[string]any{
+			"payoutID":       record.PayoutID,
+			"payoutRecordID": record.ID,
+			"applyNo":        record.AozoraTransferApplyNo,
+			"localAmount":    mismatch.LocalAmount,
+			"bankAmount":     mismatch.BankAmount,
+		})
+		if err := t.ReconciliationUsecase.FlagMismatch(ctx, mismatch); err != nil {
+			return fmt.Errorf("failed to flag amount mismatch: %w", err)
+		}
+	}
+
+	if err := t.PayoutUsecase.SaveTransferResult(ctx, record); err != nil {
+		return fmt.Errorf("failed to save transfer status: %w", err)
+	}
+
+	if record.TransferStatus.IsProcessed() || record.TransferStatus == object.TransferStatusFailed {
+		t.settledPayoutIDs.Store(record.PayoutID, struct{}{})
+	}
+	return nil
+}
+
+// SettledPayoutIDs returns the payouts that had at least one transfer executed or failed, in ascending order
+func (t *PollTransferStatusTask) SettledPayoutIDs() []int {
+	var ids []int
+	t.settledPayoutIDs.Range(func(key, _ any) bool {
+		ids = append(ids, key.(int))
+		return true
+	})
+	sort.Ints(ids)
+	return ids
+}
+
+func findTransferDetail(response *aozora.TransferStatusResponse, applyNo string) (aozora.TransferDetail, bool) {
+	for _, detail := range response.TransferDetails {
+		if detail.ApplyNo == applyNo {
+			return detail, true
+		}
+	}
+	return aozora.TransferDetail{}, false
+}
@@ -0,0 +1,60 @@
+package task
+
+import (
+	"context"
+
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// RollUpPayoutTask marks payouts as processed once all of their transfers have been executed, or as having failed
+// transfers once some of them failed or were returned by the bank
+type RollUpPayoutTask struct {
+	PayoutUsecase *payoutUsecase.PayoutUsecase
+	Logger        logger.Logger
+}
+
+// NewRollUpPayoutTask creates a new instance of RollUpPayoutTask
+func NewRollUpPayoutTask(payoutUC *payoutUsecase.PayoutUsecase, logger logger.Logger) *RollUpPayoutTask {
+	return &RollUpPayoutTask{
+		PayoutUsecase: payoutUC,
+		Logger:        logger,
+	}
+}
+
+// Do rolls up the given payouts and returns the number of payouts marked as processed and as having failed transfers.
+// Failed transfers are logged as errors so that finance is alerted.
+func (t *RollUpPayoutTask) Do(ctx context.Context, payoutIDs []int) (processed int, failed int) {
+	for _, payoutID := range payoutIDs {
+		result, err := t.PayoutUsecase.RollUpPayout(ctx, payoutID)
+		if err != nil {
+			t.Logger.Error("Failed to roll up payout", map[string]any{
+				"payoutID": payoutID,
+				"error":    err.Error(),
+			})
+			continue
+		}
+		if result == nil {
+			continue
+		}
+
+		if result.Payout.IsTransferFailed() {
+			failedRecordIDs := make([]int, 0, len(result.FailedRecords))
+			for _, record := range result.FailedRecords {
+				failedRecordIDs = append(failedRecordIDs, record.ID)
+			}
+			t.Logger.Error("Payout has failed transfers", map[string]any{
+				"payoutID":        payoutID,
+				"failedRecordIDs": failedRecordIDs,
+			})
+			failed++
+			continue
+		}
+
+		t.Logger.Info("Payout marked as processed", map[string]any{
+			"payoutID": payoutID,
+		})
+		processed++
+	}
+	return processed, failed
+}
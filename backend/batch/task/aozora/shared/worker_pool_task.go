@@ -0,0 +1,106 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// ProcessPayoutRecordFunc defines the function signature for processing payout records
+// It takes a context and PayoutRecord, processes the record, and returns error if any
+type ProcessPayoutRecordFunc func(ctx context.Context, record *model.PayoutRecord) error
+
+// WorkerPoolTask handles concurrent processing of payout records, for both submitting the transfers and polling their status
+type WorkerPoolTask struct {
+	MaxWorkers     int           // Maximum number of concurrent workers
+	Logger         logger.Logger // Logger for recording events
+	ProcessedCount *int32        // Count of successfully processed records (atomic)
+}
+
+// NewWorkerPoolTask creates a new instance of WorkerPoolTask
+func NewWorkerPoolTask(maxWorkers int, logger logger.Logger) *WorkerPoolTask {
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+
+	var count int32 = 0
+	return &WorkerPoolTask{
+		MaxWorkers:     maxWorkers,
+		Logger:         logger,
+		ProcessedCount: &count,
+	}
+}
+
+/**
+* ProcessPayoutRecords processes payout records concurrently using a worker pool.
+* It processes each record using the provided process function, and stops starting records once ctx is cancelled,
+* e.g. when the lease of the command is lost; the records in progress are left to finish.
+*
+* @param ctx The context for the operation.
+* @param records Payout records to process.
+* @param processFunc Function to process each record.
+* @return int The total number of successfully processed records.
+ */
+func (t *WorkerPoolTask) ProcessPayoutRecords(
+	ctx context.Context,
+	records []*model.PayoutRecord,
+	processFunc ProcessPayoutRecordFunc,
+) int {
+	// Setup worker pool
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, t.MaxWorkers)
+
+	// Process each record
+	for _, record := range records {
+		if !t.acquireSlot(ctx, sem) {
+			t.Logger.Warn("Stopped processing payout records, the run was cancelled", map[string]any{
+				"payoutRecordID": record.ID,
+				"reason":         context.Cause(ctx).Error(),
+			})
+			break
+		}
+		wg.Add(1)
+
+		// Process record in a goroutine
+		go func(r *model.PayoutRecord) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := processFunc(ctx, r)
+			if err != nil {
+				t.Logger.Error("Error processing payout record", map[string]any{
+					"payoutID":       r.PayoutID,
+					"payoutRecordID": r.ID,
+					"error":          err.Error(),
+				})
+				return
+			}
+
+			// Increment processed count atomically
+			atomic.AddInt32(t.ProcessedCount, 1)
+		}(record)
+	}
+
+	// Wait for all goroutines to finish
+	wg.Wait()
+
+	return int(atomic.LoadInt32(t.ProcessedCount))
+}
+
+// acquireSlot waits for a free worker, returning false when ctx is cancelled first
+func (t *WorkerPoolTask) acquireSlot(ctx context.Context, sem chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,57 @@
+package task
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	"github.com/huydq/test/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPayoutRecords(n int) []*model.PayoutRecord {
+	records := make([]*model.PayoutRecord, n)
+	for i := range records {
+		records[i] = &model.PayoutRecord{ID: i + 1, PayoutID: 1}
+	}
+	return records
+}
+
+func newTestWorkerPoolTask(t *testing.T, maxWorkers int) *WorkerPoolTask {
+	t.Helper()
+	return NewWorkerPoolTask(maxWorkers, logger.InitCLILogger(&logger.CLILoggerConfig{
+		LogLevel:     "fatal",
+		LogDirectory: t.TempDir(),
+	}))
+}
+
+func TestWorkerPoolTask_ProcessPayoutRecords(t *testing.T) {
+	pool := newTestWorkerPoolTask(t, 3)
+
+	processed := pool.ProcessPayoutRecords(context.Background(), newTestPayoutRecords(10),
+		func(_ context.Context, record *model.PayoutRecord) error {
+			return nil
+		})
+
+	assert.Equal(t, 10, processed)
+}
+
+func TestWorkerPoolTask_ProcessPayoutRecordsStopsWhenCancelled(t *testing.T) {
+	pool := newTestWorkerPoolTask(t, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var started int32
+	processed := pool.ProcessPayoutRecords(ctx, newTestPayoutRecords(10),
+		func(_ context.Context, record *model.PayoutRecord) error {
+			// The lease is lost while the third record is processed
+			if atomic.AddInt32(&started, 1) == 3 {
+				cancel()
+			}
+			return nil
+		})
+
+	assert.Equal(t, 3, processed)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&started))
+}
@@ -0,0 +1,104 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	service "github.com/huydq/test/batch/domain/service/aozora"
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	model "github.com/huydq/test/internal/domain/model/payout_record"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// SubmitPayoutRecordTask submits a single payout record to the bank
+type SubmitPayoutRecordTask struct {
+	BankService           aozora.AozoraTransferService
+	TransferStatusService *service.TransferStatusService
+	PayoutUsecase         *payoutUsecase.PayoutUsecase
+	DryRun                bool
+	Logger                logger.Logger
+}
+
+// NewSubmitPayoutRecordTask creates a new instance of SubmitPayoutRecordTask
+func NewSubmitPayoutRecordTask(
+	bankService aozora.AozoraTransferService,
+	transferStatusService *service.TransferStatusService,
+	payoutUC *payoutUsecase.PayoutUsecase,
+	dryRun bool,
+	logger logger.Logger,
+) *SubmitPayoutRecordTask {
+	return &SubmitPayoutRecordTask{
+		BankService:           bankService,
+		TransferStatusService: transferStatusService,
+		PayoutUsecase:         payoutUC,
+		DryRun:                dryRun,
+		Logger:                logger,
+	}
+}
+
+/**
+* Do registers the beneficiary of the record on the bank whitelist and requests the transfer.
+* The record's idempotency key is sent with the request, so a record that has been sent
+* before but whose result was not saved is never transferred twice.
+* Records already accepted by the bank are skipped.
+*
+* @param ctx The context for the operation.
+* @param record The payout record to submit.
+* @return error Error if the transfer could not be requested.
+ */
+func (t *SubmitPayoutRecordTask) Do(ctx context.Context, record *model.PayoutRecord) error {
+	if record.IsTransferSubmitted() {
+		return nil
+	}
+	if record.IdempotencyKey == "" {
+		return fmt.Errorf("payout record %d has no idempotency key", record.ID)
+	}
+
+	designatedDate := time.Now()
+	if record.SendingDate != nil {
+		designatedDate = *record.SendingDate
+	}
+	request, err := t.TransferStatusService.ToTransferRequest(record, designatedDate)
+	if err != nil {
+		return err
+	}
+
+	if t.DryRun {
+		t.Logger.Info("[dry-run] Transfer would be requested", map[string]any{
+			"payoutID":       record.PayoutID,
+			"payoutRecordID": record.ID,
+			"bankCode":       record.BankCode,
+			"branchCode":     record.BranchCode,
+			"accountNo":      record.AccountNo,
+			"amount":         request.TotalAmount,
+			"idempotencyKey": record.IdempotencyKey,
+		})
+		return nil
+	}
+
+	if _, err := t.BankService.RegisterWhitelist(ctx, t.TransferStatusService.ToBeneficiary(record)); err != nil {
+		t.TransferStatusService.ApplyWhitelistError(record, err)
+		if saveErr := t.PayoutUsecase.SaveTransferResult(ctx, record); saveErr != nil {
+			return fmt.Errorf("failed to save whitelist error: %w", saveErr)
+		}
+		return fmt.Errorf("failed to register whitelist: %w", err)
+	}
+
+	response, err := t.BankService.RequestTransfer(ctx, record.IdempotencyKey, request)
+	t.TransferStatusService.ApplyTransferResult(record, response, err, time.Now())
+	if saveErr := t.PayoutUsecase.SaveTransferResult(ctx, record); saveErr != nil {
+		return fmt.Errorf("failed to save transfer result: %w", saveErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to request transfer: %w", err)
+	}
+
+	t.Logger.Info("Transfer requested", map[string]any{
+		"payoutID":       record.PayoutID,
+		"payoutRecordID": record.ID,
+		"applyNo":        record.AozoraTransferApplyNo,
+	})
+	return nil
+}
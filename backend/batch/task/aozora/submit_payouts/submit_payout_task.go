@@ -0,0 +1,72 @@
+package task
+
+import (
+	"context"
+
+	sharedTask "github.com/huydq/test/batch/task/aozora/shared"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	model "github.com/huydq/test/internal/domain/model/payout"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// SubmitPayoutTask submits every record of a payout to the bank
+type SubmitPayoutTask struct {
+	PayoutUsecase    *payoutUsecase.PayoutUsecase
+	WorkerPoolTask   *sharedTask.WorkerPoolTask
+	RecordSubmitTask *SubmitPayoutRecordTask
+	DryRun           bool
+	Logger           logger.Logger
+}
+
+// NewSubmitPayoutTask creates a new instance of SubmitPayoutTask
+func NewSubmitPayoutTask(
+	payoutUC *payoutUsecase.PayoutUsecase,
+	workerPoolTask *sharedTask.WorkerPoolTask,
+	recordSubmitTask *SubmitPayoutRecordTask,
+	dryRun bool,
+	logger logger.Logger,
+) *SubmitPayoutTask {
+	return &SubmitPayoutTask{
+		PayoutUsecase:    payoutUC,
+		WorkerPoolTask:   workerPoolTask,
+		RecordSubmitTask: recordSubmitTask,
+		DryRun:           dryRun,
+		Logger:           logger,
+	}
+}
+
+/**
+* Do submits the records of the payout concurrently. The payout stays approved while its
+* transfers are in progress: the records that were not accepted by the bank are retried on
+* the next run, and the payout is marked as processed by the transfer status poller once
+* every transfer has been executed.
+*
+* @param ctx The context for the operation.
+* @param payout The payout to submit.
+* @return error Error if the payout records could not be loaded.
+ */
+func (t *SubmitPayoutTask) Do(ctx context.Context, payout *model.Payout) error {
+	records, err := t.PayoutUsecase.FindRecords(ctx, payout.ID)
+	if err != nil {
+		return err
+	}
+
+	t.WorkerPoolTask.ProcessPayoutRecords(ctx, records, t.RecordSubmitTask.Do)
+
+	if t.DryRun {
+		return nil
+	}
+
+	if unsubmitted := t.PayoutUsecase.CountUnsubmitted(records); unsubmitted > 0 {
+		t.Logger.Warn("Payout has records that were not submitted", map[string]any{
+			"payoutID":    payout.ID,
+			"unsubmitted": unsubmitted,
+		})
+		return nil
+	}
+
+	t.Logger.Info("Payout submitted, waiting for the transfers to be executed", map[string]any{
+		"payoutID": payout.ID,
+	})
+	return nil
+}
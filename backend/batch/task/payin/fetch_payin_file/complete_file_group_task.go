@@ -0,0 +1,41 @@
+package task
+
+import (
+	"context"
+	"log"
+
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+)
+
+// CompleteFileGroupTask marks a group as imported once every one of its files has been fetched
+type CompleteFileGroupTask struct {
+	FileUC      *payinUsecase.PayinFileUsecase
+	FileGroupUC *payinUsecase.PayinFileGroupUsecase
+}
+
+func NewCompleteFileGroupTask(
+	fileUC *payinUsecase.PayinFileUsecase,
+	fileGroupUC *payinUsecase.PayinFileGroupUsecase,
+) *CompleteFileGroupTask {
+	return &CompleteFileGroupTask{
+		FileUC:      fileUC,
+		FileGroupUC: fileGroupUC,
+	}
+}
+
+// Do reports whether the group is complete
+func (t *CompleteFileGroupTask) Do(ctx context.Context, group *payinModel.PayinFileGroup) (bool, error) {
+	if !group.IsListed() {
+		return false, nil
+	}
+	fetched, err := t.FileUC.AllFilesFetched(ctx, group.ID)
+	if err != nil || !fetched {
+		return false, err
+	}
+	if err := t.FileGroupUC.MarkImported(ctx, group); err != nil {
+		log.Printf("[CompleteFileGroupTask] MarkImported error: %v", err)
+		return false, err
+	}
+	return true, nil
+}
@@ -26,16 +26,17 @@ func NewCreateFileGroupTask(
 	}
 }
 
-func (t *CreateFileGroupTask) Do(ctx context.Context) (int, error) {
+// Do creates the file group of the files fetched for targetDate
+func (t *CreateFileGroupTask) Do(ctx context.Context, targetDate time.Time) (*payinModel.PayinFileGroup, error) {
 	group := &payinModel.PayinFileGroup{
 		FileGroupName:     time.Now().Format("20060102_150405"),
 		PaymentProviderID: t.ProviderID,
-		ImportTargetDate:  time.Now(),
+		ImportTargetDate:  targetDate,
 	}
 
 	tx, err := database.GetTxOrDB(ctx)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	err = tx.Transaction(func(txCtx *gorm.DB) error {
@@ -48,8 +49,8 @@ func (t *CreateFileGroupTask) Do(ctx context.Context) (int, error) {
 	})
 	if err != nil {
 		log.Printf("[CreateFileGroupTask] Transaction error: %v", err)
-		return 0, err
+		return nil, err
 	}
 
-	return group.ID, nil
+	return group, nil
 }
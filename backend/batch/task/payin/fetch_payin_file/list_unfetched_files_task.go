@@ -0,0 +1,51 @@
+package task
+
+import (
+	"context"
+	"path"
+
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+)
+
+// ListUnfetchedFilesTask streams the registered files of a group that are still pending or have failed
+type ListUnfetchedFilesTask struct {
+	FileUC    *payinUsecase.PayinFileUsecase
+	RemoteDir string
+}
+
+func NewListUnfetchedFilesTask(
+	fileUC *payinUsecase.PayinFileUsecase,
+	remoteDir string,
+) *ListUnfetchedFilesTask {
+	return &ListUnfetchedFilesTask{
+		FileUC:    fileUC,
+		RemoteDir: remoteDir,
+	}
+}
+
+func (t *ListUnfetchedFilesTask) Do(ctx context.Context, groupID int) (<-chan RemoteFileInfo, int, error) {
+	files, err := t.FileUC.ListUnfetchedFiles(ctx, groupID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fileInfoCh := make(chan RemoteFileInfo)
+	go func() {
+		defer close(fileInfoCh)
+
+		for _, file := range files {
+			fileInfo := RemoteFileInfo{
+				RemotePath: file.FileContentKey,
+				LocalPath:  t.RemoteDir,
+				Folder:     path.Dir(file.FileContentKey),
+				File:       file,
+			}
+			select {
+			case fileInfoCh <- fileInfo:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return fileInfoCh, len(files), nil
+}
@@ -0,0 +1,42 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+)
+
+var ErrFileGroupNotFound = errors.New("file group not found")
+
+// LoadFileGroupTask loads the file group of a run that is resumed
+type LoadFileGroupTask struct {
+	FileGroupUC *payinUsecase.PayinFileGroupUsecase
+	ProviderID  int
+}
+
+func NewLoadFileGroupTask(
+	fileGroupUC *payinUsecase.PayinFileGroupUsecase,
+	providerID int,
+) *LoadFileGroupTask {
+	return &LoadFileGroupTask{
+		FileGroupUC: fileGroupUC,
+		ProviderID:  providerID,
+	}
+}
+
+// Do returns the file group, or ErrFileGroupNotFound when it does not exist or belongs to another provider
+func (t *LoadFileGroupTask) Do(ctx context.Context, groupID int) (*payinModel.PayinFileGroup, error) {
+	group, err := t.FileGroupUC.FindGroup(ctx, groupID)
+	if err != nil {
+		log.Printf("[LoadFileGroupTask] Failed to find group %d: %v", groupID, err)
+		return nil, err
+	}
+	if group == nil || group.PaymentProviderID != t.ProviderID {
+		return nil, fmt.Errorf("%w: %d", ErrFileGroupNotFound, groupID)
+	}
+	return group, nil
+}
@@ -0,0 +1,92 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	remoteAdapter "github.com/huydq/test/batch/infrastructure/adapter/remote"
+	storageAdapter "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// ProcessFileTask represents a task for processing a file, including downloading, uploading to S3, and updating statuses.
+type ProcessFileTask struct {
+	FileUC     *payinUsecase.PayinFileUsecase  // Use case for handling file-related operations
+	S3Uploader storageAdapter.StorageService   // S3 uploader configuration
+	SSHClient  remoteAdapter.SSHService        // SSH client for remote file access
+	File       *payinModel.PayinFile           // Registered record of the file
+	RemotePath string                          // Path to the remote file
+	LocalPath  string                          // Local path for processing
+	JobRun     *batchJobUsecase.JobRunRecorder // Batch job run the file is recorded under
+}
+
+// NewProcessFileTask initializes a new ProcessFileTask instance.
+func NewProcessFileTask(
+	fileUC *payinUsecase.PayinFileUsecase,
+	s3 storageAdapter.StorageService,
+	ssh remoteAdapter.SSHService,
+	file *payinModel.PayinFile,
+	localPath string,
+	jobRun *batchJobUsecase.JobRunRecorder,
+) *ProcessFileTask {
+	return &ProcessFileTask{
+		FileUC:     fileUC,
+		S3Uploader: s3,
+		SSHClient:  ssh,
+		File:       file,
+		RemotePath: file.FileContentKey,
+		LocalPath:  localPath,
+		JobRun:     jobRun,
+	}
+}
+
+// Do executes the file processing task, including downloading, uploading, and updating statuses. A pending or failed
+// file is fetched again, so the task can be retried.
+func (t *ProcessFileTask) Do(ctx context.Context) error {
+	fileName := filepath.Base(t.RemotePath) // Extract the file name from the remote path
+	file := t.File
+
+	log.Printf("[ProcessFileTask] Fetching file record: %s (ID: %d)", fileName, file.ID)
+	t.JobRun.TrackPayinFile(ctx, file.ID)
+
+	// Read the file content from the remote server.
+	log.Printf("[ProcessFileTask] Streaming and uploading: %s", t.RemotePath)
+	remoteFile, err := t.SSHClient.Stat(ctx, t.RemotePath)
+	if err != nil {
+		log.Printf("[ERROR] Stat failed for %s: %v", t.RemotePath, err)
+		_ = t.FileUC.UpdateDownloadStatus(ctx, file, payinObject.StatusFailed)
+		return err
+	}
+	reader, err := t.SSHClient.Open(ctx, t.RemotePath)
+	if err != nil {
+		log.Printf("[ERROR] Open failed for %s: %v", t.RemotePath, err)
+		_ = t.FileUC.UpdateDownloadStatus(ctx, file, payinObject.StatusFailed)
+		return err
+	}
+	defer reader.Close()
+
+	// Upload the file content to S3 while it is read from the remote server,
+	// computing its checksums on the way.
+	key := t.S3Uploader.GetS3KeyFromRemotePath(t.RemotePath, t.LocalPath)
+	result, err := t.S3Uploader.UploadStreamWithChecksum(ctx, key, reader)
+	if err == nil && result.Size != remoteFile.Size {
+		err = fmt.Errorf("uploaded %d bytes but the remote file has %d bytes", result.Size, remoteFile.Size)
+	}
+	if err != nil {
+		log.Printf("[ERROR] UploadStream failed for %s: %v", t.RemotePath, err)
+		_ = t.FileUC.UpdateDownloadStatus(ctx, file, payinObject.StatusFailed)
+		_ = t.FileUC.UpdateUploadStatus(ctx, file, payinObject.StatusFailed)
+		return err
+	}
+	if err := t.FileUC.RecordUpload(ctx, file, result.Size, result.MD5, result.SHA256); err != nil {
+		log.Printf("[ERROR] RecordUpload failed for %s: %v", t.RemotePath, err)
+		return err
+	}
+	log.Printf("[ProcessFileTask] Successfully processed file: %s", t.RemotePath)
+	return nil
+}
@@ -0,0 +1,120 @@
+package task
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	remoteAdapter "github.com/huydq/test/batch/infrastructure/adapter/remote"
+	storageAdapter "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePayinFileRepository keeps the files created and the statuses and upload results saved for the file
+type fakePayinFileRepository struct {
+	created       int
+	statusUpdates int
+	uploadResults int
+}
+
+func (r *fakePayinFileRepository) Create(_ context.Context, file *payinModel.PayinFile) (*payinModel.PayinFile, error) {
+	r.created++
+	return file, nil
+}
+
+func (r *fakePayinFileRepository) UpdateStatus(context.Context, *payinModel.PayinFile) error {
+	r.statusUpdates++
+	return nil
+}
+
+func (r *fakePayinFileRepository) UpdateUploadResult(context.Context, *payinModel.PayinFile) error {
+	r.uploadResults++
+	return nil
+}
+
+func (r *fakePayinFileRepository) UpdateEncoding(context.Context, *payinModel.PayinFile) error {
+	return nil
+}
+
+func (r *fakePayinFileRepository) FindByFilename(context.Context, string) (*payinModel.PayinFile, error) {
+	return nil, nil
+}
+
+func (r *fakePayinFileRepository) GetByID(context.Context, int) (*payinModel.PayinFile, error) {
+	return nil, nil
+}
+
+func (r *fakePayinFileRepository) ListUnfetchedByGroupID(context.Context, int) ([]*payinModel.PayinFile, error) {
+	return nil, nil
+}
+
+func (r *fakePayinFileRepository) CountUnfetchedByGroupID(context.Context, int) (int64, error) {
+	return 0, nil
+}
+
+// fakeSSHService serves a single remote file whose reported size may differ from its content
+type fakeSSHService struct {
+	remoteAdapter.SSHService
+	content      string
+	reportedSize int64
+}
+
+func (s *fakeSSHService) Stat(_ context.Context, remotePath string) (*remoteAdapter.RemoteFile, error) {
+	return &remoteAdapter.RemoteFile{Path: remotePath, Size: s.reportedSize}, nil
+}
+
+func (s *fakeSSHService) Open(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(s.content)), nil
+}
+
+func newTestProcessFileTask(t *testing.T, ssh remoteAdapter.SSHService) (*ProcessFileTask, *fakePayinFileRepository) {
+	t.Helper()
+
+	storage, err := storageAdapter.NewStorageService(storageAdapter.StorageConfig{
+		Backend: storageAdapter.BackendLocal,
+		Local:   storageAdapter.LocalConfig{RootDir: t.TempDir(), Bucket: "payin-files"},
+	})
+	require.NoError(t, err)
+
+	repo := &fakePayinFileRepository{}
+	file := &payinModel.PayinFile{ID: 1, FileContentKey: "/remote/20250610/report/a.zip"}
+	return NewProcessFileTask(payinUsecase.NewPayinFileUsecase(repo), storage, ssh, file, "/remote", nil), repo
+}
+
+func TestProcessFileTask_Do(t *testing.T) {
+	content := "PK\x03\x04payin report"
+	task, repo := newTestProcessFileTask(t, &fakeSSHService{content: content, reportedSize: int64(len(content))})
+
+	require.NoError(t, task.Do(context.Background()))
+
+	assert.Equal(t, payinObject.StatusSuccess, task.File.DownloadStatus)
+	assert.Equal(t, payinObject.StatusSuccess, task.File.UploadStatus)
+	require.NotNil(t, task.File.FileSize)
+	assert.Equal(t, int64(len(content)), *task.File.FileSize)
+	require.NotNil(t, task.File.MD5Checksum)
+	assert.Len(t, *task.File.MD5Checksum, 32)
+	require.NotNil(t, task.File.SHA256Checksum)
+	assert.Len(t, *task.File.SHA256Checksum, 64)
+	assert.Equal(t, 1, repo.uploadResults)
+}
+
+func TestProcessFileTask_DoSizeMismatch(t *testing.T) {
+	content := "PK\x03\x04trunc"
+	task, repo := newTestProcessFileTask(t, &fakeSSHService{content: content, reportedSize: int64(len(content)) + 100})
+
+	err := task.Do(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "uploaded 9 bytes but the remote file has 109 bytes")
+	assert.Equal(t, payinObject.StatusFailed, task.File.DownloadStatus)
+	assert.Equal(t, payinObject.StatusFailed, task.File.UploadStatus)
+	assert.Nil(t, task.File.FileSize)
+	assert.Nil(t, task.File.MD5Checksum)
+	assert.Equal(t, 2, repo.statusUpdates)
+	assert.Equal(t, 0, repo.uploadResults)
+}
@@ -0,0 +1,87 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// RegisterRemoteFilesTask registers the listed remote files as pending files of a group, so that the files left
+// over by an interrupted run can be retried without listing the remote server again.
+type RegisterRemoteFilesTask struct {
+	FileUC      *payinUsecase.PayinFileUsecase
+	FileGroupUC *payinUsecase.PayinFileGroupUsecase
+}
+
+func NewRegisterRemoteFilesTask(
+	fileUC *payinUsecase.PayinFileUsecase,
+	fileGroupUC *payinUsecase.PayinFileGroupUsecase,
+) *RegisterRemoteFilesTask {
+	return &RegisterRemoteFilesTask{
+		FileUC:      fileUC,
+		FileGroupUC: fileGroupUC,
+	}
+}
+
+// Do registers every file of the stream that is not registered yet and checkpoints the group once the stream is
+// exhausted. When a folder could not be listed the group is not checkpointed, so that resuming it lists the remote
+// server again, and the listing errors are returned. It returns the number of newly registered files.
+func (t *RegisterRemoteFilesTask) Do(ctx context.Context, group *payinModel.PayinFileGroup, files <-chan RemoteFileInfo) (int, error) {
+	registered := 0
+	var listErrs []error
+	for fileInfo := range files {
+		if fileInfo.Err != nil {
+			log.Printf("[RegisterRemoteFilesTask] Folder %s not listed: %v", fileInfo.Folder, fileInfo.Err)
+			listErrs = append(listErrs, fileInfo.Err)
+			continue
+		}
+
+		fileName := filepath.Base(fileInfo.RemotePath)
+
+		// A file belongs to the first group it was listed in, a failed one is retried by resuming that group
+		existing, err := t.FileUC.FindByFilename(ctx, fileName)
+		if err != nil {
+			log.Printf("[RegisterRemoteFilesTask] FindByFilename error: %v", err)
+			return registered, err
+		}
+		if existing != nil {
+			if existing.PayinFileGroupID == nil || *existing.PayinFileGroupID != group.ID {
+				log.Printf("[RegisterRemoteFilesTask] File already registered: %s (ID: %d)", fileName, existing.ID)
+			}
+			continue
+		}
+
+		_, err = t.FileUC.CreateFile(ctx, &payinModel.PayinFile{
+			PaymentProviderID: group.PaymentProviderID,
+			PayinFileGroupID:  &group.ID,
+			FileName:          fileName,
+			FileContentKey:    fileInfo.RemotePath,
+			DownloadStatus:    payinObject.StatusPending,
+			UploadStatus:      payinObject.StatusPending,
+			ImportStatus:      payinObject.StatusPending,
+		})
+		if err != nil {
+			log.Printf("[RegisterRemoteFilesTask] CreateFile error: %v", err)
+			return registered, err
+		}
+		registered++
+	}
+
+	if err := ctx.Err(); err != nil {
+		return registered, err
+	}
+	if len(listErrs) > 0 {
+		return registered, fmt.Errorf("file group %d is not fully listed: %w", group.ID, errors.Join(listErrs...))
+	}
+	if err := t.FileGroupUC.MarkListed(ctx, group); err != nil {
+		log.Printf("[RegisterRemoteFilesTask] MarkListed error: %v", err)
+		return registered, err
+	}
+	return registered, nil
+}
@@ -0,0 +1,77 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePayinFileGroupRepository counts the checkpoints saved for the group
+type fakePayinFileGroupRepository struct {
+	checkpoints int
+}
+
+func (r *fakePayinFileGroupRepository) Create(context.Context, *payinModel.PayinFileGroup) error {
+	return nil
+}
+
+func (r *fakePayinFileGroupRepository) FindByID(context.Context, int) (*payinModel.PayinFileGroup, error) {
+	return nil, nil
+}
+
+func (r *fakePayinFileGroupRepository) UpdateCheckpoint(context.Context, *payinModel.PayinFileGroup) error {
+	r.checkpoints++
+	return nil
+}
+
+func streamRemoteFiles(files ...RemoteFileInfo) <-chan RemoteFileInfo {
+	ch := make(chan RemoteFileInfo, len(files))
+	for _, file := range files {
+		ch <- file
+	}
+	close(ch)
+	return ch
+}
+
+func TestRegisterRemoteFilesTask_Do(t *testing.T) {
+	fileRepo := &fakePayinFileRepository{}
+	groupRepo := &fakePayinFileGroupRepository{}
+	task := NewRegisterRemoteFilesTask(payinUsecase.NewPayinFileUsecase(fileRepo), payinUsecase.NewPayinFileGroupUsecase(groupRepo))
+	group := &payinModel.PayinFileGroup{ID: 1, PaymentProviderID: 2}
+
+	registered, err := task.Do(context.Background(), group, streamRemoteFiles(
+		RemoteFileInfo{RemotePath: "/remote/summary/a.csv", Folder: "/summary/"},
+		RemoteFileInfo{RemotePath: "/remote/topup/b.csv", Folder: "/topup/"},
+	))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, registered)
+	assert.Equal(t, 2, fileRepo.created)
+	assert.Equal(t, 1, groupRepo.checkpoints)
+	assert.True(t, group.IsListed())
+}
+
+func TestRegisterRemoteFilesTask_DoFolderNotListed(t *testing.T) {
+	fileRepo := &fakePayinFileRepository{}
+	groupRepo := &fakePayinFileGroupRepository{}
+	task := NewRegisterRemoteFilesTask(payinUsecase.NewPayinFileUsecase(fileRepo), payinUsecase.NewPayinFileGroupUsecase(groupRepo))
+	group := &payinModel.PayinFileGroup{ID: 1, PaymentProviderID: 2}
+	searchErr := errors.New("permission denied")
+
+	registered, err := task.Do(context.Background(), group, streamRemoteFiles(
+		RemoteFileInfo{Folder: "/settlement/", Err: searchErr},
+		RemoteFileInfo{RemotePath: "/remote/topup/b.csv", Folder: "/topup/"},
+	))
+
+	// The files of the other folders are registered, but the group stays unlisted so that a resume lists it again
+	assert.ErrorIs(t, err, searchErr)
+	assert.Equal(t, 1, registered)
+	assert.Equal(t, 1, fileRepo.created)
+	assert.Zero(t, groupRepo.checkpoints)
+	assert.False(t, group.IsListed())
+}
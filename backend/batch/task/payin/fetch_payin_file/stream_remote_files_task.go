@@ -1,10 +1,12 @@
 package task
 
 import (
+	"context"
 	"log"
 	"strings"
 
 	remoteAdapter "github.com/huydq/test/batch/infrastructure/adapter/remote"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
 )
 
 // RemoteFileInfo contains information about a remote file task
@@ -12,48 +14,57 @@ type RemoteFileInfo struct {
 	RemotePath string
 	LocalPath  string
 	Folder     string
+	File       *payinModel.PayinFile // Registered record of the file, nil while listing
+	Err        error                 // The folder could not be listed, there is no file
 }
 
 // StreamRemoteFilesTask handles streaming files from remote folders
 type StreamRemoteFilesTask struct {
-	SSHClient remoteAdapter.SSHService
+	SSHClient  remoteAdapter.SSHService
 	TargetDate string
+	Folders    []remoteAdapter.RemoteFolder
 }
 
 // NewStreamRemoteFilesTask creates a new instance of StreamRemoteFilesTask
 func NewStreamRemoteFilesTask(
 	sshClient remoteAdapter.SSHService,
 	targetDate string,
+	folders []remoteAdapter.RemoteFolder,
 ) *StreamRemoteFilesTask {
 	return &StreamRemoteFilesTask{
-		SSHClient: sshClient,
+		SSHClient:  sshClient,
 		TargetDate: targetDate,
+		Folders:    folders,
 	}
 }
 
-func (t *StreamRemoteFilesTask) Do(remoteDir string, pageSize int) (<-chan RemoteFileInfo, error) {
+func (t *StreamRemoteFilesTask) Do(ctx context.Context, remoteDir string, pageSize int) (<-chan RemoteFileInfo, error) {
 	// Create channel for remote file info
 	fileInfoCh := make(chan RemoteFileInfo)
-	
+
 	// Get stream of file groups from SSH client
-	stream, err := t.SSHClient.StreamFolderFilesPaginated(remoteDir, pageSize, t.TargetDate)
+	stream, err := t.SSHClient.StreamFolderFilesPaginated(ctx, remoteDir, t.Folders, pageSize, t.TargetDate)
 	if err != nil {
 		close(fileInfoCh)
 		return nil, err
 	}
-	
+
 	// Process file groups in a goroutine
 	go func() {
 		defer close(fileInfoCh)
-		
+
 		// Process each folder
 		for remoteFolder := range stream {
+			if remoteFolder.Err != nil {
+				fileInfoCh <- RemoteFileInfo{Folder: remoteFolder.Folder, Err: remoteFolder.Err}
+				continue
+			}
 			if len(remoteFolder.Files) == 0 {
 				continue
 			}
-			
+
 			log.Printf("===== Starting to process folder: %s (%d files) =====", remoteFolder.Folder, len(remoteFolder.Files))
-			
+
 			// Send each file to the channel
 			for _, file := range remoteFolder.Files {
 				fileInfoCh <- RemoteFileInfo{
@@ -62,11 +73,11 @@ func (t *StreamRemoteFilesTask) Do(remoteDir string, pageSize int) (<-chan Remot
 					Folder:     remoteFolder.Folder,
 				}
 			}
-			
+
 			log.Printf("===== Finished processing folder: %s =====", remoteFolder.Folder)
 		}
 	}()
-	
+
 	return fileInfoCh, nil
 }
 
@@ -49,6 +49,11 @@ func (t *WorkerPoolTask) ProcessRemoteFiles(
 	
 	// Process each file
 	for fileInfo := range files {
+		// Drain the remaining entries without starting new work once the run is cancelled, e.g. its lease was lost
+		if ctx.Err() != nil {
+			continue
+		}
+
 		wg.Add(1)
 		sem <- struct{}{}
 		
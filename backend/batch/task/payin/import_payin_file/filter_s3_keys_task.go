@@ -0,0 +1,45 @@
+package task
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/huydq/test/batch/provider"
+)
+
+type FilterS3KeysTask struct {
+	ReportFolders *provider.ReportFolders
+}
+
+// NewFilterS3KeysTask creates a new instance of FilterS3KeysTask
+func NewFilterS3KeysTask(reportFolders *provider.ReportFolders) *FilterS3KeysTask {
+	return &FilterS3KeysTask{
+		ReportFolders: reportFolders,
+	}
+}
+
+/**
+* Do filters S3 keys based on the report folders and file extension.
+* It checks if the key is in one of the report folders and if it has the extension of the files of that folder.
+* If both conditions are met, it returns true; otherwise, it returns false.
+*
+* @param s3Key The S3 key to filter.
+* @return bool True if the key should be processed, false otherwise.
+*/
+func (t *FilterS3KeysTask) Do(s3Key string) bool {
+	// Check if key is in report folders
+	fmt.Printf("[DEBUG] Checking S3 key: %s\n", s3Key)
+	folderMatch, ok := t.ReportFolders.Match(s3Key)
+	if !ok {
+		return false // Skip files not in report folders
+	}
+	
+	// Check if file has the extension of the folder
+	if !strings.HasSuffix(strings.ToLower(s3Key), folderMatch.Extension()) {
+		return false // Skip other files
+	}
+	
+	log.Printf("[DEBUG] S3 file matched for import: %s (in folder: %s)", s3Key, folderMatch.Path)
+	return true
+}
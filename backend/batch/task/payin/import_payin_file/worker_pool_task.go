@@ -64,6 +64,11 @@ func (t *WorkerPoolTask) ProcessS3Keys(
 			continue
 		}
 		
+		// Drain the remaining entries without starting new work once the run is cancelled, e.g. its lease was lost
+		if ctx.Err() != nil {
+			continue
+		}
+
 		wg.Add(1)
 		sem <- struct{}{}
 		
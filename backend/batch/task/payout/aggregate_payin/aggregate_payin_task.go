@@ -0,0 +1,144 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	service "github.com/huydq/test/batch/domain/service/payout"
+	merchantUsecase "github.com/huydq/test/batch/usecase/merchant"
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
+	transactionUsecase "github.com/huydq/test/batch/usecase/transaction"
+	payoutModel "github.com/huydq/test/internal/domain/model/payout"
+	payoutRecordModel "github.com/huydq/test/internal/domain/model/payout_record"
+	"github.com/huydq/test/internal/pkg/database"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// AggregatePayinTask aggregates the payin details of a cutoff date into transactions and a draft payout
+type AggregatePayinTask struct {
+	PayinDetailUsecase      *paypayUsecase.PayinDetailUsecase
+	MerchantUsecase         *merchantUsecase.MerchantUsecase
+	TransactionUsecase      *transactionUsecase.TransactionUsecase
+	PayoutUsecase           *payoutUsecase.PayoutUsecase
+	PayinAggregationService *service.PayinAggregationService
+	ProviderID              int
+	UserID                  int
+	Logger                  logger.Logger
+}
+
+// NewAggregatePayinTask creates a new instance of AggregatePayinTask
+func NewAggregatePayinTask(
+	payinDetailUC *paypayUsecase.PayinDetailUsecase,
+	merchantUC *merchantUsecase.MerchantUsecase,
+	transactionUC *transactionUsecase.TransactionUsecase,
+	payoutUC *payoutUsecase.PayoutUsecase,
+	payinAggregationService *service.PayinAggregationService,
+	providerID int,
+	userID int,
+	logger logger.Logger,
+) *AggregatePayinTask {
+	return &AggregatePayinTask{
+		PayinDetailUsecase:      payinDetailUC,
+		MerchantUsecase:         merchantUC,
+		TransactionUsecase:      transactionUC,
+		PayoutUsecase:           payoutUC,
+		PayinAggregationService: payinAggregationService,
+		ProviderID:              providerID,
+		UserID:                  userID,
+		Logger:                  logger,
+	}
+}
+
+/**
+* Do groups the payin details of the cutoff date that have not been aggregated yet by merchant,
+* creates one transaction per merchant and a draft payout with one payout record per transaction.
+* Merchants that are not registered, without a bank account or without a positive amount to pay
+* out are skipped and left for a later run. Everything is created in a single database transaction.
+*
+* @param ctx The context for the operation.
+* @param cutoffDate The cutoff date of the payin details to aggregate.
+* @param sendingDate The date the payout is scheduled to be sent.
+* @return *payoutModel.Payout The created draft payout, or nil when there was nothing to pay out.
+* @return error Error if the payin details could not be aggregated.
+ */
+func (t *AggregatePayinTask) Do(ctx context.Context, cutoffDate, sendingDate time.Time) (*payoutModel.Payout, error) {
+	tx, err := database.NewTx[*payoutModel.Payout](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*payoutModel.Payout, error) {
+		details, err := t.PayinDetailUsecase.FindUnaggregatedDetails(ctx, cutoffDate)
+		if err != nil {
+			return nil, err
+		}
+		if len(details) == 0 {
+			t.Logger.Info("No payin details to aggregate", map[string]any{
+				"cutoffDate": cutoffDate.Format(time.DateOnly),
+			})
+			return nil, nil
+		}
+
+		groups := t.PayinAggregationService.GroupByPaymentMerchantID(details)
+		merchants, err := t.MerchantUsecase.FindByPaymentMerchantIDs(ctx, t.ProviderID, t.PayinAggregationService.PaymentMerchantIDs(groups))
+		if err != nil {
+			return nil, err
+		}
+		merchantIDs := make([]int, 0, len(merchants))
+		for _, merchant := range merchants {
+			merchantIDs = append(merchantIDs, merchant.ID)
+		}
+		bankAccounts, err := t.MerchantUsecase.FindBankAccounts(ctx, merchantIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		payout := t.PayinAggregationService.BuildPayout(sendingDate, t.UserID)
+		items, skipped := t.PayinAggregationService.Aggregate(payout, groups, merchants, bankAccounts)
+		for _, merchant := range skipped {
+			t.Logger.Warn("Skipping payin details: "+string(merchant.Reason), map[string]any{
+				"paymentMerchantID": merchant.PaymentMerchantID,
+				"merchantID":        merchant.MerchantID,
+				"detailCount":       merchant.DetailCount,
+				"amount":            merchant.Amount,
+			})
+		}
+
+		if len(items) == 0 {
+			t.Logger.Info("No merchants to pay out", map[string]any{
+				"cutoffDate": cutoffDate.Format(time.DateOnly),
+			})
+			return nil, nil
+		}
+
+		records := make([]*payoutRecordModel.PayoutRecord, 0, len(items))
+		for _, item := range items {
+			records = append(records, item.PayoutRecord)
+		}
+		payout.SetPayoutRecords(records)
+		if err := t.PayoutUsecase.CreatePayout(ctx, payout); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			item.Transaction.PayoutID = payout.ID
+			if err := t.TransactionUsecase.CreateTransaction(ctx, item.Transaction); err != nil {
+				return nil, err
+			}
+			item.PayoutRecord.TransactionID = item.Transaction.ID
+		}
+
+		if err := t.PayoutUsecase.CreatePayoutRecords(ctx, payout); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if err := t.TransactionUsecase.LinkPayoutRecord(ctx, item.Transaction, item.PayoutRecord.ID); err != nil {
+				return nil, err
+			}
+		}
+
+		return payout, nil
+	})
+}
@@ -0,0 +1,77 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	payoutUsecase "github.com/huydq/test/batch/usecase/payout"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// ExportZenginFileTask writes the Zengin transfer file of a payout to the local filesystem
+type ExportZenginFileTask struct {
+	PayoutUsecase             *payoutUsecase.PayoutUsecase
+	ZenginTransferFileService service.ZenginTransferFileService
+	OutputDir                 string
+	Logger                    logger.Logger
+}
+
+// NewExportZenginFileTask creates a new instance of ExportZenginFileTask
+func NewExportZenginFileTask(
+	payoutUC *payoutUsecase.PayoutUsecase,
+	zenginTransferFileService service.ZenginTransferFileService,
+	outputDir string,
+	logger logger.Logger,
+) *ExportZenginFileTask {
+	return &ExportZenginFileTask{
+		PayoutUsecase:             payoutUC,
+		ZenginTransferFileService: zenginTransferFileService,
+		OutputDir:                 outputDir,
+		Logger:                    logger,
+	}
+}
+
+/**
+* Do builds the Zengin transfer file of an approved payout and writes it to the output directory.
+*
+* @param ctx The context for the operation.
+* @param payoutID The ID of the payout to export.
+* @return string The path of the written file.
+* @return error Error if the payout is not approved, fails validation or the file could not be written.
+ */
+func (t *ExportZenginFileTask) Do(ctx context.Context, payoutID int) (string, error) {
+	payout, err := t.PayoutUsecase.FindPayoutWithRecords(ctx, payoutID)
+	if err != nil {
+		return "", err
+	}
+	if payout == nil {
+		return "", fmt.Errorf("payout %d not found", payoutID)
+	}
+	if !payout.CanBeProcessed() && !payout.IsProcessed() {
+		return "", fmt.Errorf("payout %d is not approved", payoutID)
+	}
+
+	file, err := t.ZenginTransferFileService.BuildTransferFile(payout, payout.PayoutRecords)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(t.OutputDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	path := filepath.Join(t.OutputDir, file.FileName)
+	if err := os.WriteFile(path, file.Content, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write zengin file: %w", err)
+	}
+
+	t.Logger.Info("Zengin transfer file exported", map[string]any{
+		"payoutID": payoutID,
+		"path":     path,
+		"records":  len(payout.PayoutRecords),
+	})
+	return path, nil
+}
@@ -0,0 +1,59 @@
+package task
+
+import (
+	"context"
+
+	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// BackfillPaymentMethodTask sets the payment method of the imported PayPay rows from their payment method labels
+type BackfillPaymentMethodTask struct {
+	BackfillUC *paypayUsecase.PaymentMethodBackfillUsecase
+	DryRun     bool
+	Logger     logger.Logger
+}
+
+// NewBackfillPaymentMethodTask creates a new instance of BackfillPaymentMethodTask
+func NewBackfillPaymentMethodTask(backfillUC *paypayUsecase.PaymentMethodBackfillUsecase, dryRun bool, logger logger.Logger) *BackfillPaymentMethodTask {
+	return &BackfillPaymentMethodTask{
+		BackfillUC: backfillUC,
+		DryRun:     dryRun,
+		Logger:     logger,
+	}
+}
+
+/**
+* Do maps the payment method labels of the rows without a payment method and logs the labels that are not mapped,
+* so that aliases can be configured for them and the backfill run again. The payin files of the transactions without
+* a label are logged to be imported again, as their labels are not in the DB.
+*
+* @param ctx The context for the operation.
+* @return *PaymentMethodBackfillResult The number of rows updated and the rows left per unmapped label.
+* @return error Any error that occurred while updating the rows.
+ */
+func (t *BackfillPaymentMethodTask) Do(ctx context.Context) (*paypayUsecase.PaymentMethodBackfillResult, error) {
+	result, err := t.BackfillUC.Backfill(ctx, t.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	for label, count := range result.Unmapped {
+		t.Logger.Warn("[Backfill] Unmapped payment method label:", map[string]any{
+			"label": label,
+			"rows":  count,
+		})
+	}
+	for _, payinFileID := range result.UnlabeledPayinFileIDs {
+		t.Logger.Warn("[Backfill] Payin file without payment method labels, import it again with payin_import_file --reimport:", map[string]any{
+			"payinFileID": payinFileID,
+		})
+	}
+	t.Logger.Info("[Backfill] Payment methods backfilled", map[string]any{
+		"updated":   result.Updated,
+		"unmapped":  len(result.Unmapped),
+		"unlabeled": len(result.UnlabeledPayinFileIDs),
+		"dryRun":    t.DryRun,
+	})
+	return result, nil
+}
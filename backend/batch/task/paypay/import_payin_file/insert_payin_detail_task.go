@@ -83,7 +83,7 @@ func (t *InsertPayinDetailTask) Do(ctx context.Context, payinFileID int, records
 		}
 
 		// Insert all records in bulk
-		err := t.PaypayPayinDetailRepo.BulkInsert(ctx, details)
+		err := t.PaypayPayinDetailRepo.BulkUpsert(ctx, details)
 		if err != nil {
 			t.appLogger.ErrorWithContext("[InsertPayinDetailTask] Error inserting details: %v", err)
 			return err
@@ -95,7 +95,7 @@ func (t *InsertPayinSummaryTask) Do(ctx context.Context, payinFileID int, record
 			summaries = append(summaries, summary)
 		}
 
-		if err := t.PaypayPayinSummaryRepo.BulkInsert(ctx, summaries); err != nil {
+		if err := t.PaypayPayinSummaryRepo.BulkUpsert(ctx, summaries); err != nil {
 			log.Printf("[InsertPayinSummaryTask] Error inserting summaries: %v", err)
 			return err
 		}
@@ -114,7 +114,7 @@ func (t *InsertPayinTransactionTask) Do(ctx context.Context, payinFileID int, re
 				TransactionAt:            transactionAt,
 				TransactionAmount:        &transactionAmount,
 				ReceiptNumber:            strPtr(record["receipt_number"]),
-				PaypayPaymentMethod:      record["paypay_payment_method"],
+				PaypayPaymentMethodLabel: strPtr(record["paypay_payment_method"]),
 				MerchantOrderID:          strPtr(record["merchant_order_id"]),
 			}
 			transactions = append(transactions, transaction)
@@ -124,7 +124,7 @@ func (t *InsertPayinTransactionTask) Do(ctx context.Context, payinFileID int, re
 			return nil
 		}
 
-		err := t.PaypayPayinTransactionRepo.BulkInsert(ctx, transactions)
+		err := t.PaypayPayinTransactionRepo.BulkUpsert(ctx, transactions)
 		if err != nil {
 			t.appLogger.ErrorWithContext("[InsertPayinTransactionTask] Error inserting transactions: %v", err)
 			return err
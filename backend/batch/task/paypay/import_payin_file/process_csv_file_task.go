@@ -14,14 +14,14 @@ import (
 )
 
 type ProcessCSVFileTask struct {
-	S3Downloader storage.S3Service
+	S3Downloader storage.StorageService
 	PayinFileUC  *payinUsecase.PayinFileUsecase
 	Bucket       string
 	Key          string
 }
 
 func NewProcessCSVFileTask(
-	s3 storage.S3Service,
+	s3 storage.StorageService,
 	payinFileUC *payinUsecase.PayinFileUsecase,
 	bucket string,
 	key string,
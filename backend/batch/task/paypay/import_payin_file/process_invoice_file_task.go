@@ -0,0 +1,201 @@
+package task
+
+import (
+	"context"
+	"io"
+	"log"
+	"path"
+
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	storageService "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	"github.com/huydq/test/batch/provider"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
+	payinUsecase "github.com/huydq/test/batch/usecase/payin"
+	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
+	model "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	object "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/database"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// ProcessInvoiceFileTask handles the import of the qualified invoice (適格請求書) PDFs
+type ProcessInvoiceFileTask struct {
+	S3Client            storageService.StorageService
+	PayinFileUC         *payinUsecase.PayinFileUsecase
+	InvoiceParseService *paypayService.InvoiceTextParseService
+	InvoiceUC           *paypayUsecase.InvoiceUsecase
+	ReimportUC          *paypayUsecase.PayinReimportUsecase
+	S3Bucket            string
+	ReportFolders       *provider.ReportFolders
+	Logger              logger.Logger
+	JobRun              *batchJobUsecase.JobRunRecorder
+}
+
+// NewProcessInvoiceFileTask creates a new instance of ProcessInvoiceFileTask
+func NewProcessInvoiceFileTask(
+	s3Client storageService.StorageService,
+	payinFileUC *payinUsecase.PayinFileUsecase,
+	invoiceParseService *paypayService.InvoiceTextParseService,
+	invoiceUC *paypayUsecase.InvoiceUsecase,
+	reimportUC *paypayUsecase.PayinReimportUsecase,
+	s3Bucket string,
+	reportFolders *provider.ReportFolders,
+	logger logger.Logger,
+	jobRun *batchJobUsecase.JobRunRecorder,
+) *ProcessInvoiceFileTask {
+	return &ProcessInvoiceFileTask{
+		S3Client:            s3Client,
+		PayinFileUC:         payinFileUC,
+		InvoiceParseService: invoiceParseService,
+		InvoiceUC:           invoiceUC,
+		ReimportUC:          reimportUC,
+		S3Bucket:            s3Bucket,
+		ReportFolders:       reportFolders,
+		Logger:              logger,
+		JobRun:              jobRun,
+	}
+}
+
+/**
+* Do imports an invoice PDF from S3.
+* It extracts the text of the PDF, reads the invoice metadata from it and stores the invoice linked to its payin.
+* Returns true if the file was successfully processed, false otherwise.
+*
+* @param ctx The context for the operation.
+* @param s3Key The S3 key of the PDF file to process.
+*
+* @return bool True if the file was successfully processed, false otherwise.
+* @return error Any error that occurred during processing.
+ */
+func (t *ProcessInvoiceFileTask) Do(ctx context.Context, s3Key string) (bool, error) {
+	t.Logger.Info("[Import] Starting invoice import for:", map[string]any{
+		"info": s3Key,
+	})
+
+	// Find payin file record in database
+	fileName := path.Base(s3Key)
+	payinFile, err := t.PayinFileUC.FindByFilename(ctx, fileName)
+	if err != nil || payinFile == nil {
+		t.Logger.Info("[Import] No PayinFile found for:", map[string]any{
+			"info": fileName,
+		})
+		return false, err
+	}
+
+	// Another run may have imported the file since its key was listed
+	if payinFile.ImportStatus == object.StatusSuccess {
+		t.Logger.Info("[Import] PayinFile already imported:", map[string]any{
+			"info": fileName,
+		})
+		return false, nil
+	}
+	t.JobRun.TrackPayinFile(ctx, payinFile.ID)
+
+	return t.importInvoice(ctx, s3Key, payinFile, nil)
+}
+
+// Reimport reads an already imported invoice again, replacing the metadata stored for it, and records the reimport in
+// the audit log as the given user
+func (t *ProcessInvoiceFileTask) Reimport(ctx context.Context, s3Key string, payinFile *model.PayinFile, userID *int) (bool, error) {
+	t.Logger.Info("[Import] Starting invoice reimport for:", map[string]any{
+		"info": s3Key,
+	})
+	t.JobRun.TrackPayinFile(ctx, payinFile.ID)
+
+	return t.importInvoice(ctx, s3Key, payinFile, func(ctx context.Context) error {
+		return t.ReimportUC.RecordReimport(ctx, payinFile.ID, userID)
+	})
+}
+
+// importInvoice downloads the PDF of the payin file and stores the invoice. replaceFunc, when given, runs in the
+// same transaction as the invoice is stored in.
+func (t *ProcessInvoiceFileTask) importInvoice(ctx context.Context, s3Key string, payinFile *model.PayinFile, replaceFunc func(ctx context.Context) error) (bool, error) {
+	folder, ok := t.ReportFolders.Match(s3Key)
+	if !ok || !folder.FileType.IsInvoice() {
+		t.Logger.Warn("[Import] File is not in an invoice folder:", map[string]any{
+			"key": s3Key,
+		})
+		return false, nil
+	}
+
+	// Download PDF file from S3
+	pdfStream, err := t.S3Client.DownloadStream(ctx, t.S3Bucket, s3Key)
+	if err != nil {
+		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
+		t.Logger.Error("Failed to download invoice", map[string]any{
+			"error": err.Error(),
+			"key":   s3Key,
+		})
+		return false, err
+	}
+	defer pdfStream.Close()
+
+	content, err := io.ReadAll(pdfStream)
+	if err != nil {
+		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
+		t.Logger.Error("Failed to read invoice", map[string]any{
+			"error": err.Error(),
+			"key":   s3Key,
+		})
+		return false, err
+	}
+
+	text, err := t.InvoiceParseService.ExtractText(content)
+	if err != nil {
+		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
+		t.Logger.Error("Failed to extract invoice text", map[string]any{
+			"error": err.Error(),
+			"key":   s3Key,
+		})
+		return false, err
+	}
+
+	// The invoice is stored even without metadata, so it can still be found by file and downloaded
+	metadata := t.InvoiceParseService.Parse(text)
+	if metadata.RegistrationNumber == "" {
+		t.Logger.Warn("[Import] No registration number found in invoice:", map[string]any{
+			"key":        s3Key,
+			"textLength": len(text),
+		})
+	}
+
+	invoice := &paypayModel.PaypayInvoice{
+		PayinFileID:          payinFile.ID,
+		Duplicate:            folder.FileType == object.PayinFileTypeDuplicateInvoice,
+		FileName:             payinFile.FileName,
+		StorageKey:           s3Key,
+		RegistrationNumber:   metadata.RegistrationNumber,
+		IssueDate:            metadata.IssueDate,
+		PaymentMerchantID:    metadata.MerchantID,
+		MerchantBusinessName: metadata.MerchantName,
+		CutoffDate:           metadata.CutoffDate,
+		TaxableAmount:        metadata.TaxableAmount,
+		TaxAmount:            metadata.TaxAmount,
+		TotalAmount:          metadata.TotalAmount,
+	}
+
+	tx, err := database.NewTx[any](ctx)
+	if err == nil {
+		_, err = tx.Transact(ctx, func(ctx context.Context) (any, error) {
+			if replaceFunc != nil {
+				if err := replaceFunc(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return nil, t.InvoiceUC.SaveInvoice(ctx, invoice)
+		})
+	}
+	if err != nil {
+		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
+		t.Logger.Error("Invoice insert error", map[string]any{
+			"error": err.Error(),
+			"key":   s3Key,
+		})
+		return false, err
+	}
+
+	t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusSuccess)
+	log.Printf("[Import] Successfully imported invoice %s (registration number: %q, linked: %t)", s3Key, invoice.RegistrationNumber, invoice.PaypayPayinDetailID != nil)
+	return true, nil
+}
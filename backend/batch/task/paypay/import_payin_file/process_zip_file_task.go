@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"log"
 	"strings"
@@ -11,54 +12,63 @@ import (
 	paypayService "github.com/huydq/test/batch/domain/service/paypay"
 	csvService "github.com/huydq/test/batch/domain/service/shared/csv"
 	storageService "github.com/huydq/test/batch/infrastructure/adapter/storage"
+	"github.com/huydq/test/batch/provider"
+	batchJobUsecase "github.com/huydq/test/batch/usecase/batchjob"
 	payinUsecase "github.com/huydq/test/batch/usecase/payin"
 	paypayUsecase "github.com/huydq/test/batch/usecase/paypay"
 	model "github.com/huydq/test/internal/domain/model/payin"
 	object "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/database"
 	"github.com/huydq/test/internal/pkg/logger"
 )
 
+// RowImportFunc imports the rows of a single-section report and returns the row errors
+type RowImportFunc func(ctx context.Context, payinFileID int, rows []object.PayinFileRow, policy object.PayinImportErrorPolicy) ([]*model.PayinImportError, error)
+
 // ProcessZipFileTask handles the processing of ZIP files containing CSV data
 type ProcessZipFileTask struct {
-	S3Client                 storageService.S3Service
-	PayinFileUC              *payinUsecase.PayinFileUsecase
-	CSVReaderService         *csvService.CsvReaderService
-	ValidateFieldsService    *paypayService.ValidateCSVFieldsService
+	S3Client                  storageService.StorageService
+	PayinFileUC               *payinUsecase.PayinFileUsecase
+	CSVReaderService          *csvService.CsvReaderService
+	ValidateFieldsService     *paypayService.ValidateCSVFieldsService
 	MultiSectionImportService *paypayService.MultiSectionCSVImportService
-	TransactionUC            *paypayUsecase.PayinTransactionUsecase
-	S3Bucket                 string
-	RemoteDir                string
-	TopUpReportPath          string
-	TopUpSummaryDetailsPath  string
-	Logger                   logger.Logger
+	RowImporters              map[object.PayinFileType]RowImportFunc // Importers of the single-section reports
+	ImportErrorUC             *payinUsecase.PayinImportErrorUsecase
+	ReimportUC                *paypayUsecase.PayinReimportUsecase
+	S3Bucket                  string
+	ReportFolders             *provider.ReportFolders
+	Logger                    logger.Logger
+	JobRun                    *batchJobUsecase.JobRunRecorder
 }
 
 // NewProcessZipFileTask creates a new instance of ProcessZipFileTask
 func NewProcessZipFileTask(
-	s3Client storageService.S3Service,
+	s3Client storageService.StorageService,
 	payinFileUC *payinUsecase.PayinFileUsecase,
 	csvReaderService *csvService.CsvReaderService,
-	validateFieldsService *paypayService.ValidateCSVFieldsService, 
+	validateFieldsService *paypayService.ValidateCSVFieldsService,
 	multiSectionImportService *paypayService.MultiSectionCSVImportService,
-	transactionUC *paypayUsecase.PayinTransactionUsecase,
+	rowImporters map[object.PayinFileType]RowImportFunc,
+	importErrorUC *payinUsecase.PayinImportErrorUsecase,
+	reimportUC *paypayUsecase.PayinReimportUsecase,
 	s3Bucket string,
-	remoteDir string,
-	topUpReportPath string,
-	topUpSummaryDetailsPath string,
+	reportFolders *provider.ReportFolders,
 	logger logger.Logger,
+	jobRun *batchJobUsecase.JobRunRecorder,
 ) *ProcessZipFileTask {
 	return &ProcessZipFileTask{
-		S3Client:                 s3Client,
-		PayinFileUC:              payinFileUC,
-		CSVReaderService:         csvReaderService,
-		ValidateFieldsService:    validateFieldsService,
+		S3Client:                  s3Client,
+		PayinFileUC:               payinFileUC,
+		CSVReaderService:          csvReaderService,
+		ValidateFieldsService:     validateFieldsService,
 		MultiSectionImportService: multiSectionImportService,
-		TransactionUC:            transactionUC,
-		S3Bucket:                 s3Bucket,
-		RemoteDir:                remoteDir,
-		TopUpReportPath:          topUpReportPath,
-		TopUpSummaryDetailsPath:  topUpSummaryDetailsPath,
-		Logger:                   logger,
+		RowImporters:              rowImporters,
+		ImportErrorUC:             importErrorUC,
+		ReimportUC:                reimportUC,
+		S3Bucket:                  s3Bucket,
+		ReportFolders:             reportFolders,
+		Logger:                    logger,
+		JobRun:                    jobRun,
 	}
 }
 
@@ -93,6 +103,63 @@ func (t *ProcessZipFileTask) Do(ctx context.Context, s3Key string) (bool, error)
 		return false, err
 	}
 
+	// Another run may have imported the file since its key was listed
+	if payinFile.ImportStatus == object.StatusSuccess {
+		t.Logger.Info("[Import] PayinFile already imported:", map[string]any{
+			"info": fileName,
+		})
+		return false, nil
+	}
+	t.JobRun.TrackPayinFile(ctx, payinFile.ID)
+
+	return t.importFile(ctx, s3Key, payinFile, nil)
+}
+
+// Reimport imports the ZIP file of an already imported payin file again. The rows imported before are replaced in the
+// same database transaction as the new import, so a failed reimport leaves them untouched, and the reimport is
+// recorded in the audit log as the given user.
+func (t *ProcessZipFileTask) Reimport(ctx context.Context, s3Key string, payinFile *model.PayinFile, userID *int) (bool, error) {
+	t.Logger.Info("[Import] Starting reimport for:", map[string]any{
+		"info": s3Key,
+	})
+
+	if err := t.ReimportUC.CheckReimportable(ctx, payinFile.ID); err != nil {
+		t.Logger.Error("PayinFile cannot be reimported", map[string]any{
+			"error": err.Error(),
+			"key":   s3Key,
+		})
+		return false, err
+	}
+	t.JobRun.TrackPayinFile(ctx, payinFile.ID)
+
+	return t.importFile(ctx, s3Key, payinFile, func(ctx context.Context) error {
+		if err := t.ReimportUC.DeleteImportedRows(ctx, payinFile.ID); err != nil {
+			return err
+		}
+		return t.ReimportUC.RecordReimport(ctx, payinFile.ID, userID)
+	})
+}
+
+// importFile downloads the ZIP file of the payin file and imports the CSV in it. replaceFunc, when given, runs in the
+// import transaction before the rows are imported.
+func (t *ProcessZipFileTask) importFile(ctx context.Context, s3Key string, payinFile *model.PayinFile, replaceFunc func(ctx context.Context) error) (bool, error) {
+	// Find how the file is imported from the folder it is in
+	folder, ok := t.ReportFolders.Match(s3Key)
+	if !ok {
+		t.Logger.Warn("[Import] File is not in a report folder:", map[string]any{
+			"key": s3Key,
+		})
+		return false, nil
+	}
+	rowImportFunc, isRowFile := t.RowImporters[folder.FileType]
+	if !isRowFile && folder.FileType != object.PayinFileTypePaymentSummary {
+		t.Logger.Warn("[Import] No importer for the report folder:", map[string]any{
+			"key":    s3Key,
+			"folder": folder.Path,
+		})
+		return false, nil
+	}
+
 	// Download ZIP file from S3
 	zipStream, err := t.S3Client.DownloadStream(ctx, t.S3Bucket, s3Key)
 	if err != nil {
@@ -152,60 +219,49 @@ func (t *ProcessZipFileTask) Do(ctx context.Context, s3Key string) (bool, error)
 	}
 	defer csvReader.Close()
 
-	// Process based on file path and type
-	joinRemotePath := func(base, sub string) string {
-		sub = strings.TrimPrefix(sub, "/")
-		return strings.TrimRight(base, "/") + "/" + sub
+	decoded, err := t.decodeCSV(ctx, s3Key, csvReader, folder.Encoding, payinFile)
+	if err != nil {
+		return false, err
 	}
 
 	// Process Top-Up Report (multi-section import)
-	if strings.HasPrefix(s3Key, strings.TrimLeft(joinRemotePath(t.RemoteDir, t.TopUpReportPath), "/")) {
-		return t.processMultiSectionFile(ctx, s3Key, csvReader, payinFile)
-	} 
-	
-	// Process Summary Details (transaction import)
-	if strings.HasPrefix(s3Key, strings.TrimLeft(joinRemotePath(t.RemoteDir, t.TopUpSummaryDetailsPath), "/")) {
-		return t.processTransactionFile(ctx, s3Key, csvReader, csvKey, payinFile, zr)
+	if !isRowFile {
+		return t.processMultiSectionFile(ctx, s3Key, decoded, payinFile, replaceFunc)
 	}
 
-	return false, nil
+	// Process the single-section reports
+	return t.processRowFile(ctx, s3Key, decoded, csvKey, payinFile, folder.FileType, rowImportFunc, replaceFunc)
 }
 
-// processMultiSectionFile processes a multi-section CSV file
-func (t *ProcessZipFileTask) processMultiSectionFile(ctx context.Context, key string, csvReader io.ReadCloser, payinFile *model.PayinFile) (bool, error) {
-	log.Printf("[Import] Reading CSV for multi-section import: %s", key)
-	
-	var lines []string
-	buf := new(bytes.Buffer)
-	n, err := io.Copy(buf, csvReader)
-	log.Printf("[Import] io.Copy read %d bytes for %s (err: %v)", n, key, err)
+// decodeCSV transcodes the CSV to UTF-8 and records the encoding it is read with on the payin file
+func (t *ProcessZipFileTask) decodeCSV(ctx context.Context, key string, csvReader io.Reader, encoding object.PayinFileEncoding, payinFile *model.PayinFile) (io.Reader, error) {
+	decoded, detected, err := t.CSVReaderService.DecodeReader(csvReader, encoding)
 	if err != nil {
 		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
-		t.Logger.Error("Failed to read csv in zip", map[string]any{
+		t.Logger.Error("Failed to decode csv", map[string]any{
 			"error": err.Error(),
 			"key":   key,
 		})
-		return false, err
-	}
-	
-	log.Printf("[Import] Buffer length after io.Copy: %d for %s", buf.Len(), key)
-	for _, line := range strings.Split(buf.String(), "\n") {
-		lines = append(lines, strings.TrimRight(line, "\r"))
-	}
-	
-	if len(lines) > 0 {
-		log.Printf("[Import] First 2 lines: %q", lines[:min(2, len(lines))])
+		return nil, err
 	}
-	
-	log.Printf("[Import] Read %d lines from CSV for %s", len(lines), key)
-	if len(lines) == 0 {
-		log.Printf("[Import] No lines found in CSV for %s", key)
-		return false, nil
+	log.Printf("[Import] Reading CSV as %s: %s", detected, key)
+
+	if err := t.PayinFileUC.RecordEncoding(ctx, payinFile, detected); err != nil {
+		t.Logger.Error("Failed to record csv encoding", map[string]any{
+			"error": err.Error(),
+			"key":   key,
+		})
 	}
-	
-	log.Printf("[Import] Calling multiSectionImportService.ProcessLines for %s", key)
+	return decoded, nil
+}
+
+// processMultiSectionFile processes a multi-section CSV file
+func (t *ProcessZipFileTask) processMultiSectionFile(ctx context.Context, key string, csvReader io.Reader, payinFile *model.PayinFile, replaceFunc func(ctx context.Context) error) (bool, error) {
 	log.Printf("[Import] Attempting multi-section import for %s", key)
-	if err := t.MultiSectionImportService.ProcessLines(ctx, payinFile.ID, lines); err != nil {
+	err := t.importRows(ctx, key, payinFile, replaceFunc, func(ctx context.Context) ([]*model.PayinImportError, error) {
+		return t.MultiSectionImportService.ProcessReader(ctx, payinFile.ID, csvReader, t.ImportErrorUC.Policy())
+	})
+	if err != nil {
 		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
 		t.Logger.Error("Multi-section import error", map[string]any{
 			"error": err.Error(),
@@ -213,41 +269,23 @@ func (t *ProcessZipFileTask) processMultiSectionFile(ctx context.Context, key st
 		})
 		return false, err
 	}
-	
+
 	t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusSuccess)
 	log.Printf("[Import] Successfully processed file %s", key)
 	log.Printf("[Import] Finished import attempt for: %s", key)
 	return true, nil
 }
 
-// processTransactionFile processes a transaction CSV file
-func (t *ProcessZipFileTask) processTransactionFile(ctx context.Context, key string, csvReader io.ReadCloser, csvKey string, payinFile *model.PayinFile, zipReader *zip.Reader) (bool, error) {
-	log.Printf("[Import] Reading CSV for transaction import: %s", key)
-	
-	// Debug: peek at first 256 bytes of CSV
-	peekBuf := make([]byte, 256)
-	n, _ := csvReader.Read(peekBuf)
-	log.Printf("[Import] First %d bytes of CSV for %s: %q", n, key, string(peekBuf[:n]))
-	csvReader.Close() // close the current reader
-	
-	// Reopen the CSV file
-	for _, f := range zipReader.File {
-		if f.Name == csvKey {
-			rc, err := f.Open()
-			if err == nil {
-				csvReader = rc
-				defer csvReader.Close()
-				break
-			}
-		}
-	}
+// processRowFile processes a single-section CSV file of the file type
+func (t *ProcessZipFileTask) processRowFile(ctx context.Context, key string, csvReader io.Reader, csvKey string, payinFile *model.PayinFile, fileType object.PayinFileType, rowImportFunc RowImportFunc, replaceFunc func(ctx context.Context) error) (bool, error) {
+	log.Printf("[Import] Reading CSV for row import: %s", key)
 
 	// Read the CSV file using domain service
 	records, err := t.CSVReaderService.ReadWithHeader(csvReader)
 	if err != nil || len(records) == 0 {
 		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
 		t.Logger.Error("CSV invalid or empty", map[string]any{
-			"error": err.Error(),
+			"error": err,
 			"key":   key,
 		})
 		return false, err
@@ -257,16 +295,16 @@ func (t *ProcessZipFileTask) processTransactionFile(ctx context.Context, key str
 	var actualHeaders []string
 	var rawHeaders []string
 	if len(records) > 0 {
-		for k := range records[0] {
+		for k := range records[0].Values {
 			actualHeaders = append(actualHeaders, strings.TrimSpace(k))
 		}
 		// Try to get the raw headers from the first record if available
-		for k := range records[0] {
+		for k := range records[0].Values {
 			rawHeaders = append(rawHeaders, k)
 		}
 	}
 
-	isValid, requiredHeaders := t.ValidateFieldsService.ValidateHeaders(actualHeaders, object.PayinFileTypePaymentTransaction)
+	isValid, requiredHeaders := t.ValidateFieldsService.ValidateHeaders(actualHeaders, fileType)
 	if !isValid {
 		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
 		t.Logger.Error("CSV missing column", map[string]any{
@@ -278,8 +316,10 @@ func (t *ProcessZipFileTask) processTransactionFile(ctx context.Context, key str
 		return false, nil
 	}
 	
-	log.Printf("[Import] Attempting transaction import for %s", key)
-	insertErr := t.TransactionUC.ProcessAndInsertTransactions(ctx, payinFile.ID, records)
+	log.Printf("[Import] Attempting row import for %s", key)
+	insertErr := t.importRows(ctx, key, payinFile, replaceFunc, func(ctx context.Context) ([]*model.PayinImportError, error) {
+		return rowImportFunc(ctx, payinFile.ID, records, t.ImportErrorUC.Policy())
+	})
 	if insertErr != nil {
 		t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusFailed)
 		t.Logger.Error("Insert error", map[string]any{
@@ -292,3 +332,51 @@ func (t *ProcessZipFileTask) processTransactionFile(ctx context.Context, key str
 	t.PayinFileUC.UpdateImportStatus(ctx, payinFile, object.StatusSuccess)
 	return true, nil
 }
+
+// importRows runs an import of the file in a database transaction and records the row errors it returns. Under the
+// reject file policy nothing of a file with row errors is imported and ErrPayinFileRejected is returned. replaceFunc,
+// when given, runs first in the same transaction.
+func (t *ProcessZipFileTask) importRows(ctx context.Context, key string, payinFile *model.PayinFile, replaceFunc func(ctx context.Context) error, importFunc func(ctx context.Context) ([]*model.PayinImportError, error)) error {
+	tx, err := database.NewTx[any](ctx)
+	if err != nil {
+		return err
+	}
+
+	var importErrors []*model.PayinImportError
+	_, err = tx.Transact(ctx, func(ctx context.Context) (any, error) {
+		if replaceFunc != nil {
+			if err := replaceFunc(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		importErrors, err = importFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if t.ImportErrorUC.Rejects(importErrors) {
+			return nil, payinUsecase.ErrPayinFileRejected
+		}
+		return nil, nil
+	})
+	if err != nil && !errors.Is(err, payinUsecase.ErrPayinFileRejected) {
+		return err
+	}
+
+	if len(importErrors) > 0 {
+		t.Logger.Warn("Rows with import errors", map[string]any{
+			"key":    key,
+			"errors": len(importErrors),
+			"policy": t.ImportErrorUC.Policy().String(),
+		})
+	}
+	// The errors are kept even when the rows are rolled back; failing here would leave committed rows behind a failed status
+	if recordErr := t.ImportErrorUC.ReplaceErrors(ctx, payinFile.ID, importErrors); recordErr != nil {
+		t.Logger.Error("Failed to record import errors", map[string]any{
+			"error": recordErr.Error(),
+			"key":   key,
+		})
+	}
+	return err
+}
@@ -18,13 +18,13 @@ type PayinFileInfo struct {
 }
 
 type StreamPayinFilesTask struct {
-	S3Downloader storage.S3Service
+	S3Downloader storage.StorageService
 	PayinFileUC  *payinUsecase.PayinFileUsecase
 	Bucket       string
 }
 
 func NewStreamPayinFilesTask(
-	s3 storage.S3Service,
+	s3 storage.StorageService,
 	payinFileUC *payinUsecase.PayinFileUsecase,
 	bucket string,
 ) *StreamPayinFilesTask {
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	repository "github.com/huydq/test/batch/domain/repository/batchjob"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// DefaultLeaseTTL is used when no lease TTL is configured
+const DefaultLeaseTTL = 5 * time.Minute
+
+var (
+	ErrLeaseHeld = errors.New("lease is held by another process")
+	ErrLeaseLost = errors.New("lease was lost")
+)
+
+// BatchJobLeaseUsecase takes leases that keep other processes from running the same command or processing the same
+// file. A held lease is renewed in the background until it is released; the lease of a process that died expires
+// after the TTL and can be taken over. The work done under a lease must run with its Context, which is cancelled when
+// the lease is lost so that the work stops before another process takes over.
+type BatchJobLeaseUsecase struct {
+	repo   repository.BatchJobLeaseRepository
+	owner  string
+	ttl    time.Duration
+	logger logger.Logger
+}
+
+func NewBatchJobLeaseUsecase(repo repository.BatchJobLeaseRepository, ttl time.Duration, logger logger.Logger) *BatchJobLeaseUsecase {
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+	return &BatchJobLeaseUsecase{
+		repo:   repo,
+		owner:  newLeaseOwner(),
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// newLeaseOwner identifies the current process in the lease table
+func newLeaseOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), uuid.NewString())
+}
+
+// CommandLeaseKey is the lease key of a batch command
+func CommandLeaseKey(commandName string) string {
+	return "command:" + commandName
+}
+
+// FileLeaseKey is the lease key of a file in the object storage, shared by the commands that write or read it
+func FileLeaseKey(storageKey string) string {
+	return "file:" + storageKey
+}
+
+// Acquire takes the lease on leaseKey. It returns an error wrapping ErrLeaseHeld, naming the holder, when another
+// process holds an unexpired lease on the key.
+func (uc *BatchJobLeaseUsecase) Acquire(ctx context.Context, leaseKey string) (*JobLease, error) {
+	now := time.Now()
+	lease := model.NewBatchJobLease(leaseKey, uc.owner, uc.ttl, now)
+	acquired, err := uc.repo.TryAcquire(ctx, lease, now)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		holder, err := uc.repo.FindByKey(ctx, leaseKey)
+		if err != nil || holder == nil {
+			return nil, fmt.Errorf("%w: %s", ErrLeaseHeld, leaseKey)
+		}
+		return nil, fmt.Errorf("%w: %s is held by %s until %s", ErrLeaseHeld, leaseKey, holder.Owner, holder.ExpiresAt.Format(time.DateTime))
+	}
+
+	leaseCtx, cancel := context.WithCancelCause(ctx)
+	jobLease := &JobLease{
+		usecase:   uc,
+		lease:     lease,
+		heldUntil: lease.ExpiresAt,
+		ctx:       leaseCtx,
+		cancel:    cancel,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go jobLease.keepAlive(ctx)
+	return jobLease, nil
+}
+
+// JobLease is a lease held by the current process
+type JobLease struct {
+	usecase *BatchJobLeaseUsecase
+	lease   *model.BatchJobLease
+	// heldUntil is the expiry of the last successful acquire or renewal
+	heldUntil time.Time
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	stop    chan struct{}
+	done    chan struct{}
+	release sync.Once
+}
+
+// Context is cancelled with ErrLeaseLost as the cause when the lease is lost, and when the lease is released
+func (l *JobLease) Context() context.Context {
+	return l.ctx
+}
+
+// Lost reports whether the lease was lost before it was released
+func (l *JobLease) Lost() bool {
+	return l != nil && errors.Is(context.Cause(l.ctx), ErrLeaseLost)
+}
+
+// keepAlive renews the lease well before it expires until it is released, and cancels the context of the lease once
+// it is taken by another process or has expired without being renewed
+func (l *JobLease) keepAlive(ctx context.Context) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.usecase.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		l.lease.Extend(l.usecase.ttl, now)
+		held, err := l.usecase.repo.Renew(ctx, l.lease)
+		if err != nil {
+			// Retried on the next tick, the lease is only lost once it expires
+			l.usecase.logger.Error("Failed to renew batch job lease", map[string]any{
+				"lease_key": l.lease.LeaseKey,
+				"error":     err.Error(),
+			})
+			if now.Before(l.heldUntil) {
+				continue
+			}
+			held = false
+		}
+		if !held {
+			l.usecase.logger.Error("Batch job lease was lost, stopping the work done under it", map[string]any{
+				"lease_key": l.lease.LeaseKey,
+			})
+			l.cancel(fmt.Errorf("%w: %s", ErrLeaseLost, l.lease.LeaseKey))
+			return
+		}
+		l.heldUntil = l.lease.ExpiresAt
+	}
+}
+
+// Release stops renewing the lease and deletes it. Releasing twice or releasing a nil lease is a no-op.
+func (l *JobLease) Release(ctx context.Context) {
+	if l == nil {
+		return
+	}
+	l.release.Do(func() {
+		close(l.stop)
+		<-l.done
+		l.cancel(context.Canceled)
+		// Deleted even when the run was cancelled, so that the next run does not wait for the expiry
+		if err := l.usecase.repo.Release(context.WithoutCancel(ctx), l.lease); err != nil {
+			// The lease expires on its own
+			l.usecase.logger.Error("Failed to release batch job lease", map[string]any{
+				"lease_key": l.lease.LeaseKey,
+				"error":     err.Error(),
+			})
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	"github.com/huydq/test/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLeaseRepository renews the lease while it is held and fails the renewals with renewErr when set
+type fakeLeaseRepository struct {
+	mu       sync.Mutex
+	held     bool
+	renewErr error
+	released bool
+}
+
+func (r *fakeLeaseRepository) TryAcquire(context.Context, *model.BatchJobLease, time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.held = true
+	return true, nil
+}
+
+func (r *fakeLeaseRepository) Renew(context.Context, *model.BatchJobLease) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.held, r.renewErr
+}
+
+func (r *fakeLeaseRepository) Release(ctx context.Context, _ *model.BatchJobLease) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	r.released = true
+	return nil
+}
+
+func (r *fakeLeaseRepository) FindByKey(context.Context, string) (*model.BatchJobLease, error) {
+	return nil, nil
+}
+
+func (r *fakeLeaseRepository) takeOver() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.held = false
+}
+
+func (r *fakeLeaseRepository) failRenewals(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.renewErr = err
+}
+
+func newTestLeaseUsecase(t *testing.T, repo *fakeLeaseRepository) *BatchJobLeaseUsecase {
+	t.Helper()
+	return NewBatchJobLeaseUsecase(repo, 30*time.Millisecond, logger.InitCLILogger(&logger.CLILoggerConfig{
+		LogLevel:     "fatal",
+		LogDirectory: t.TempDir(),
+	}))
+}
+
+func waitForCancel(t *testing.T, ctx context.Context) {
+	t.Helper()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		require.Fail(t, "the lease context was not cancelled")
+	}
+}
+
+func TestJobLease_KeepsContextWhileHeld(t *testing.T) {
+	repo := &fakeLeaseRepository{}
+	lease, err := newTestLeaseUsecase(t, repo).Acquire(context.Background(), CommandLeaseKey("test"))
+	require.NoError(t, err)
+
+	// Several renewals go through
+	time.Sleep(60 * time.Millisecond)
+	assert.NoError(t, lease.Context().Err())
+	assert.False(t, lease.Lost())
+
+	lease.Release(context.Background())
+	assert.Error(t, lease.Context().Err())
+	assert.False(t, lease.Lost())
+	assert.True(t, repo.released)
+}
+
+func TestJobLease_CancelsContextWhenTakenOver(t *testing.T) {
+	repo := &fakeLeaseRepository{}
+	lease, err := newTestLeaseUsecase(t, repo).Acquire(context.Background(), CommandLeaseKey("test"))
+	require.NoError(t, err)
+
+	repo.takeOver()
+	waitForCancel(t, lease.Context())
+
+	assert.True(t, lease.Lost())
+	assert.ErrorIs(t, context.Cause(lease.Context()), ErrLeaseLost)
+
+	// A lost lease is still deleted when released with the cancelled context of the run
+	lease.Release(lease.Context())
+	assert.True(t, repo.released)
+}
+
+func TestJobLease_CancelsContextWhenRenewalsFailUntilExpiry(t *testing.T) {
+	repo := &fakeLeaseRepository{}
+	lease, err := newTestLeaseUsecase(t, repo).Acquire(context.Background(), CommandLeaseKey("test"))
+	require.NoError(t, err)
+	defer lease.Release(context.Background())
+
+	repo.failRenewals(errors.New("connection refused"))
+	waitForCancel(t, lease.Context())
+
+	assert.True(t, lease.Lost())
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	repository "github.com/huydq/test/batch/domain/repository/batchjob"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+)
+
+type BatchJobRunUsecase struct {
+	repo repository.BatchJobRunRepository
+}
+
+func NewBatchJobRunUsecase(repo repository.BatchJobRunRepository) *BatchJobRunUsecase {
+	return &BatchJobRunUsecase{repo: repo}
+}
+
+// StartRun records that a run of the command has started
+func (uc *BatchJobRunUsecase) StartRun(ctx context.Context, commandName string, flags map[string]string) (*model.BatchJobRun, error) {
+	run := model.NewBatchJobRun(commandName, flags, time.Now())
+	if err := uc.repo.Create(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// AddPayinFile records that the run created or imported the payin file
+func (uc *BatchJobRunUsecase) AddPayinFile(ctx context.Context, run *model.BatchJobRun, payinFileID int) error {
+	if run.ID == 0 || payinFileID == 0 {
+		return nil
+	}
+	return uc.repo.AddPayinFile(ctx, run.ID, payinFileID)
+}
+
+// FinishRun records the end of the run with its counts and error summary
+func (uc *BatchJobRunUsecase) FinishRun(ctx context.Context, run *model.BatchJobRun, processedCount, failedCount int, errorSummary string, aborted bool) error {
+	if run.ID == 0 {
+		return nil
+	}
+	run.Finish(processedCount, failedCount, errorSummary, aborted, time.Now())
+	return uc.repo.UpdateResult(ctx, run)
+}
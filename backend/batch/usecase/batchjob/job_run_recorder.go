@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// maxErrorSummaryLines caps the failures kept in the error summary of a run
+const maxErrorSummaryLines = 20
+
+// JobRunRecorder records the progress of a single batch command run. Recording is best effort: failures to write
+// the history are logged and never stop the batch. It is safe for concurrent use, and a nil recorder records nothing.
+type JobRunRecorder struct {
+	usecase *BatchJobRunUsecase
+	run     *model.BatchJobRun
+	logger  logger.Logger
+
+	mu          sync.Mutex
+	failedCount int
+	failures    []string
+}
+
+// StartJobRunRecorder records the start of the run. When that fails the error is logged and nil is returned.
+func StartJobRunRecorder(ctx context.Context, uc *BatchJobRunUsecase, logger logger.Logger, commandName string, flags map[string]string) *JobRunRecorder {
+	run, err := uc.StartRun(ctx, commandName, flags)
+	if err != nil {
+		logger.Error("Failed to record batch job run start", map[string]any{
+			"command": commandName,
+			"error":   err.Error(),
+		})
+		return nil
+	}
+
+	logger.Info("Batch job run started", map[string]any{
+		"command": commandName,
+		"run_id":  run.ID,
+	})
+	return &JobRunRecorder{
+		usecase: uc,
+		run:     run,
+		logger:  logger,
+	}
+}
+
+// TrackPayinFile links a payin file created or imported by the run
+func (r *JobRunRecorder) TrackPayinFile(ctx context.Context, payinFileID int) {
+	if r == nil {
+		return
+	}
+	if err := r.usecase.AddPayinFile(ctx, r.run, payinFileID); err != nil {
+		r.logger.Error("Failed to record payin file of batch job run", map[string]any{
+			"run_id":        r.run.ID,
+			"payin_file_id": payinFileID,
+			"error":         err.Error(),
+		})
+	}
+}
+
+// RecordFailure counts an item the run failed to process
+func (r *JobRunRecorder) RecordFailure(target string, err error) {
+	if r == nil || err == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failedCount++
+	if len(r.failures) < maxErrorSummaryLines {
+		r.failures = append(r.failures, fmt.Sprintf("%s: %v", target, err))
+	}
+}
+
+// Finish records the end of the run, also when ctx was cancelled. A non-nil err means the run aborted before
+// processing every item.
+func (r *JobRunRecorder) Finish(ctx context.Context, processedCount int, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	failedCount := r.failedCount
+	summary := r.errorSummary(err)
+	r.mu.Unlock()
+
+	if finishErr := r.usecase.FinishRun(context.WithoutCancel(ctx), r.run, processedCount, failedCount, summary, err != nil); finishErr != nil {
+		r.logger.Error("Failed to record batch job run result", map[string]any{
+			"run_id": r.run.ID,
+			"error":  finishErr.Error(),
+		})
+		return
+	}
+
+	r.logger.Info("Batch job run finished", map[string]any{
+		"run_id":    r.run.ID,
+		"status":    r.run.Status.String(),
+		"processed": processedCount,
+		"failed":    failedCount,
+	})
+}
+
+func (r *JobRunRecorder) errorSummary(err error) string {
+	lines := make([]string, 0, len(r.failures)+2)
+	if err != nil {
+		lines = append(lines, err.Error())
+	}
+	lines = append(lines, r.failures...)
+	if omitted := r.failedCount - len(r.failures); omitted > 0 {
+		lines = append(lines, fmt.Sprintf("... and %d more failures", omitted))
+	}
+	return strings.Join(lines, "\n")
+}
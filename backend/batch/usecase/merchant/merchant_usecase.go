@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/merchant"
+	model "github.com/huydq/test/internal/domain/model/merchant"
+)
+
+type MerchantUsecase struct {
+	merchantRepo    repository.MerchantRepository
+	bankAccountRepo repository.MerchantBankAccountRepository
+}
+
+func NewMerchantUsecase(merchantRepo repository.MerchantRepository, bankAccountRepo repository.MerchantBankAccountRepository) *MerchantUsecase {
+	return &MerchantUsecase{
+		merchantRepo:    merchantRepo,
+		bankAccountRepo: bankAccountRepo,
+	}
+}
+
+// FindByPaymentMerchantIDs returns the merchants of a payment provider keyed by the merchant ID issued by the provider
+func (uc *MerchantUsecase) FindByPaymentMerchantIDs(ctx context.Context, paymentProviderID int, paymentMerchantIDs []string) (map[string]*model.Merchant, error) {
+	merchants, err := uc.merchantRepo.FindByPaymentMerchantIDs(ctx, paymentProviderID, paymentMerchantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*model.Merchant, len(merchants))
+	for _, merchant := range merchants {
+		result[merchant.PaymentMerchantID] = merchant
+	}
+	return result, nil
+}
+
+func (uc *MerchantUsecase) FindBankAccounts(ctx context.Context, merchantIDs []int) (map[int]*model.MerchantBankAccount, error) {
+	return uc.bankAccountRepo.FindByMerchantIDs(ctx, merchantIDs)
+}
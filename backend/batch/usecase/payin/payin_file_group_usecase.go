@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	repository "github.com/huydq/test/batch/domain/repository/payin"
 	model "github.com/huydq/test/internal/domain/model/payin"
@@ -18,3 +19,19 @@ func NewPayinFileGroupUsecase(repo repository.PayinFileGroupRepository) *PayinFi
 func (uc *PayinFileGroupUsecase) CreateGroup(ctx context.Context, group *model.PayinFileGroup) error {
 	return uc.repo.Create(ctx, group)
 }
+
+func (uc *PayinFileGroupUsecase) FindGroup(ctx context.Context, id int) (*model.PayinFileGroup, error) {
+	return uc.repo.FindByID(ctx, id)
+}
+
+// MarkListed checkpoints the group once all its remote files are registered, so that a resumed run does not list them again
+func (uc *PayinFileGroupUsecase) MarkListed(ctx context.Context, group *model.PayinFileGroup) error {
+	group.MarkListed(time.Now())
+	return uc.repo.UpdateCheckpoint(ctx, group)
+}
+
+// MarkImported marks the group as complete
+func (uc *PayinFileGroupUsecase) MarkImported(ctx context.Context, group *model.PayinFileGroup) error {
+	group.MarkImported(time.Now())
+	return uc.repo.UpdateCheckpoint(ctx, group)
+}
@@ -36,6 +36,15 @@ func (uc *PayinFileUsecase) UpdateUploadStatus(ctx context.Context, file *model.
 	return uc.repo.UpdateStatus(ctx, file)
 }
 
+// RecordUpload stores the size and checksums of the object the file was uploaded as
+func (uc *PayinFileUsecase) RecordUpload(ctx context.Context, file *model.PayinFile, size int64, md5Checksum string, sha256Checksum string) error {
+	if file.ID == 0 {
+		return nil
+	}
+	file.RecordUpload(size, md5Checksum, sha256Checksum)
+	return uc.repo.UpdateUploadResult(ctx, file)
+}
+
 func (uc *PayinFileUsecase) UpdateImportStatus(ctx context.Context, file *model.PayinFile, status object.PayinFileStatus) error {
 	if file.ID == 0 {
 		return nil
@@ -44,6 +53,15 @@ func (uc *PayinFileUsecase) UpdateImportStatus(ctx context.Context, file *model.
 	return uc.repo.UpdateStatus(ctx, file)
 }
 
+// RecordEncoding stores the character encoding the file content was read with
+func (uc *PayinFileUsecase) RecordEncoding(ctx context.Context, file *model.PayinFile, encoding object.PayinFileEncoding) error {
+	if file.ID == 0 {
+		return nil
+	}
+	file.RecordEncoding(encoding)
+	return uc.repo.UpdateEncoding(ctx, file)
+}
+
 func (uc *PayinFileUsecase) FileExistsAndDownloaded(ctx context.Context, filename string) (bool, error) {
 	file, err := uc.repo.FindByFilename(ctx, filename)
 	if err != nil || file == nil || file.ID == 0 {
@@ -62,3 +80,22 @@ func (uc *PayinFileUsecase) FindByFilename(ctx context.Context, filename string)
 	}
 	return payinFile, nil
 }
+
+// GetByID returns the payin file with the ID, or nil when there is none
+func (uc *PayinFileUsecase) GetByID(ctx context.Context, id int) (*model.PayinFile, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+// ListUnfetchedFiles lists the files of the group that still have to be downloaded or uploaded
+func (uc *PayinFileUsecase) ListUnfetchedFiles(ctx context.Context, groupID int) ([]*model.PayinFile, error) {
+	return uc.repo.ListUnfetchedByGroupID(ctx, groupID)
+}
+
+// AllFilesFetched reports whether every file of the group has been downloaded and uploaded
+func (uc *PayinFileUsecase) AllFilesFetched(ctx context.Context, groupID int) (bool, error) {
+	count, err := uc.repo.CountUnfetchedByGroupID(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+	return count == 0, nil
+}
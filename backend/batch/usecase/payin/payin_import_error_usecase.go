@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	repository "github.com/huydq/test/batch/domain/repository/payin"
+	model "github.com/huydq/test/internal/domain/model/payin"
+	object "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// ErrPayinFileRejected is returned when a file with row errors is not imported under the reject file policy
+var ErrPayinFileRejected = errors.New("payin file rejected because of row errors")
+
+// PayinImportErrorUsecase records the rows of payin files that could not be imported as is, under the configured policy
+type PayinImportErrorUsecase struct {
+	repo   repository.PayinImportErrorRepository
+	policy object.PayinImportErrorPolicy
+}
+
+func NewPayinImportErrorUsecase(repo repository.PayinImportErrorRepository, policy object.PayinImportErrorPolicy) *PayinImportErrorUsecase {
+	return &PayinImportErrorUsecase{repo: repo, policy: policy}
+}
+
+// Policy returns the policy applied to rows with errors
+func (uc *PayinImportErrorUsecase) Policy() object.PayinImportErrorPolicy {
+	return uc.policy
+}
+
+// Rejects reports whether a file with the given row errors must not be imported. Warnings never reject a file.
+func (uc *PayinImportErrorUsecase) Rejects(importErrors []*model.PayinImportError) bool {
+	if uc.policy != object.PayinImportErrorPolicyRejectFile {
+		return false
+	}
+	for _, importError := range importErrors {
+		if !importError.IsWarning() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceErrors replaces the errors recorded by previous imports of the file with the errors of the last import
+func (uc *PayinImportErrorUsecase) ReplaceErrors(ctx context.Context, payinFileID int, importErrors []*model.PayinImportError) error {
+	if err := uc.repo.DeleteByPayinFileID(ctx, payinFileID); err != nil {
+		return err
+	}
+	for _, importError := range importErrors {
+		// Warnings keep their policy
+		if importError.Policy == 0 {
+			importError.Policy = uc.policy
+		}
+	}
+	return uc.repo.BulkCreate(ctx, importErrors)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	repository "github.com/huydq/test/batch/domain/repository/payin"
+	model "github.com/huydq/test/internal/domain/model/payin"
+)
+
+// ErrPaymentProviderNotFound is returned when the code of a provider is not in the payment_provider table
+var ErrPaymentProviderNotFound = errors.New("payment provider not found")
+
+type PaymentProviderUsecase struct {
+	repo repository.PaymentProviderRepository
+}
+
+func NewPaymentProviderUsecase(repo repository.PaymentProviderRepository) *PaymentProviderUsecase {
+	return &PaymentProviderUsecase{repo: repo}
+}
+
+// GetByCode returns the payment provider of the code, ErrPaymentProviderNotFound when it is not in the master table
+func (uc *PaymentProviderUsecase) GetByCode(ctx context.Context, code string) (*model.PaymentProvider, error) {
+	provider, err := uc.repo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("%w: %s", ErrPaymentProviderNotFound, code)
+	}
+	return provider, nil
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/payout"
+	model "github.com/huydq/test/internal/domain/model/payout_reconciliation"
+)
+
+type PayoutReconciliationUsecase struct {
+	repo repository.PayoutReconciliationRepository
+}
+
+func NewPayoutReconciliationUsecase(repo repository.PayoutReconciliationRepository) *PayoutReconciliationUsecase {
+	return &PayoutReconciliationUsecase{repo: repo}
+}
+
+// FlagMismatch records an amount mismatch between a payout record and the bank
+func (uc *PayoutReconciliationUsecase) FlagMismatch(ctx context.Context, reconciliation *model.PayoutReconciliation) error {
+	return uc.repo.Save(ctx, reconciliation)
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	repository "github.com/huydq/test/batch/domain/repository/payout"
+	model "github.com/huydq/test/internal/domain/model/payout"
+	recordModel "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+)
+
+type PayoutUsecase struct {
+	payoutRepo       repository.PayoutRepository
+	payoutRecordRepo repository.PayoutRecordRepository
+}
+
+func NewPayoutUsecase(payoutRepo repository.PayoutRepository, payoutRecordRepo repository.PayoutRecordRepository) *PayoutUsecase {
+	return &PayoutUsecase{
+		payoutRepo:       payoutRepo,
+		payoutRecordRepo: payoutRecordRepo,
+	}
+}
+
+// CreatePayout creates a payout; its totals are calculated from the records already set on it
+func (uc *PayoutUsecase) CreatePayout(ctx context.Context, payout *model.Payout) error {
+	return uc.payoutRepo.Create(ctx, payout)
+}
+
+// CreatePayoutRecords creates the records set on a payout that has already been created
+func (uc *PayoutUsecase) CreatePayoutRecords(ctx context.Context, payout *model.Payout) error {
+	for _, record := range payout.PayoutRecords {
+		record.PayoutID = payout.ID
+	}
+	return uc.payoutRecordRepo.CreateBatch(ctx, payout.PayoutRecords)
+}
+
+// FindPayoutsToSubmit lists the approved payouts that are scheduled to be sent on the given date
+func (uc *PayoutUsecase) FindPayoutsToSubmit(ctx context.Context, sendingDate time.Time) ([]*model.Payout, error) {
+	return uc.payoutRepo.FindApprovedBySendingDate(ctx, sendingDate)
+}
+
+func (uc *PayoutUsecase) FindRecords(ctx context.Context, payoutID int) ([]*recordModel.PayoutRecord, error) {
+	return uc.payoutRecordRepo.FindByPayoutID(ctx, payoutID)
+}
+
+// FindPayoutWithRecords retrieves a payout together with its records, returning nil if it does not exist
+func (uc *PayoutUsecase) FindPayoutWithRecords(ctx context.Context, payoutID int) (*model.Payout, error) {
+	payout, err := uc.payoutRepo.FindByID(ctx, payoutID)
+	if err != nil || payout == nil {
+		return nil, err
+	}
+
+	records, err := uc.payoutRecordRepo.FindByPayoutID(ctx, payoutID)
+	if err != nil {
+		return nil, err
+	}
+	payout.PayoutRecords = records
+
+	return payout, nil
+}
+
+// FindRecordsToPoll lists the records whose transfer has been requested but not yet executed
+func (uc *PayoutUsecase) FindRecordsToPoll(ctx context.Context) ([]*recordModel.PayoutRecord, error) {
+	return uc.payoutRecordRepo.FindByTransferStatuses(ctx, []object.TransferStatus{
+		object.TransferStatusRequested,
+		object.TransferStatusInProgress,
+	})
+}
+
+func (uc *PayoutUsecase) SaveTransferResult(ctx context.Context, record *recordModel.PayoutRecord) error {
+	return uc.payoutRecordRepo.UpdateTransferResult(ctx, record)
+}
+
+// CountUnsubmitted counts the records whose transfer has not been accepted by the bank yet
+func (uc *PayoutUsecase) CountUnsubmitted(records []*recordModel.PayoutRecord) int {
+	unsubmitted := 0
+	for _, record := range records {
+		if !record.IsTransferSubmitted() {
+			unsubmitted++
+		}
+	}
+	return unsubmitted
+}
+
+// RollUpResult is the outcome of rolling up a payout whose transfers are all final
+type RollUpResult struct {
+	Payout        *model.Payout
+	FailedRecords []*recordModel.PayoutRecord // Records whose transfer failed or was returned by the bank
+}
+
+// RollUpPayout updates the payout once none of its transfers is pending anymore. The payout is marked as processed,
+// with the latest execution time as the sent date, when all of its transfers have been executed; it is marked as having
+// failed transfers when some of them failed or were returned, so that finance can handle them. It returns nil when the
+// payout was not updated.
+func (uc *PayoutUsecase) RollUpPayout(ctx context.Context, payoutID int) (*RollUpResult, error) {
+	payout, err := uc.payoutRepo.FindByID(ctx, payoutID)
+	if err != nil || payout == nil {
+		return nil, err
+	}
+	if !payout.CanBeProcessed() && !payout.IsProcessed() {
+		return nil, nil
+	}
+
+	records, err := uc.payoutRecordRepo.FindByPayoutID(ctx, payoutID)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	var sentDate time.Time
+	var failedRecords []*recordModel.PayoutRecord
+	for _, record := range records {
+		switch {
+		case record.TransferStatus == object.TransferStatusFailed:
+			failedRecords = append(failedRecords, record)
+		case record.TransferStatus.IsProcessed() && record.TransferExecutedAt != nil:
+			if record.TransferExecutedAt.After(sentDate) {
+				sentDate = *record.TransferExecutedAt
+			}
+		default:
+			return nil, nil
+		}
+	}
+
+	if len(failedRecords) > 0 {
+		payout.MarkAsTransferFailed()
+	} else {
+		payout.MarkAsProcessed(sentDate)
+	}
+	if err := uc.payoutRepo.UpdateStatus(ctx, payout); err != nil {
+		return nil, err
+	}
+	return &RollUpResult{Payout: payout, FailedRecords: failedRecords}, nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+
+	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// InvoiceUsecase handles business logic for the qualified invoices
+type InvoiceUsecase struct {
+	repo        paypayRepo.PaypayInvoiceRepository
+	detailRepo  paypayRepo.PaypayPayinDetailRepository
+	summaryRepo paypayRepo.PaypayPayinSummaryRepository
+	appLogger   logger.Logger
+}
+
+// NewInvoiceUsecase creates a new instance of InvoiceUsecase
+func NewInvoiceUsecase(
+	repo paypayRepo.PaypayInvoiceRepository,
+	detailRepo paypayRepo.PaypayPayinDetailRepository,
+	summaryRepo paypayRepo.PaypayPayinSummaryRepository,
+	appLogger logger.Logger,
+) *InvoiceUsecase {
+	return &InvoiceUsecase{
+		repo:        repo,
+		detailRepo:  detailRepo,
+		summaryRepo: summaryRepo,
+		appLogger:   appLogger,
+	}
+}
+
+// SaveInvoice links the invoice to the payin detail of its merchant and cutoff date and upserts it
+func (uc *InvoiceUsecase) SaveInvoice(ctx context.Context, invoice *paypayModel.PaypayInvoice) error {
+	if err := uc.linkPayin(ctx, invoice); err != nil {
+		uc.appLogger.ErrorWithContext("[InvoiceUsecase] Error finding the payin of the invoice: %v", err)
+		return err
+	}
+
+	if err := uc.repo.Upsert(ctx, invoice); err != nil {
+		uc.appLogger.ErrorWithContext("[InvoiceUsecase] Error upserting invoice: %v", err)
+		return err
+	}
+	return nil
+}
+
+// RelinkInvoices links the invoices whose payin was not imported yet, or was imported again, and returns the number
+// of invoices linked
+func (uc *InvoiceUsecase) RelinkInvoices(ctx context.Context) (int, error) {
+	invoices, err := uc.repo.FindUnlinked(ctx)
+	if err != nil {
+		uc.appLogger.ErrorWithContext("[InvoiceUsecase] Error listing unlinked invoices: %v", err)
+		return 0, err
+	}
+
+	linked := 0
+	for _, invoice := range invoices {
+		if err := uc.linkPayin(ctx, invoice); err != nil {
+			return linked, err
+		}
+		if invoice.PaypayPayinDetailID == nil {
+			continue
+		}
+		if err := uc.repo.UpdateLinks(ctx, invoice); err != nil {
+			uc.appLogger.ErrorWithContext("[InvoiceUsecase] Error linking invoice: %v", err)
+			return linked, err
+		}
+		linked++
+	}
+	return linked, nil
+}
+
+// linkPayin sets the payin detail and summary of the invoice, which are left unset when the invoice has no merchant or
+// cutoff date or the payin report is not imported yet
+func (uc *InvoiceUsecase) linkPayin(ctx context.Context, invoice *paypayModel.PaypayInvoice) error {
+	if invoice.PaymentMerchantID == "" || invoice.CutoffDate == nil {
+		invoice.LinkPayin(nil, nil)
+		return nil
+	}
+
+	detail, err := uc.detailRepo.FindByMerchantAndCutoffDate(ctx, invoice.PaymentMerchantID, *invoice.CutoffDate)
+	if err != nil {
+		return err
+	}
+	if detail == nil {
+		invoice.LinkPayin(nil, nil)
+		return nil
+	}
+
+	summary, err := uc.summaryRepo.FindByPayinFileIDAndCutoffDate(ctx, detail.PayinFileID, *invoice.CutoffDate)
+	if err != nil {
+		return err
+	}
+	invoice.LinkPayin(detail, summary)
+	return nil
+}
@@ -2,12 +2,12 @@ package usecase
 
 import (
 	"context"
-	"strconv"
-	"strings"
 	"time"
 
 	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
 	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
 	"github.com/huydq/test/internal/pkg/database"
 	"github.com/huydq/test/internal/pkg/logger"
 	"gorm.io/gorm"
@@ -26,71 +26,103 @@ func NewPayinDetailUsecase(repo paypayRepo.PaypayPayinDetailRepository, appLogge
 	}
 }
 
-// ProcessAndInsertDetails processes detail records and inserts them into database
-func (uc *PayinDetailUsecase) ProcessAndInsertDetails(ctx context.Context, payinFileID int, records []map[string]string) error {
+// ProcessAndInsertDetails parses the detail rows and inserts them into database. It returns an import error for every
+// value that cannot be parsed; the rows with errors are imported or skipped, or the whole file is rejected, per policy.
+func (uc *PayinDetailUsecase) ProcessAndInsertDetails(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	var details []*paypayModel.PaypayPayinDetail
+	var detailRows []payinObject.PayinFileRow
+	var importErrors []*payinModel.PayinImportError
+	for _, row := range rows {
+		p := newRowParser(payinFileID, row)
+		detail := &paypayModel.PaypayPayinDetail{
+			PayinFileID:          payinFileID,
+			PaymentMerchantID:    row.Values["payment_merchant_id"],
+			MerchantBusinessName: row.Values["merchant_business_name"],
+			CutoffDate:           p.date("cutoff_date"),
+			TransactionAmount:    p.requiredFloat("transaction_amount"),
+			RefundAmount:         p.float("refund_amount"),
+			UsageFee:             p.float("usage_fee"),
+			PlatformFee:          p.float("platform_fee"),
+			InitialFee:           p.float("initial_fee"),
+			Tax:                  p.float("tax"),
+			Cashback:             p.float("cashback"),
+			Adjustment:           p.float("adjustment"),
+			Fee:                  p.float("fee"),
+			Amount:               p.float("amount"),
+		}
+		importErrors = append(importErrors, p.errors...)
+		if p.skipped(policy) {
+			continue
+		}
+		details = append(details, detail)
+		detailRows = append(detailRows, row)
+	}
+	if rejected(policy, importErrors) || len(details) == 0 {
+		return importErrors, nil
+	}
+
 	tx, err := database.GetTxOrDB(ctx)
 	if err != nil {
 		uc.appLogger.ErrorWithContext("[PayinDetailUsecase] Error getting transaction: %v", err)
-		return err
+		return nil, err
 	}
 
 	err = tx.Transaction(func(txCtx *gorm.DB) error {
-		var details []*paypayModel.PaypayPayinDetail
-		for _, record := range records {
-			// Parse transaction amount
-			transactionAmount, err := strconv.ParseFloat(record["transaction_amount"], 64)
-			if err != nil {
-				uc.appLogger.ErrorWithContext("[PayinDetailUsecase] Error parsing transaction_amount: %v", err)
-				return err
-			}
-
-			// Parse other numeric fields
-			refundAmount, _ := strconv.ParseFloat(record["refund_amount"], 64)
-			usageFee, _ := strconv.ParseFloat(record["usage_fee"], 64)
-			platformFee, _ := strconv.ParseFloat(record["platform_fee"], 64)
-			initialFee, _ := strconv.ParseFloat(record["initial_fee"], 64)
-			tax, _ := strconv.ParseFloat(record["tax"], 64)
-			cashback, _ := strconv.ParseFloat(record["cashback"], 64)
-			adjustment, _ := strconv.ParseFloat(record["adjustment"], 64)
-			fee, _ := strconv.ParseFloat(record["fee"], 64)
-			amount, _ := strconv.ParseFloat(record["amount"], 64)
-
-			// Defensive parse for cutoff_date
-			var cutoffDatePtr *time.Time
-			if s := strings.TrimSpace(record["cutoff_date"]); s != "" {
-				if d, err := time.Parse("2006-01-02", s); err == nil {
-					cutoffDatePtr = &d
-				}
-			}
-
-			// Create detail record
-			detail := &paypayModel.PaypayPayinDetail{
-				PayinFileID:          payinFileID,
-				PaymentMerchantID:    record["payment_merchant_id"],
-				MerchantBusinessName: record["merchant_business_name"],
-				CutoffDate:           cutoffDatePtr,
-				TransactionAmount:    transactionAmount,
-				RefundAmount:         refundAmount,
-				UsageFee:             usageFee,
-				PlatformFee:          platformFee,
-				InitialFee:           initialFee,
-				Tax:                  tax,
-				Cashback:             cashback,
-				Adjustment:           adjustment,
-				Fee:                  fee,
-				Amount:               amount,
-			}
-			details = append(details, detail)
+		aggregated, err := uc.repo.FindAggregatedByNaturalKeys(ctx, details)
+		if err != nil {
+			uc.appLogger.ErrorWithContext("[PayinDetailUsecase] Error finding aggregated details: %v", err)
+			return err
+		}
+		var aggregatedErrors []*payinModel.PayinImportError
+		details, aggregatedErrors = skipAggregatedDetails(payinFileID, details, detailRows, aggregated)
+		importErrors = append(importErrors, aggregatedErrors...)
+		if rejected(policy, importErrors) || len(details) == 0 {
+			return nil
 		}
 
 		// Insert all records in bulk
-		err := uc.repo.BulkInsert(ctx, details)
+		err = uc.repo.BulkUpsert(ctx, details)
 		if err != nil {
 			uc.appLogger.ErrorWithContext("[PayinDetailUsecase] Error inserting details: %v", err)
 			return err
 		}
 		return nil
 	})
-	
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return importErrors, nil
+}
+
+// skipAggregatedDetails leaves out the details that would overwrite a detail already aggregated into a transaction, the
+// payout of which was made from the amounts of that detail, and returns an import error for each of their rows
+func skipAggregatedDetails(payinFileID int, details []*paypayModel.PaypayPayinDetail, rows []payinObject.PayinFileRow, aggregated []*paypayModel.PaypayPayinDetail) ([]*paypayModel.PaypayPayinDetail, []*payinModel.PayinImportError) {
+	if len(aggregated) == 0 {
+		return details, nil
+	}
+	aggregatedKeys := make(map[string]bool, len(aggregated))
+	for _, detail := range aggregated {
+		aggregatedKeys[detailNaturalKey(detail)] = true
+	}
+
+	var kept []*paypayModel.PaypayPayinDetail
+	var importErrors []*payinModel.PayinImportError
+	for i, detail := range details {
+		if detail.CutoffDate != nil && aggregatedKeys[detailNaturalKey(detail)] {
+			importErrors = append(importErrors, payinModel.NewPayinImportError(payinFileID, rows[i].Line, "cutoff_date", rows[i].Values["cutoff_date"], reasonAggregatedDetail))
+			continue
+		}
+		kept = append(kept, detail)
+	}
+	return kept, importErrors
+}
+
+// detailNaturalKey is the merchant and cutoff date that identify a detail, the unique key of the table
+func detailNaturalKey(detail *paypayModel.PaypayPayinDetail) string {
+	return detail.PaymentMerchantID + "/" + detail.CutoffDate.Format("2006-01-02")
+}
+
+// FindUnaggregatedDetails lists the details of a cutoff date that have not been aggregated into a transaction yet
+func (uc *PayinDetailUsecase) FindUnaggregatedDetails(ctx context.Context, cutoffDate time.Time) ([]*paypayModel.PaypayPayinDetail, error) {
+	return uc.repo.FindUnaggregatedByCutoffDate(ctx, cutoffDate)
 }
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePayinDetailRepository keeps the upserted details; the details of the aggregated merchants of the cutoff date
+// are already referenced by a transaction record
+type fakePayinDetailRepository struct {
+	aggregatedMerchants map[string]bool
+	upserted            []*paypayModel.PaypayPayinDetail
+}
+
+func (r *fakePayinDetailRepository) BulkUpsert(_ context.Context, details []*paypayModel.PaypayPayinDetail) error {
+	r.upserted = append(r.upserted, details...)
+	return nil
+}
+
+func (r *fakePayinDetailRepository) DeleteByPayinFileID(context.Context, int) error {
+	return nil
+}
+
+func (r *fakePayinDetailRepository) FindAggregatedByNaturalKeys(_ context.Context, details []*paypayModel.PaypayPayinDetail) ([]*paypayModel.PaypayPayinDetail, error) {
+	var aggregated []*paypayModel.PaypayPayinDetail
+	for _, detail := range details {
+		if r.aggregatedMerchants[detail.PaymentMerchantID] {
+			aggregated = append(aggregated, &paypayModel.PaypayPayinDetail{
+				ID:                100,
+				PaymentMerchantID: detail.PaymentMerchantID,
+				CutoffDate:        detail.CutoffDate,
+			})
+		}
+	}
+	return aggregated, nil
+}
+
+func (r *fakePayinDetailRepository) CountAggregatedByPayinFileID(context.Context, int) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakePayinDetailRepository) FindUnaggregatedByCutoffDate(context.Context, time.Time) ([]*paypayModel.PaypayPayinDetail, error) {
+	return nil, nil
+}
+
+func (r *fakePayinDetailRepository) FindByMerchantAndCutoffDate(context.Context, string, time.Time) (*paypayModel.PaypayPayinDetail, error) {
+	return nil, nil
+}
+
+func newTestDetailRows() []payinObject.PayinFileRow {
+	row := func(line int, merchantID string) payinObject.PayinFileRow {
+		return payinObject.PayinFileRow{Line: line, Values: map[string]string{
+			"payment_merchant_id":    merchantID,
+			"merchant_business_name": "テスト商事",
+			"cutoff_date":            "2025/06/10",
+			"transaction_amount":     "10000",
+			"fee":                    "300",
+			"amount":                 "9700",
+		}}
+	}
+	return []payinObject.PayinFileRow{row(2, "M1"), row(3, "AGGREGATED")}
+}
+
+func TestPayinDetailUsecase_ProcessAndInsertDetailsSkipsAggregatedDetails(t *testing.T) {
+	cases := []struct {
+		name         string
+		policy       payinObject.PayinImportErrorPolicy
+		wantUpserted []string
+	}{
+		{"the file is rejected", payinObject.PayinImportErrorPolicyRejectFile, nil},
+		{"the other rows are imported when skipping rows", payinObject.PayinImportErrorPolicySkipRow, []string{"M1"}},
+		{"the other rows are imported when warning", payinObject.PayinImportErrorPolicyWarn, []string{"M1"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := newDryRunContext(t)
+			repo := &fakePayinDetailRepository{aggregatedMerchants: map[string]bool{"AGGREGATED": true}}
+			uc := NewPayinDetailUsecase(repo, logger.InitCLILogger(&logger.CLILoggerConfig{LogLevel: "fatal", LogDirectory: t.TempDir()}))
+
+			importErrors, err := uc.ProcessAndInsertDetails(ctx, 1, newTestDetailRows(), tc.policy)
+			require.NoError(t, err)
+
+			require.Len(t, importErrors, 1)
+			assert.False(t, importErrors[0].IsWarning())
+			assert.Equal(t, 3, importErrors[0].LineNumber)
+			assert.Equal(t, "cutoff_date", importErrors[0].ColumnName)
+			assert.Equal(t, reasonAggregatedDetail, importErrors[0].Reason)
+
+			var upserted []string
+			for _, detail := range repo.upserted {
+				upserted = append(upserted, detail.PaymentMerchantID)
+			}
+			assert.Equal(t, tc.wantUpserted, upserted)
+		})
+	}
+}
+
+func TestPayinDetailUsecase_ProcessAndInsertDetailsTwice(t *testing.T) {
+	ctx, _ := newDryRunContext(t)
+	repo := &fakePayinDetailRepository{}
+	uc := NewPayinDetailUsecase(repo, logger.InitCLILogger(&logger.CLILoggerConfig{LogLevel: "fatal", LogDirectory: t.TempDir()}))
+
+	// Details that are not aggregated yet are updated by the second import
+	for i := 0; i < 2; i++ {
+		importErrors, err := uc.ProcessAndInsertDetails(ctx, 1, newTestDetailRows(), payinObject.PayinImportErrorPolicyRejectFile)
+		require.NoError(t, err)
+		assert.Empty(t, importErrors)
+	}
+	assert.Len(t, repo.upserted, 4)
+}
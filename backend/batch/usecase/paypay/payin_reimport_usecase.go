@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	auditLogRepo "github.com/huydq/test/batch/domain/repository/auditlog"
+	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	auditLogModel "github.com/huydq/test/internal/domain/model/audit_log"
+	auditLogObject "github.com/huydq/test/internal/domain/object/audit_log"
+)
+
+// ErrPayinFileAggregated is returned for a file whose details are already aggregated into transactions: replacing the
+// details would leave the transaction records of the payouts pointing to deleted rows
+var ErrPayinFileAggregated = errors.New("payin file details are already aggregated into transactions")
+
+// PayinReimportUsecase handles the replacement of the rows imported from a payin file
+type PayinReimportUsecase struct {
+	summaryRepo     paypayRepo.PaypayPayinSummaryRepository
+	detailRepo      paypayRepo.PaypayPayinDetailRepository
+	transactionRepo paypayRepo.PaypayPayinTransactionRepository
+	reportRepos     []importedRowsRepository
+	auditLogRepo    auditLogRepo.AuditLogRepository
+}
+
+// importedRowsRepository is a repository of the rows of a report that can be deleted by payin file
+type importedRowsRepository interface {
+	DeleteByPayinFileID(ctx context.Context, payinFileID int) error
+}
+
+// NewPayinReimportUsecase creates a new instance of PayinReimportUsecase
+func NewPayinReimportUsecase(
+	summaryRepo paypayRepo.PaypayPayinSummaryRepository,
+	detailRepo paypayRepo.PaypayPayinDetailRepository,
+	transactionRepo paypayRepo.PaypayPayinTransactionRepository,
+	transactionDetailRepo paypayRepo.PaypayTransactionDetailRepository,
+	transactionSummaryRepo paypayRepo.PaypayTransactionSummaryRepository,
+	topUpDetailRepo paypayRepo.PaypayTopUpDetailRepository,
+	auditLogRepo auditLogRepo.AuditLogRepository,
+) *PayinReimportUsecase {
+	return &PayinReimportUsecase{
+		summaryRepo:     summaryRepo,
+		detailRepo:      detailRepo,
+		transactionRepo: transactionRepo,
+		reportRepos:     []importedRowsRepository{transactionDetailRepo, transactionSummaryRepo, topUpDetailRepo},
+		auditLogRepo:    auditLogRepo,
+	}
+}
+
+// CheckReimportable returns ErrPayinFileAggregated when the rows of the file cannot be replaced
+func (uc *PayinReimportUsecase) CheckReimportable(ctx context.Context, payinFileID int) error {
+	count, err := uc.detailRepo.CountAggregatedByPayinFileID(ctx, payinFileID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrPayinFileAggregated
+	}
+	return nil
+}
+
+// DeleteImportedRows deletes the rows of every report type imported from the payin file
+func (uc *PayinReimportUsecase) DeleteImportedRows(ctx context.Context, payinFileID int) error {
+	if err := uc.summaryRepo.DeleteByPayinFileID(ctx, payinFileID); err != nil {
+		return err
+	}
+	if err := uc.detailRepo.DeleteByPayinFileID(ctx, payinFileID); err != nil {
+		return err
+	}
+	if err := uc.transactionRepo.DeleteByPayinFileID(ctx, payinFileID); err != nil {
+		return err
+	}
+	for _, repo := range uc.reportRepos {
+		if err := repo.DeleteByPayinFileID(ctx, payinFileID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordReimport records the reimport of the payin file in the audit log. userID is nil when no user is given.
+func (uc *PayinReimportUsecase) RecordReimport(ctx context.Context, payinFileID int, userID *int) error {
+	generator := auditLogModel.NewAuditLogGenerator(userID, auditLogObject.AuditLogTypePayinReimport, nil, nil)
+	generator.PayinID = &payinFileID
+	return uc.auditLogRepo.Create(ctx, generator.Generate())
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+)
+
+// Reasons recorded for the values that cannot be imported
+const (
+	reasonRequired      = "必須項目が空です"
+	reasonInvalidNumber = "数値として解釈できません"
+	reasonInvalidDate   = "日付として解釈できません"
+	reasonInvalidTime   = "日時として解釈できません"
+	reasonUnknownStatus = "未定義の取引ステータスです"
+
+	reasonInvalidPaymentDetail = "支払い詳細として解釈できません"
+	reasonUnknownPaymentMethod = "未定義の支払い方法です"
+	reasonAggregatedDetail     = "振込集計済みの明細のため上書きできません"
+)
+
+var (
+	dateLayouts     = []string{"2006-01-02", "2006/01/02"}
+	datetimeLayouts = []string{"2006/01/02 15:04:05", "2006-01-02 15:04:05", time.RFC3339}
+)
+
+// rowParser parses the values of a payin file row and keeps an import error for every value that cannot be
+// parsed. An invalid value is parsed as if it were empty. Values that are imported although they are not understood,
+// such as unknown payment methods, are kept as warnings.
+type rowParser struct {
+	payinFileID int
+	row         payinObject.PayinFileRow
+	errors      []*payinModel.PayinImportError
+}
+
+func newRowParser(payinFileID int, row payinObject.PayinFileRow) *rowParser {
+	return &rowParser{payinFileID: payinFileID, row: row}
+}
+
+// value returns the trimmed value of the column, empty when the row does not have the column
+func (p *rowParser) value(column string) string {
+	return strings.TrimSpace(p.row.Values[column])
+}
+
+// requiredValue returns the trimmed value of a column that must be set
+func (p *rowParser) requiredValue(column string) string {
+	s := p.value(column)
+	if s == "" {
+		p.fail(column, reasonRequired)
+	}
+	return s
+}
+
+// fail keeps an import error for the value of the column
+func (p *rowParser) fail(column, reason string) {
+	p.errors = append(p.errors, payinModel.NewPayinImportError(p.payinFileID, p.row.Line, column, p.row.Values[column], reason))
+}
+
+// warn keeps an import warning for the value of the column
+func (p *rowParser) warn(column, reason string) {
+	p.errors = append(p.errors, payinModel.NewPayinImportWarning(p.payinFileID, p.row.Line, column, p.row.Values[column], reason))
+}
+
+// float parses an amount, 0 when empty
+func (p *rowParser) float(column string) float64 {
+	s := p.value(column)
+	if s == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		p.fail(column, reasonInvalidNumber)
+		return 0
+	}
+	return f
+}
+
+// integer parses a count, 0 when empty
+func (p *rowParser) integer(column string) int {
+	s := p.value(column)
+	if s == "" {
+		return 0
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		p.fail(column, reasonInvalidNumber)
+		return 0
+	}
+	return i
+}
+
+// requiredFloat parses an amount that must be set
+func (p *rowParser) requiredFloat(column string) float64 {
+	if p.value(column) == "" {
+		p.fail(column, reasonRequired)
+		return 0
+	}
+	return p.float(column)
+}
+
+// date parses a yyyy-mm-dd or yyyy/mm/dd date, nil when empty
+func (p *rowParser) date(column string) *time.Time {
+	return p.parseTime(column, dateLayouts, reasonInvalidDate)
+}
+
+// requiredDate parses a date that must be set
+func (p *rowParser) requiredDate(column string) *time.Time {
+	if p.value(column) == "" {
+		p.fail(column, reasonRequired)
+		return nil
+	}
+	return p.date(column)
+}
+
+// datetime parses a date and time, nil when empty
+func (p *rowParser) datetime(column string) *time.Time {
+	return p.parseTime(column, datetimeLayouts, reasonInvalidTime)
+}
+
+func (p *rowParser) parseTime(column string, layouts []string, reason string) *time.Time {
+	s := p.value(column)
+	if s == "" {
+		return nil
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	p.fail(column, reason)
+	return nil
+}
+
+// transactionStatus maps a transaction status of the reports to PaypayTransactionStatus, nil when empty
+func (p *rowParser) transactionStatus(column string) *paypayObject.PaypayTransactionStatus {
+	s := p.value(column)
+	if s == "" {
+		return nil
+	}
+	status, ok := transactionStatuses[s]
+	if !ok {
+		p.fail(column, reasonUnknownStatus)
+		return nil
+	}
+	return &status
+}
+
+// paymentDetail parses the breakdown of a payment by payment method, nil when empty
+func (p *rowParser) paymentDetail(column string, parser *paypayService.PaymentDetailParseService, transactionAmount float64) *paypayModel.PaymentDetail {
+	detail, err := parser.Parse(p.value(column), transactionAmount)
+	if err != nil {
+		if errors.Is(err, paypayService.ErrUnknownPaymentMethod) {
+			p.warn(column, reasonUnknownPaymentMethod)
+		} else {
+			p.fail(column, reasonInvalidPaymentDetail)
+		}
+		return nil
+	}
+	return detail
+}
+
+// paymentMethod maps a payment method label of the reports to PaypayPaymentMethod, nil when empty or unknown
+func (p *rowParser) paymentMethod(column string, paymentMethods *paypayService.PaymentMethodMappingService) *paypayObject.PaypayPaymentMethod {
+	s := p.value(column)
+	if s == "" {
+		return nil
+	}
+	method, ok := paymentMethods.Map(s)
+	if !ok {
+		p.warn(column, reasonUnknownPaymentMethod)
+		return nil
+	}
+	return &method
+}
+
+// skipped reports whether the row is left out of the import under the policy
+func (p *rowParser) skipped(policy payinObject.PayinImportErrorPolicy) bool {
+	return hasErrors(p.errors) && policy == payinObject.PayinImportErrorPolicySkipRow
+}
+
+// rejected reports whether nothing of the file is imported under the policy
+func rejected(policy payinObject.PayinImportErrorPolicy, importErrors []*payinModel.PayinImportError) bool {
+	return hasErrors(importErrors) && policy == payinObject.PayinImportErrorPolicyRejectFile
+}
+
+// hasErrors reports whether there is an import error other than a warning
+func hasErrors(importErrors []*payinModel.PayinImportError) bool {
+	for _, importError := range importErrors {
+		if !importError.IsWarning() {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,11 @@ package usecase
 import (
 	"context"
 	"log"
-	"strconv"
-	"strings"
-	"time"
 
 	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
 	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
 	"github.com/huydq/test/internal/pkg/database"
 	"github.com/huydq/test/internal/pkg/logger"
 	"gorm.io/gorm"
@@ -28,77 +27,48 @@ func NewPayinSummaryUsecase(repo paypayRepo.PaypayPayinSummaryRepository, appLog
 	}
 }
 
-// parseFloatOrZero parses a string to float64, returning 0 if parsing fails
-func (uc *PayinSummaryUsecase) parseFloatOrZero(s string) float64 {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return 0
+// ProcessAndInsertSummaries parses the summary rows and inserts them into database. It returns an import error for
+// every value that cannot be parsed; the rows with errors are imported or skipped, or the whole file is rejected, per
+// policy.
+func (uc *PayinSummaryUsecase) ProcessAndInsertSummaries(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	var summaries []*paypayModel.PaypayPayinSummary
+	var importErrors []*payinModel.PayinImportError
+	for _, row := range rows {
+		p := newRowParser(payinFileID, row)
+		summary := &paypayModel.PaypayPayinSummary{
+			PayinFileID:       payinFileID,
+			TransactionAmount: p.float("transaction_amount"),
+			CorporateName:     row.Values["corporate_name"],
+			CutoffDate:        p.date("cutoff_date"),
+			PaymentDate:       p.date("payment_date"),
+			RefundAmount:      p.float("refund_amount"),
+			UsageFee:          p.float("usage_fee"),
+			PlatformFee:       p.float("platform_fee"),
+			InitialFee:        p.float("initial_fee"),
+			Tax:               p.float("tax"),
+			Cashback:          p.float("cashback"),
+			Adjustment:        p.float("adjustment"),
+			Fee:               p.float("fee"),
+			Amount:            p.float("amount"),
+		}
+		importErrors = append(importErrors, p.errors...)
+		if p.skipped(policy) {
+			continue
+		}
+		summaries = append(summaries, summary)
 	}
-	f, err := strconv.ParseFloat(s, 64)
-	if err != nil {
-		return 0
+	if rejected(policy, importErrors) || len(summaries) == 0 {
+		return importErrors, nil
 	}
-	return f
-}
 
-// ProcessAndInsertSummaries processes summary records and inserts them into database
-func (uc *PayinSummaryUsecase) ProcessAndInsertSummaries(ctx context.Context, payinFileID int, records []map[string]string) error {
 	tx, err := database.GetTxOrDB(ctx)
 	if err != nil {
 		uc.appLogger.ErrorWithContext("[PayinSummaryUsecase] Error getting transaction: %v", err)
-		return err
+		return nil, err
 	}
 
 	err = tx.Transaction(func(txCtx *gorm.DB) error {
-		var summaries []*paypayModel.PaypayPayinSummary
-		if len(records) > 0 {
-			record := records[0]
-			transactionAmount := uc.parseFloatOrZero(record["transaction_amount"])
-			refundAmount := uc.parseFloatOrZero(record["refund_amount"])
-			usageFee := uc.parseFloatOrZero(record["usage_fee"])
-			platformFee := uc.parseFloatOrZero(record["platform_fee"])
-			initialFee := uc.parseFloatOrZero(record["initial_fee"])
-			tax := uc.parseFloatOrZero(record["tax"])
-			cashback := uc.parseFloatOrZero(record["cashback"])
-			adjustment := uc.parseFloatOrZero(record["adjustment"])
-			fee := uc.parseFloatOrZero(record["fee"])
-			amount := uc.parseFloatOrZero(record["amount"])
-
-			// Defensive parse for cutoff_date
-			var cutoffDatePtr *time.Time
-			if s := strings.TrimSpace(record["cutoff_date"]); s != "" {
-				if d, err := time.Parse("2006-01-02", s); err == nil {
-					cutoffDatePtr = &d
-				}
-			}
-			// Defensive parse for payment_date
-			var paymentDatePtr *time.Time
-			if s := strings.TrimSpace(record["payment_date"]); s != "" {
-				if d, err := time.Parse("2006-01-02", s); err == nil {
-					paymentDatePtr = &d
-				}
-			}
-
-			summary := &paypayModel.PaypayPayinSummary{
-				PayinFileID:       payinFileID,
-				TransactionAmount: transactionAmount,
-				CorporateName:     record["corporate_name"],
-				CutoffDate:        cutoffDatePtr,
-				PaymentDate:       paymentDatePtr,
-				RefundAmount:      refundAmount,
-				UsageFee:          usageFee,
-				PlatformFee:       platformFee,
-				InitialFee:        initialFee,
-				Tax:               tax,
-				Cashback:          cashback,
-				Adjustment:        adjustment,
-				Fee:               fee,
-				Amount:            amount,
-			}
-			summaries = append(summaries, summary)
-		}
-
-		if err := uc.repo.BulkInsert(ctx, summaries); err != nil {
+		if err := uc.repo.BulkUpsert(ctx, summaries); err != nil {
 			log.Printf("[PayinSummaryUsecase] Error inserting summaries: %v", err)
 			return err
 		}
@@ -106,7 +76,7 @@ func (uc *PayinSummaryUsecase) ProcessAndInsertSummaries(ctx context.Context, pa
 	})
 	if err != nil {
 		uc.appLogger.ErrorWithContext("[PayinSummaryUsecase] Error in transaction: %v", err)
-		return err
+		return nil, err
 	}
-	return nil
-}
\ No newline at end of file
+	return importErrors, nil
+}
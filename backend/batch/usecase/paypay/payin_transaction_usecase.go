@@ -2,13 +2,13 @@ package usecase
 
 import (
 	"context"
-	"fmt"
-	"strconv"
 	"strings"
-	"time"
 
 	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
 	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
 	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
 	"github.com/huydq/test/internal/pkg/database"
 	"github.com/huydq/test/internal/pkg/logger"
@@ -17,118 +17,88 @@ import (
 
 // PayinTransactionUsecase handles business logic for payin transactions
 type PayinTransactionUsecase struct {
-	repo      paypayRepo.PaypayPayinTransactionRepository
-	appLogger logger.Logger
+	repo                paypayRepo.PaypayPayinTransactionRepository
+	paymentMethods      *paypayService.PaymentMethodMappingService
+	paymentDetailParser *paypayService.PaymentDetailParseService
+	appLogger           logger.Logger
 }
 
 // NewPayinTransactionUsecase creates a new instance of PayinTransactionUsecase
-func NewPayinTransactionUsecase(repo paypayRepo.PaypayPayinTransactionRepository, appLogger logger.Logger) *PayinTransactionUsecase {
+func NewPayinTransactionUsecase(repo paypayRepo.PaypayPayinTransactionRepository, paymentMethods *paypayService.PaymentMethodMappingService, paymentDetailParser *paypayService.PaymentDetailParseService, appLogger logger.Logger) *PayinTransactionUsecase {
 	return &PayinTransactionUsecase{
-		repo:      repo,
-		appLogger: appLogger,
+		repo:                repo,
+		paymentMethods:      paymentMethods,
+		paymentDetailParser: paymentDetailParser,
+		appLogger:           appLogger,
 	}
 }
 
-// parseDatetime parses a datetime string into a *time.Time using multiple formats
-func (uc *PayinTransactionUsecase) parseDatetime(val string) (*time.Time, error) {
-	formats := []string{
-		"2006/01/02 15:04:05",
-		"2006-01-02 15:04:05",
-		time.RFC3339,
-	}
-	val = strings.TrimSpace(val)
-	if val == "" {
-		return nil, nil
-	}
-	for _, f := range formats {
-		t, err := time.Parse(f, val)
-		if err == nil {
-			return &t, nil
+// transactionStatuses maps the transaction statuses of the reports to PaypayTransactionStatus
+var transactionStatuses = map[string]paypayObject.PaypayTransactionStatus{
+	"取引完了":   paypayObject.TransactionComplete,
+	"取引受付完了": paypayObject.TransactionAccepted,
+	"返金完了":   paypayObject.RefundComplete,
+	"取引取消":   paypayObject.TransactionCancelled,
+	"取引受付取消": paypayObject.TransactionAcceptCancelled,
+	"調整":     paypayObject.Adjustment,
+	"送金完了":   paypayObject.RemittanceComplete,
+}
+
+// ProcessAndInsertTransactions parses the transaction rows and inserts them into database. It returns an import error
+// for every value that cannot be parsed, including unknown statuses; the rows with errors are imported or skipped, or
+// the whole file is rejected, per policy. Unknown payment methods are only warned about.
+func (uc *PayinTransactionUsecase) ProcessAndInsertTransactions(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	// Helper to get pointer to string
+	strPtr := func(s string) *string { s = strings.TrimSpace(s); return &s }
+
+	var transactions []*paypayModel.PaypayPayinTransaction
+	var importErrors []*payinModel.PayinImportError
+	for _, row := range rows {
+		p := newRowParser(payinFileID, row)
+
+		// payment_transaction_id
+		var paymentTransactionID *string
+		if ptidStr := p.value("payment_transaction_id"); ptidStr != "" {
+			paymentTransactionID = strPtr(ptidStr)
 		}
+
+		transactionAmount := p.float("transaction_amount")
+		transaction := &paypayModel.PaypayPayinTransaction{
+			PayinFileID:              payinFileID,
+			PaymentTransactionID:     paymentTransactionID,
+			SSID:                     paymentTransactionID,
+			PaymentMerchantID:        strPtr(row.Values["payment_merchant_id"]),
+			MerchantBusinessName:     strPtr(row.Values["merchant_business_name"]),
+			ShopID:                   strPtr(row.Values["shop_id"]),
+			ShopName:                 strPtr(row.Values["shop_name"]),
+			TerminalCode:             strPtr(row.Values["terminal_code"]),
+			PaymentTransactionStatus: p.transactionStatus("payment_transaction_status"),
+			TransactionAt:            p.datetime("transaction_at"),
+			TransactionAmount:        &transactionAmount,
+			ReceiptNumber:            strPtr(row.Values["receipt_number"]),
+			PaypayPaymentMethod:      p.paymentMethod("paypay_payment_method", uc.paymentMethods),
+			PaypayPaymentMethodLabel: strPtr(row.Values["paypay_payment_method"]),
+			MerchantOrderID:          strPtr(row.Values["merchant_order_id"]),
+			PaymentDetail:            p.paymentDetail("payment_detail", uc.paymentDetailParser, transactionAmount),
+		}
+		importErrors = append(importErrors, p.errors...)
+		if p.skipped(policy) {
+			continue
+		}
+		transactions = append(transactions, transaction)
+	}
+	if rejected(policy, importErrors) || len(transactions) == 0 {
+		return importErrors, nil
 	}
-	return nil, fmt.Errorf("cannot parse datetime: %s", val)
-}
 
-// ProcessAndInsertTransactions processes transaction records and inserts them into database
-func (uc *PayinTransactionUsecase) ProcessAndInsertTransactions(ctx context.Context, payinFileID int, records []map[string]string) error {
 	tx, err := database.GetTxOrDB(ctx)
 	if err != nil {
 		uc.appLogger.ErrorWithContext("[PayinTransactionUsecase] Error getting transaction: %v", err)
-		return err
+		return nil, err
 	}
 
 	err = tx.Transaction(func(txCtx *gorm.DB) error {
-		var transactions []*paypayModel.PaypayPayinTransaction
-		for _, record := range records {
-
-			// Helper to get pointer to string
-			strPtr := func(s string) *string { s = strings.TrimSpace(s); return &s }
-
-			// payment_transaction_id
-			ptidStr := strings.TrimSpace(record["payment_transaction_id"])
-			var paymentTransactionID *string
-			if ptidStr != "" {
-				paymentTransactionID = strPtr(ptidStr)
-			}
-
-			// transaction_amount
-			transactionAmount, _ := strconv.ParseFloat(strings.TrimSpace(record["transaction_amount"]), 64)
-
-			// transaction_status (string mapping)
-			statusStr := strings.TrimSpace(record["payment_transaction_status"])
-			var transactionStatus *paypayObject.PaypayTransactionStatus
-			if statusStr != "" {
-				switch statusStr {
-				case "取引完了":
-					val := paypayObject.TransactionComplete
-					transactionStatus = &val
-				case "取引受付完了":
-					val := paypayObject.TransactionAccepted
-					transactionStatus = &val
-				case "返金完了":
-					val := paypayObject.RefundComplete
-					transactionStatus = &val
-				case "取引取消":
-					val := paypayObject.TransactionCancelled
-					transactionStatus = &val
-				case "取引受付取消":
-					val := paypayObject.TransactionAcceptCancelled
-					transactionStatus = &val
-				case "調整":
-					val := paypayObject.Adjustment
-					transactionStatus = &val
-				case "送金完了":
-					val := paypayObject.RemittanceComplete
-					transactionStatus = &val
-				default:
-					transactionStatus = nil
-				}
-			}
-			transactionAt, _ := uc.parseDatetime(record["transaction_at"])
-			transaction := &paypayModel.PaypayPayinTransaction{
-				PayinFileID:              payinFileID,
-				PaymentTransactionID:     paymentTransactionID,
-				SSID:                     paymentTransactionID,
-				PaymentMerchantID:        strPtr(record["payment_merchant_id"]),
-				MerchantBusinessName:     strPtr(record["merchant_business_name"]),
-				ShopID:                   strPtr(record["shop_id"]),
-				ShopName:                 strPtr(record["shop_name"]),
-				TerminalCode:             strPtr(record["terminal_code"]),
-				PaymentTransactionStatus: transactionStatus,
-				TransactionAt:            transactionAt,
-				TransactionAmount:        &transactionAmount,
-				ReceiptNumber:            strPtr(record["receipt_number"]),
-				PaypayPaymentMethod:      record["paypay_payment_method"],
-				MerchantOrderID:          strPtr(record["merchant_order_id"]),
-			}
-			transactions = append(transactions, transaction)
-		}
-
-		if len(transactions) == 0 {
-			return nil
-		}
-
-		err := uc.repo.BulkInsert(ctx, transactions)
+		err := uc.repo.BulkUpsert(ctx, transactions)
 		if err != nil {
 			uc.appLogger.ErrorWithContext("[PayinTransactionUsecase] Error inserting transactions: %v", err)
 			return err
@@ -136,6 +106,8 @@ func (uc *PayinTransactionUsecase) ProcessAndInsertTransactions(ctx context.Cont
 
 		return nil
 	})
-	
-	return err
-}
\ No newline at end of file
+	if err != nil {
+		return nil, err
+	}
+	return importErrors, nil
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	paypayPersistence "github.com/huydq/test/batch/infrastructure/persistence/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+	"github.com/huydq/test/internal/pkg/database"
+	"github.com/huydq/test/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var errDryRun = errors.New("no statement is executed in a dry run")
+
+// dryRunConnPool is a connection that can begin transactions but never runs a statement, for the dry run DB
+type dryRunConnPool struct{}
+
+func (*dryRunConnPool) PrepareContext(context.Context, string) (*sql.Stmt, error) {
+	return nil, errDryRun
+}
+
+func (*dryRunConnPool) ExecContext(context.Context, string, ...any) (sql.Result, error) {
+	return nil, errDryRun
+}
+
+func (*dryRunConnPool) QueryContext(context.Context, string, ...any) (*sql.Rows, error) {
+	return nil, errDryRun
+}
+
+func (*dryRunConnPool) QueryRowContext(context.Context, string, ...any) *sql.Row {
+	return nil
+}
+
+func (p *dryRunConnPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	return p, nil
+}
+
+func (*dryRunConnPool) Commit() error {
+	return nil
+}
+
+func (*dryRunConnPool) Rollback() error {
+	return nil
+}
+
+// capturedStatement is an INSERT built by the dry run DB
+type capturedStatement struct {
+	SQL  string
+	Vars []any
+}
+
+// newDryRunContext returns a context carrying a MySQL DB that builds the statements without running them, and the
+// INSERT statements it built
+func newDryRunContext(t *testing.T) (context.Context, *[]capturedStatement) {
+	t.Helper()
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: &dryRunConnPool{}, SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+
+	var statements []capturedStatement
+	err = db.Callback().Create().After("gorm:create").Register("test:capture", func(db *gorm.DB) {
+		statements = append(statements, capturedStatement{SQL: db.Statement.SQL.String(), Vars: db.Statement.Vars})
+	})
+	require.NoError(t, err)
+
+	ctx, err := database.SetDB(context.Background(), db)
+	require.NoError(t, err)
+	return ctx, &statements
+}
+
+// insertedRows maps the values of every row of an INSERT statement by column
+func insertedRows(t *testing.T, statement capturedStatement) []map[string]any {
+	t.Helper()
+
+	start := strings.Index(statement.SQL, "(")
+	end := strings.Index(statement.SQL, ") VALUES")
+	require.True(t, start >= 0 && end > start, "not an INSERT: %s", statement.SQL)
+
+	columns := strings.Split(strings.ReplaceAll(statement.SQL[start+1:end], "`", ""), ",")
+	require.Zero(t, len(statement.Vars)%len(columns))
+
+	var rows []map[string]any
+	for i := 0; i < len(statement.Vars); i += len(columns) {
+		row := make(map[string]any, len(columns))
+		for j, column := range columns {
+			row[column] = statement.Vars[i+j]
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func newTestTransactionRows() []payinObject.PayinFileRow {
+	row := func(line int, status string) payinObject.PayinFileRow {
+		return payinObject.PayinFileRow{Line: line, Values: map[string]string{
+			"payment_transaction_id":     "04123456789012345678",
+			"payment_merchant_id":        "M1",
+			"merchant_business_name":     "テスト商事",
+			"payment_transaction_status": status,
+			"transaction_at":             "2025/06/10 10:00:00",
+			"transaction_amount":         "1000",
+			"paypay_payment_method":      "残高",
+		}}
+	}
+	// A refunded payment is reported as two rows with the same transaction ID
+	return []payinObject.PayinFileRow{row(2, "取引完了"), row(3, "返金完了")}
+}
+
+func TestPayinTransactionUsecase_ProcessAndInsertTransactionsTwice(t *testing.T) {
+	ctx, statements := newDryRunContext(t)
+	paymentMethods := paypayService.NewPaymentMethodMappingService(nil)
+	uc := NewPayinTransactionUsecase(
+		paypayPersistence.NewPayinTransactionRepository(nil),
+		paymentMethods,
+		paypayService.NewPaymentDetailParseService(paymentMethods),
+		logger.InitCLILogger(&logger.CLILoggerConfig{LogLevel: "fatal", LogDirectory: t.TempDir()}),
+	)
+
+	// The same rows imported by two imports of the file
+	for i := 0; i < 2; i++ {
+		importErrors, err := uc.ProcessAndInsertTransactions(ctx, 1, newTestTransactionRows(), payinObject.PayinImportErrorPolicyRejectFile)
+		require.NoError(t, err)
+		assert.Empty(t, importErrors)
+	}
+
+	require.Len(t, *statements, 2)
+	first, second := (*statements)[0], (*statements)[1]
+
+	t.Run("the rows are upserted on the unique key of the transaction ID and status", func(t *testing.T) {
+		assert.Contains(t, first.SQL, "ON DUPLICATE KEY UPDATE")
+		updates := first.SQL[strings.Index(first.SQL, "ON DUPLICATE KEY UPDATE"):]
+		assert.NotContains(t, updates, "`payment_transaction_id`")
+		assert.NotContains(t, updates, "`payment_transaction_status`")
+		assert.Equal(t, first.SQL, second.SQL)
+	})
+
+	t.Run("both imports store the status of every row", func(t *testing.T) {
+		wantStatuses := []paypayObject.PaypayTransactionStatus{paypayObject.TransactionComplete, paypayObject.RefundComplete}
+		for _, statement := range []capturedStatement{first, second} {
+			rows := insertedRows(t, statement)
+			require.Len(t, rows, 2)
+			for i, row := range rows {
+				status, ok := row["payment_transaction_status"].(*paypayObject.PaypayTransactionStatus)
+				require.True(t, ok, "payment_transaction_status is %T", row["payment_transaction_status"])
+				require.NotNil(t, status, "row %d has no status, it would not conflict with the row of the first import", i)
+				assert.Equal(t, wantStatuses[i], *status)
+				assert.Equal(t, "04123456789012345678", *row["payment_transaction_id"].(*string))
+			}
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+
+	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+	"github.com/huydq/test/internal/pkg/database"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// PaymentMethodBackfillResult is the outcome of a backfill of the payment methods
+type PaymentMethodBackfillResult struct {
+	// Number of rows a payment method was set on, or would be on a dry run
+	Updated int64
+	// Number of rows left without a payment method per label that is not mapped
+	Unmapped map[string]int64
+	// Payin files of the transactions imported before the labels were kept, whose payment methods can only be set by
+	// importing the files again
+	UnlabeledPayinFileIDs []int
+}
+
+// PaymentMethodBackfillUsecase sets the payment method of the imported transactions and transaction details from the
+// payment method label of the reports, for the rows imported before the labels were mapped or while a label was not.
+// The transactions imported before their labels were kept have no label to map, their payin files are listed instead.
+type PaymentMethodBackfillUsecase struct {
+	transactionRepo       paypayRepo.PaypayPayinTransactionRepository
+	transactionDetailRepo paypayRepo.PaypayTransactionDetailRepository
+	paymentMethods        *paypayService.PaymentMethodMappingService
+	appLogger             logger.Logger
+}
+
+// NewPaymentMethodBackfillUsecase creates a new instance of PaymentMethodBackfillUsecase
+func NewPaymentMethodBackfillUsecase(
+	transactionRepo paypayRepo.PaypayPayinTransactionRepository,
+	transactionDetailRepo paypayRepo.PaypayTransactionDetailRepository,
+	paymentMethods *paypayService.PaymentMethodMappingService,
+	appLogger logger.Logger,
+) *PaymentMethodBackfillUsecase {
+	return &PaymentMethodBackfillUsecase{
+		transactionRepo:       transactionRepo,
+		transactionDetailRepo: transactionDetailRepo,
+		paymentMethods:        paymentMethods,
+		appLogger:             appLogger,
+	}
+}
+
+// paymentMethodBackfillTarget is a table with a payment method and the payment method label it is mapped from
+type paymentMethodBackfillTarget interface {
+	CountUnmappedPaymentMethodLabels(ctx context.Context) (map[string]int64, error)
+	UpdatePaymentMethodByLabel(ctx context.Context, label string, method paypayObject.PaypayPaymentMethod) (int64, error)
+}
+
+// Backfill maps the labels of the rows without a payment method in a single database transaction. On a dry run
+// nothing is updated.
+func (uc *PaymentMethodBackfillUsecase) Backfill(ctx context.Context, dryRun bool) (*PaymentMethodBackfillResult, error) {
+	result := &PaymentMethodBackfillResult{Unmapped: make(map[string]int64)}
+
+	tx, err := database.NewTx[any](ctx)
+	if err != nil {
+		uc.appLogger.ErrorWithContext("[PaymentMethodBackfillUsecase] Error starting transaction: %v", err)
+		return nil, err
+	}
+	_, err = tx.Transact(ctx, func(ctx context.Context) (any, error) {
+		for _, target := range []paymentMethodBackfillTarget{uc.transactionRepo, uc.transactionDetailRepo} {
+			if err := uc.backfill(ctx, target, dryRun, result); err != nil {
+				return nil, err
+			}
+		}
+
+		payinFileIDs, err := uc.transactionRepo.ListPayinFileIDsWithoutPaymentMethodLabel(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.UnlabeledPayinFileIDs = payinFileIDs
+		return nil, nil
+	})
+	if err != nil {
+		uc.appLogger.ErrorWithContext("[PaymentMethodBackfillUsecase] Error backfilling payment methods: %v", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (uc *PaymentMethodBackfillUsecase) backfill(ctx context.Context, target paymentMethodBackfillTarget, dryRun bool, result *PaymentMethodBackfillResult) error {
+	counts, err := target.CountUnmappedPaymentMethodLabels(ctx)
+	if err != nil {
+		return err
+	}
+
+	for label, count := range counts {
+		method, ok := uc.paymentMethods.Map(label)
+		if !ok {
+			result.Unmapped[label] += count
+			continue
+		}
+		if dryRun {
+			result.Updated += count
+			continue
+		}
+
+		updated, err := target.UpdatePaymentMethodByLabel(ctx, label, method)
+		if err != nil {
+			return err
+		}
+		result.Updated += updated
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+	"github.com/huydq/test/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePaymentMethodTable keeps the rows without a payment method per label and the payment methods set on them
+type fakePaymentMethodTable struct {
+	unmapped     map[string]int64
+	updated      map[string]paypayObject.PaypayPaymentMethod
+	payinFileIDs []int
+}
+
+func (r *fakePaymentMethodTable) CountUnmappedPaymentMethodLabels(context.Context) (map[string]int64, error) {
+	return r.unmapped, nil
+}
+
+func (r *fakePaymentMethodTable) UpdatePaymentMethodByLabel(_ context.Context, label string, method paypayObject.PaypayPaymentMethod) (int64, error) {
+	if r.updated == nil {
+		r.updated = make(map[string]paypayObject.PaypayPaymentMethod)
+	}
+	r.updated[label] = method
+	return r.unmapped[label], nil
+}
+
+func (r *fakePaymentMethodTable) ListPayinFileIDsWithoutPaymentMethodLabel(context.Context) ([]int, error) {
+	return r.payinFileIDs, nil
+}
+
+func (r *fakePaymentMethodTable) BulkUpsert(context.Context, []*paypayModel.PaypayPayinTransaction) error {
+	return nil
+}
+
+func (r *fakePaymentMethodTable) DeleteByPayinFileID(context.Context, int) error {
+	return nil
+}
+
+// fakePaymentMethodDetailTable is the transaction detail table of the backfill
+type fakePaymentMethodDetailTable struct {
+	fakePaymentMethodTable
+}
+
+func (r *fakePaymentMethodDetailTable) BulkUpsert(context.Context, []*paypayModel.PaypayTransactionDetail) error {
+	return nil
+}
+
+func newTestPaymentMethodBackfillUsecase(t *testing.T, transactions *fakePaymentMethodTable, details *fakePaymentMethodDetailTable) *PaymentMethodBackfillUsecase {
+	t.Helper()
+
+	return NewPaymentMethodBackfillUsecase(
+		transactions,
+		details,
+		paypayService.NewPaymentMethodMappingService(nil),
+		logger.InitCLILogger(&logger.CLILoggerConfig{LogLevel: "fatal", LogDirectory: t.TempDir()}),
+	)
+}
+
+func TestPaymentMethodBackfillUsecase_Backfill(t *testing.T) {
+	ctx, _ := newDryRunContext(t)
+	transactions := &fakePaymentMethodTable{
+		unmapped:     map[string]int64{"PayPay残高": 3, "謎の支払い": 1},
+		payinFileIDs: []int{4, 7},
+	}
+	details := &fakePaymentMethodDetailTable{fakePaymentMethodTable{unmapped: map[string]int64{"PayPayポイント": 2}}}
+	uc := newTestPaymentMethodBackfillUsecase(t, transactions, details)
+
+	result, err := uc.Backfill(ctx, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), result.Updated)
+	assert.Equal(t, map[string]int64{"謎の支払い": 1}, result.Unmapped)
+	assert.Equal(t, []int{4, 7}, result.UnlabeledPayinFileIDs, "the files imported without labels are listed for a reimport")
+	assert.Equal(t, map[string]paypayObject.PaypayPaymentMethod{"PayPay残高": paypayObject.PaymentMethodPayPayBalance}, transactions.updated)
+	assert.Equal(t, map[string]paypayObject.PaypayPaymentMethod{"PayPayポイント": paypayObject.PaymentMethodPayPayPoint}, details.updated)
+}
+
+func TestPaymentMethodBackfillUsecase_BackfillDryRun(t *testing.T) {
+	ctx, _ := newDryRunContext(t)
+	transactions := &fakePaymentMethodTable{unmapped: map[string]int64{"PayPay残高": 3}, payinFileIDs: []int{4}}
+	details := &fakePaymentMethodDetailTable{}
+	uc := newTestPaymentMethodBackfillUsecase(t, transactions, details)
+
+	result, err := uc.Backfill(ctx, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Updated)
+	assert.Equal(t, []int{4}, result.UnlabeledPayinFileIDs)
+	assert.Nil(t, transactions.updated, "nothing is updated on a dry run")
+}
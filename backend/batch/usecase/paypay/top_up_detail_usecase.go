@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// TopUpDetailUsecase handles business logic for the top-up details
+type TopUpDetailUsecase struct {
+	repo      paypayRepo.PaypayTopUpDetailRepository
+	appLogger logger.Logger
+}
+
+// NewTopUpDetailUsecase creates a new instance of TopUpDetailUsecase
+func NewTopUpDetailUsecase(repo paypayRepo.PaypayTopUpDetailRepository, appLogger logger.Logger) *TopUpDetailUsecase {
+	return &TopUpDetailUsecase{
+		repo:      repo,
+		appLogger: appLogger,
+	}
+}
+
+// ProcessAndInsertTopUpDetails parses the rows of a top-up details report and upserts them. It returns an import
+// error for every value that cannot be parsed; the rows with errors are imported or skipped, or the whole file is
+// rejected, per policy.
+func (uc *TopUpDetailUsecase) ProcessAndInsertTopUpDetails(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	var details []*paypayModel.PaypayTopUpDetail
+	var importErrors []*payinModel.PayinImportError
+	for _, row := range rows {
+		p := newRowParser(payinFileID, row)
+		detail := &paypayModel.PaypayTopUpDetail{
+			PayinFileID:          payinFileID,
+			PaymentMerchantID:    p.requiredValue("payment_merchant_id"),
+			MerchantBusinessName: p.value("merchant_business_name"),
+			CutoffDate:           p.requiredDate("cutoff_date"),
+			PaymentDate:          p.requiredDate("payment_date"),
+			Amount:               p.requiredFloat("amount"),
+			BankName:             p.value("bank_name"),
+			BranchName:           p.value("branch_name"),
+			AccountType:          p.value("account_type"),
+			AccountNumber:        p.value("account_number"),
+			AccountHolder:        p.value("account_holder"),
+		}
+		importErrors = append(importErrors, p.errors...)
+		if p.skipped(policy) {
+			continue
+		}
+		details = append(details, detail)
+	}
+	if rejected(policy, importErrors) || len(details) == 0 {
+		return importErrors, nil
+	}
+
+	if err := uc.repo.BulkUpsert(ctx, details); err != nil {
+		uc.appLogger.ErrorWithContext("[TopUpDetailUsecase] Error inserting top-up details: %v", err)
+		return nil, err
+	}
+	return importErrors, nil
+}
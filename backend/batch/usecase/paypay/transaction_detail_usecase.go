@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	paypayService "github.com/huydq/test/batch/domain/service/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// TransactionDetailUsecase handles business logic for the transaction details, with and without shipping
+type TransactionDetailUsecase struct {
+	repo           paypayRepo.PaypayTransactionDetailRepository
+	paymentMethods *paypayService.PaymentMethodMappingService
+	appLogger      logger.Logger
+}
+
+// NewTransactionDetailUsecase creates a new instance of TransactionDetailUsecase
+func NewTransactionDetailUsecase(repo paypayRepo.PaypayTransactionDetailRepository, paymentMethods *paypayService.PaymentMethodMappingService, appLogger logger.Logger) *TransactionDetailUsecase {
+	return &TransactionDetailUsecase{
+		repo:           repo,
+		paymentMethods: paymentMethods,
+		appLogger:      appLogger,
+	}
+}
+
+// ProcessAndInsertTransactionDetails parses the rows of a transaction details report without shipping and upserts them
+func (uc *TransactionDetailUsecase) ProcessAndInsertTransactionDetails(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	return uc.processAndInsert(ctx, payinFileID, rows, policy, false)
+}
+
+// ProcessAndInsertShippingTransactionDetails parses the rows of a shipping related transaction details report, with
+// their shipping fee and shipping time, and upserts them
+func (uc *TransactionDetailUsecase) ProcessAndInsertShippingTransactionDetails(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	return uc.processAndInsert(ctx, payinFileID, rows, policy, true)
+}
+
+// processAndInsert returns an import error for every value that cannot be parsed; the rows with errors are imported
+// or skipped, or the whole file is rejected, per policy. Unknown payment methods are only warned about.
+func (uc *TransactionDetailUsecase) processAndInsert(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy, shippingRelated bool) ([]*payinModel.PayinImportError, error) {
+	var details []*paypayModel.PaypayTransactionDetail
+	var importErrors []*payinModel.PayinImportError
+	for _, row := range rows {
+		p := newRowParser(payinFileID, row)
+		detail := &paypayModel.PaypayTransactionDetail{
+			PayinFileID:              payinFileID,
+			ShippingRelated:          shippingRelated,
+			PaymentTransactionID:     p.requiredValue("payment_transaction_id"),
+			PaymentMerchantID:        p.value("payment_merchant_id"),
+			MerchantBusinessName:     p.value("merchant_business_name"),
+			ShopID:                   p.value("shop_id"),
+			ShopName:                 p.value("shop_name"),
+			TerminalCode:             p.value("terminal_code"),
+			PaymentTransactionStatus: p.transactionStatus("payment_transaction_status"),
+			TransactionAt:            p.datetime("transaction_at"),
+			TransactionAmount:        p.requiredFloat("transaction_amount"),
+			ReceiptNumber:            p.value("receipt_number"),
+			PaypayPaymentMethod:      p.paymentMethod("paypay_payment_method", uc.paymentMethods),
+			PaypayPaymentMethodLabel: p.value("paypay_payment_method"),
+			MerchantOrderID:          p.value("merchant_order_id"),
+		}
+		if shippingRelated {
+			shippingFee := p.float("shipping_fee")
+			detail.ShippingFee = &shippingFee
+			detail.ShippedAt = p.datetime("shipped_at")
+		}
+		importErrors = append(importErrors, p.errors...)
+		if p.skipped(policy) {
+			continue
+		}
+		details = append(details, detail)
+	}
+	if rejected(policy, importErrors) || len(details) == 0 {
+		return importErrors, nil
+	}
+
+	if err := uc.repo.BulkUpsert(ctx, details); err != nil {
+		uc.appLogger.ErrorWithContext("[TransactionDetailUsecase] Error inserting transaction details: %v", err)
+		return nil, err
+	}
+	return importErrors, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	paypayRepo "github.com/huydq/test/batch/domain/repository/paypay"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	payinObject "github.com/huydq/test/internal/domain/object/payin"
+	"github.com/huydq/test/internal/pkg/logger"
+)
+
+// TransactionSummaryUsecase handles business logic for the transaction summaries
+type TransactionSummaryUsecase struct {
+	repo      paypayRepo.PaypayTransactionSummaryRepository
+	appLogger logger.Logger
+}
+
+// NewTransactionSummaryUsecase creates a new instance of TransactionSummaryUsecase
+func NewTransactionSummaryUsecase(repo paypayRepo.PaypayTransactionSummaryRepository, appLogger logger.Logger) *TransactionSummaryUsecase {
+	return &TransactionSummaryUsecase{
+		repo:      repo,
+		appLogger: appLogger,
+	}
+}
+
+// ProcessAndInsertTransactionSummaries parses the rows of a transaction details summary report and upserts them. It
+// returns an import error for every value that cannot be parsed; the rows with errors are imported or skipped, or the
+// whole file is rejected, per policy.
+func (uc *TransactionSummaryUsecase) ProcessAndInsertTransactionSummaries(ctx context.Context, payinFileID int, rows []payinObject.PayinFileRow, policy payinObject.PayinImportErrorPolicy) ([]*payinModel.PayinImportError, error) {
+	var summaries []*paypayModel.PaypayTransactionSummary
+	var importErrors []*payinModel.PayinImportError
+	for _, row := range rows {
+		p := newRowParser(payinFileID, row)
+		summary := &paypayModel.PaypayTransactionSummary{
+			PayinFileID:          payinFileID,
+			PaymentMerchantID:    p.requiredValue("payment_merchant_id"),
+			MerchantBusinessName: p.value("merchant_business_name"),
+			TransactionDate:      p.requiredDate("transaction_date"),
+			TransactionCount:     p.integer("transaction_count"),
+			TransactionAmount:    p.float("transaction_amount"),
+			RefundCount:          p.integer("refund_count"),
+			RefundAmount:         p.float("refund_amount"),
+			UsageFee:             p.float("usage_fee"),
+		}
+		importErrors = append(importErrors, p.errors...)
+		if p.skipped(policy) {
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	if rejected(policy, importErrors) || len(summaries) == 0 {
+		return importErrors, nil
+	}
+
+	if err := uc.repo.BulkUpsert(ctx, summaries); err != nil {
+		uc.appLogger.ErrorWithContext("[TransactionSummaryUsecase] Error inserting transaction summaries: %v", err)
+		return nil, err
+	}
+	return importErrors, nil
+}
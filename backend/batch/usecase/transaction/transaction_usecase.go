@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	repository "github.com/huydq/test/batch/domain/repository/transaction"
+	model "github.com/huydq/test/internal/domain/model/transaction"
+)
+
+type TransactionUsecase struct {
+	transactionRepo       repository.TransactionRepository
+	transactionRecordRepo repository.TransactionRecordRepository
+}
+
+func NewTransactionUsecase(transactionRepo repository.TransactionRepository, transactionRecordRepo repository.TransactionRecordRepository) *TransactionUsecase {
+	return &TransactionUsecase{
+		transactionRepo:       transactionRepo,
+		transactionRecordRepo: transactionRecordRepo,
+	}
+}
+
+// CreateTransaction creates a transaction together with its records
+func (uc *TransactionUsecase) CreateTransaction(ctx context.Context, transaction *model.Transaction) error {
+	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+		return err
+	}
+	return uc.transactionRecordRepo.BulkInsert(ctx, transaction.TransactionRecords)
+}
+
+// LinkPayoutRecord links a transaction to the payout record it is paid out by
+func (uc *TransactionUsecase) LinkPayoutRecord(ctx context.Context, transaction *model.Transaction, payoutRecordID int) error {
+	transaction.PayoutRecordID = payoutRecordID
+	return uc.transactionRepo.UpdatePayoutRecordID(ctx, transaction)
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/huydq/test/batch/infrastructure/adapter/aozora"
+	"github.com/huydq/test/internal/pkg/config"
+)
+
+// Runs the in-memory fake of the Aozora Net Bank transfer API for local development.
+// Point AOZORA_API_BASE_URL at the listen address to use it from the batch commands.
+func main() {
+	addr := flag.String("addr", ":18080", "listen address")
+	flag.Parse()
+
+	appConfig := config.GetConfig()
+	bank := aozora.NewFakeBankServer(appConfig.AozoraAccessToken, appConfig.AozoraAccountID)
+
+	log.Printf("fake aozora bank listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, bank); err != nil {
+		log.Fatalf("fake aozora bank stopped: %v", err)
+	}
+}
@@ -9,8 +9,15 @@ import (
 	merchantController "github.com/huydq/test/internal/controller/merchant"
 	"github.com/huydq/test/internal/controller/user"
 	internalEmail "github.com/huydq/test/internal/infrastructure/adapter/email"
+	approvalPersistence "github.com/huydq/test/internal/infrastructure/persistence/approval"
+	approvalStagePersistence "github.com/huydq/test/internal/infrastructure/persistence/approval_stage"
+	approvalWorkflowPersistence "github.com/huydq/test/internal/infrastructure/persistence/approval_workflow"
+	approvalWorkflowStagePersistence "github.com/huydq/test/internal/infrastructure/persistence/approval_workflow_stage"
 	auditLogPersistence "github.com/huydq/test/internal/infrastructure/persistence/audit_log"
+	batchJobRunPersistence "github.com/huydq/test/internal/infrastructure/persistence/batch_job_run"
+	invoicePersistence "github.com/huydq/test/internal/infrastructure/persistence/invoice"
 	merchantPersistence "github.com/huydq/test/internal/infrastructure/persistence/merchant"
+	payinFilePersistence "github.com/huydq/test/internal/infrastructure/persistence/payin"
 	payoutPersistence "github.com/huydq/test/internal/infrastructure/persistence/payout"
 	payoutRecordPersistence "github.com/huydq/test/internal/infrastructure/persistence/payout_record"
 	permissionPersistence "github.com/huydq/test/internal/infrastructure/persistence/permission"
@@ -21,10 +28,14 @@ import (
 	"github.com/joho/godotenv"
 
 	auditLogController "github.com/huydq/test/internal/controller/audit_log"
+	batchJobRunController "github.com/huydq/test/internal/controller/batch_job_run"
+	invoiceController "github.com/huydq/test/internal/controller/invoice"
+	payinFileController "github.com/huydq/test/internal/controller/payin_file"
 	payoutController "github.com/huydq/test/internal/controller/payout"
 	permissionController "github.com/huydq/test/internal/controller/permission"
 	roleController "github.com/huydq/test/internal/controller/role"
 
+	payoutObject "github.com/huydq/test/internal/domain/object/payout"
 	"github.com/huydq/test/internal/domain/service"
 	accessTokenDomainService "github.com/huydq/test/internal/domain/service/auth"
 	twoFactorTokenDomainService "github.com/huydq/test/internal/domain/service/auth"
@@ -37,13 +48,19 @@ import (
 	"github.com/huydq/test/internal/server/router"
 	auditLogUsecase "github.com/huydq/test/internal/usecase/audit_log"
 	authUC "github.com/huydq/test/internal/usecase/auth"
+	batchJobRunUsecase "github.com/huydq/test/internal/usecase/batch_job_run"
+	invoiceUsecase "github.com/huydq/test/internal/usecase/invoice"
 	merchantUC "github.com/huydq/test/internal/usecase/merchant"
+	payinFileUsecase "github.com/huydq/test/internal/usecase/payin_file"
 	payoutUsecase "github.com/huydq/test/internal/usecase/payout"
+	payoutApprovalUsecase "github.com/huydq/test/internal/usecase/payout_approval"
 	permissionUsecase "github.com/huydq/test/internal/usecase/permission"
 	roleUsecase "github.com/huydq/test/internal/usecase/role"
 	userUC "github.com/huydq/test/internal/usecase/user"
 
 	authService "github.com/huydq/test/internal/infrastructure/adapter/auth"
+
+	storage "github.com/huydq/test/batch/infrastructure/adapter/storage"
 )
 
 func init() {
@@ -86,9 +103,17 @@ func main() {
 	internalAuditLogRepo := auditLogPersistence.NewAuditLogRepository(db)
 	internalTwoFactorRepo := twoFactorPersistence.NewTwoFactorTokenRepository(db)
 	internalMerchantRepo := merchantPersistence.NewMerchantRepository(db)
+	internalMerchantBankAccountRepo := merchantPersistence.NewMerchantBankAccountRepository(db)
 	internalPayoutRepo := payoutPersistence.NewPayoutRepository(db)
 	internalPayoutRecordRepo := payoutRecordPersistence.NewPayoutRecordRepository(db)
 	internalTokenRepo := tokenPersistence.NewTokenRepository(db)
+	internalApprovalRepo := approvalPersistence.NewApprovalRepository(db)
+	internalApprovalStageRepo := approvalStagePersistence.NewApprovalStageRepository(db)
+	internalApprovalWorkflowRepo := approvalWorkflowPersistence.NewApprovalWorkflowRepository(db)
+	internalApprovalWorkflowStageRepo := approvalWorkflowStagePersistence.NewApprovalWorkflowStageRepository(db)
+	internalBatchJobRunRepo := batchJobRunPersistence.NewBatchJobRunRepository(db)
+	internalPayinFileRepo := payinFilePersistence.NewPayinFileRepository(db)
+	internalInvoiceRepo := invoicePersistence.NewInvoiceRepository(db)
 
 	// Initialize services
 	jwtService := authService.NewJWTService()
@@ -96,11 +121,44 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create internal mail service: %v", err)
 	}
+	storageService, err := storage.NewStorageService(storage.StorageConfig{
+		Backend: appConfig.StorageBackend,
+		S3: storage.S3Config{
+			Bucket:          appConfig.S3Bucket,
+			Region:          appConfig.S3Region,
+			AccessKeyID:     appConfig.AwsAccessKeyID,
+			SecretAccessKey: appConfig.AwsSecretAccessKey,
+		},
+		Local: storage.LocalConfig{
+			RootDir: appConfig.LocalStorageDir,
+			Bucket:  appConfig.S3Bucket,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to create storage service: %v", err)
+	}
 
 	auditLogService := service.NewAuditLogService(internalAuditLogRepo, internalUserRepo)
 	roleService := service.NewRoleService(internalRoleRepo, internalPermissionRepo)
 	permissionService := service.NewPermissionService(internalPermissionRepo)
 	payoutService := service.NewPayoutManagementService(internalPayoutRepo, internalPayoutRecordRepo)
+	approvalWorkflowService := service.NewApprovalWorkflowService(
+		internalApprovalRepo,
+		internalApprovalStageRepo,
+		internalApprovalWorkflowRepo,
+		internalApprovalWorkflowStageRepo,
+	)
+	merchantReviewStatusCSVService := service.NewMerchantReviewStatusCSVService()
+	zenginTransferFileService := service.NewZenginTransferFileService(service.ZenginRequester{
+		Code:        appConfig.ZenginRequesterCode,
+		Name:        appConfig.ZenginRequesterName,
+		BankCode:    appConfig.ZenginBankCode,
+		BankName:    appConfig.ZenginBankName,
+		BranchCode:  appConfig.ZenginBranchCode,
+		BranchName:  appConfig.ZenginBranchName,
+		AccountType: payoutObject.BankAccountType(appConfig.ZenginAccountType),
+		AccountNo:   appConfig.ZenginAccountNo,
+	})
 
 	// Initialize usecases
 	auditLogUsecase := auditLogUsecase.NewAuditLogUsecase(auditLogService)
@@ -110,18 +168,26 @@ func main() {
 	twoFactorDomainSvc := twoFactorTokenDomainService.NewTwoFactorTokenService(internalUserRepo, internalTwoFactorRepo, mailService)
 	accessTokenDomainSvc := accessTokenDomainService.NewAccessTokenService(internalTokenRepo)
 	userManagementUsecase := userUC.NewManageUsersUsecase(internalUserRepo, internalRoleRepo)
-	merchantManagementUsecase := merchantUC.NewManageMerchantsUsecase(internalMerchantRepo)
+	merchantManagementUsecase := merchantUC.NewManageMerchantsUsecase(internalMerchantRepo, merchantReviewStatusCSVService)
+	merchantBankAccountUsecase := merchantUC.NewMerchantBankAccountUsecase(internalMerchantRepo, internalMerchantBankAccountRepo)
 	authUsecase := authUC.NewAuthUsecase(internalUserRepo, internalTwoFactorRepo, jwtService, twoFactorDomainSvc, accessTokenDomainSvc)
-	payoutUsecase := payoutUsecase.NewPayoutUsecase(payoutService)
+	payoutUsecase := payoutUsecase.NewPayoutUsecase(payoutService, zenginTransferFileService, internalMerchantBankAccountRepo)
+	payoutApprovalUsecase := payoutApprovalUsecase.NewPayoutApprovalUsecase(payoutService, approvalWorkflowService, internalUserRepo, appConfig.PayoutApprovalWorkflowID)
+	batchJobRunUsecase := batchJobRunUsecase.NewBatchJobRunUsecase(internalBatchJobRunRepo)
+	payinFileUsecase := payinFileUsecase.NewPayinFileUsecase(internalPayinFileRepo)
+	invoiceUsecase := invoiceUsecase.NewInvoiceUsecase(internalInvoiceRepo, storageService, appConfig.S3Bucket)
 
 	// Initialize controllers
 	authController := auth.NewAuthController(authUsecase)
 	userController := user.NewUserController(userManagementUsecase)
-	merchantController := merchantController.NewMerchantController(merchantManagementUsecase)
+	merchantController := merchantController.NewMerchantController(merchantManagementUsecase, merchantBankAccountUsecase)
 	roleController := roleController.NewRoleController(roleUsecase)
 	permissionController := permissionController.NewPermissionController(permissionUsecase)
 	auditLogController := auditLogController.NewAuditLogController(auditLogUsecase)
-	payoutController := payoutController.NewPayoutController(payoutUsecase)
+	payoutController := payoutController.NewPayoutController(payoutUsecase, payoutApprovalUsecase)
+	batchJobRunController := batchJobRunController.NewBatchJobRunController(batchJobRunUsecase)
+	payinFileController := payinFileController.NewPayinFileController(payinFileUsecase)
+	invoiceController := invoiceController.NewInvoiceController(invoiceUsecase)
 
 	// Create Echo server
 	srv := http.NewServer(appLogger)
@@ -157,6 +223,9 @@ func main() {
 		roleController,
 		permissionController,
 		auditLogController,
+		batchJobRunController,
+		payinFileController,
+		invoiceController,
 		middlewareManager,
 	)
 
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `payout`
+    MODIFY COLUMN `sent_date` datetime DEFAULT NULL COMMENT '実際振込の実施日時';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `payout`
+    MODIFY COLUMN `sent_date` datetime NOT NULL COMMENT '実際振込の実施日時';
+-- +goose StatementEnd
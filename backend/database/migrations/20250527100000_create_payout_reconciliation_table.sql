@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `payout_reconciliation` (
+  `id` int NOT NULL AUTO_INCREMENT COMMENT '主キー',
+  `payout_id` int NOT NULL COMMENT '振込ID',
+  `payout_record_id` int NOT NULL COMMENT '振込詳細ID',
+  `aozora_transfer_apply_no` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL COMMENT 'あおぞらネット銀行の振込実施番号',
+  `local_amount` decimal(18,2) NOT NULL COMMENT '振込詳細の振込金額',
+  `bank_amount` decimal(18,2) NOT NULL COMMENT '銀行から取得した振込金額',
+  `bank_transfer_status` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL COMMENT '銀行から取得した振込ステータス',
+  `detected_at` datetime NOT NULL COMMENT '不一致検知日時',
+  `created_at` datetime DEFAULT NULL COMMENT 'レコード作成日時',
+  `updated_at` datetime DEFAULT NULL COMMENT 'レコード更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT 'レコード削除日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_payout_record_id` (`payout_record_id`),
+  KEY `idx_payout_id` (`payout_id`),
+  KEY `idx_aozora_transfer_apply_no` (`aozora_transfer_apply_no`),
+  KEY `idx_detected_at` (`detected_at`),
+  KEY `idx_deleted_at` (`deleted_at`),
+  CONSTRAINT `fk_payout_reconciliation_payout` FOREIGN KEY (`payout_id`) REFERENCES `payout` (`id`),
+  CONSTRAINT `fk_payout_reconciliation_payout_record` FOREIGN KEY (`payout_record_id`) REFERENCES `payout_record` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='振込金額照合不一致';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `payout_reconciliation`;
+-- +goose StatementEnd
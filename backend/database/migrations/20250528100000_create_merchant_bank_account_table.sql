@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `merchant_bank_account` (
+  `id` int NOT NULL AUTO_INCREMENT COMMENT '主キー',
+  `merchant_id` int NOT NULL COMMENT '加盟店ID',
+  `bank_name` varchar(255) NOT NULL COMMENT '金融機関名',
+  `bank_code` varchar(255) NOT NULL COMMENT '金融機関コード',
+  `branch_name` varchar(255) NOT NULL COMMENT '支店名',
+  `branch_code` varchar(255) NOT NULL COMMENT '支店コード',
+  `bank_account_type` int NOT NULL COMMENT '口座種別　\n1:普通預金, 2:当座預金, 3:定期預金',
+  `account_no` varchar(255) NOT NULL COMMENT '口座番号',
+  `account_name` varchar(255) NOT NULL COMMENT '口座名義（半角カナ）',
+  `created_at` datetime DEFAULT NULL COMMENT 'レコード作成日時',
+  `updated_at` datetime DEFAULT NULL COMMENT 'レコード更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT 'レコード削除日時',
+  PRIMARY KEY (`id`),
+  KEY `idx_merchant_id` (`merchant_id`),
+  KEY `idx_deleted_at` (`deleted_at`),
+  CONSTRAINT `fk_merchant_bank_account_merchant` FOREIGN KEY (`merchant_id`) REFERENCES `merchant` (`id`) ON DELETE RESTRICT ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='加盟店振込先口座';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `merchant_bank_account`;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `merchant_bank_account`
+    ADD COLUMN `change_type` int NOT NULL DEFAULT 1 COMMENT '変更種別　\n1:登録, 2:変更, 3:削除' AFTER `account_name`,
+    ADD COLUMN `status` int NOT NULL DEFAULT 2 COMMENT '状態　\n1:確認待ち, 2:有効, 3:却下, 4:無効' AFTER `change_type`,
+    ADD COLUMN `previous_bank_account_id` int DEFAULT NULL COMMENT '変更前の口座ID' AFTER `status`,
+    ADD COLUMN `requested_by` int DEFAULT NULL COMMENT '申請者ID' AFTER `previous_bank_account_id`,
+    ADD COLUMN `reviewed_by` int DEFAULT NULL COMMENT '確認者ID' AFTER `requested_by`,
+    ADD COLUMN `reviewed_at` datetime DEFAULT NULL COMMENT '確認日時' AFTER `reviewed_by`,
+    ADD KEY `idx_merchant_id_status` (`merchant_id`, `status`),
+    ADD CONSTRAINT `fk_merchant_bank_account_previous` FOREIGN KEY (`previous_bank_account_id`) REFERENCES `merchant_bank_account` (`id`),
+    ADD CONSTRAINT `fk_merchant_bank_account_requested_by` FOREIGN KEY (`requested_by`) REFERENCES `user` (`id`),
+    ADD CONSTRAINT `fk_merchant_bank_account_reviewed_by` FOREIGN KEY (`reviewed_by`) REFERENCES `user` (`id`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `merchant_bank_account`
+    DROP FOREIGN KEY `fk_merchant_bank_account_previous`,
+    DROP FOREIGN KEY `fk_merchant_bank_account_requested_by`,
+    DROP FOREIGN KEY `fk_merchant_bank_account_reviewed_by`,
+    DROP KEY `idx_merchant_id_status`,
+    DROP COLUMN `reviewed_at`,
+    DROP COLUMN `reviewed_by`,
+    DROP COLUMN `requested_by`,
+    DROP COLUMN `previous_bank_account_id`,
+    DROP COLUMN `status`,
+    DROP COLUMN `change_type`;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `payin_file`
+    ADD COLUMN `file_size` bigint DEFAULT NULL COMMENT 'アップロードしたファイルのサイズ（バイト）' AFTER `content_added_manually`,
+    ADD COLUMN `md5_checksum` char(32) DEFAULT NULL COMMENT 'アップロードしたファイルのMD5チェックサム（16進数）' AFTER `file_size`,
+    ADD COLUMN `sha256_checksum` char(64) DEFAULT NULL COMMENT 'アップロードしたファイルのSHA-256チェックサム（16進数）' AFTER `md5_checksum`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `payin_file`
+    DROP COLUMN `sha256_checksum`,
+    DROP COLUMN `md5_checksum`,
+    DROP COLUMN `file_size`;
+-- +goose StatementEnd
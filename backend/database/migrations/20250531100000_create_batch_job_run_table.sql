@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `batch_job_run` (
+  `id` int NOT NULL AUTO_INCREMENT COMMENT '主キー',
+  `command_name` varchar(255) NOT NULL COMMENT 'バッチコマンド名',
+  `flags` json DEFAULT NULL COMMENT '実行時のフラグ',
+  `status` int NOT NULL COMMENT '実行状況　\n1:実行中, 2:成功, 3:一部失敗, 4:失敗',
+  `started_at` datetime NOT NULL COMMENT '開始日時',
+  `finished_at` datetime DEFAULT NULL COMMENT '終了日時',
+  `processed_count` int NOT NULL DEFAULT 0 COMMENT '処理件数',
+  `failed_count` int NOT NULL DEFAULT 0 COMMENT '失敗件数',
+  `error_summary` text DEFAULT NULL COMMENT 'エラー概要',
+  `created_at` datetime DEFAULT NULL COMMENT 'レコード作成日時',
+  `updated_at` datetime DEFAULT NULL COMMENT 'レコード更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT 'レコード削除日時',
+  PRIMARY KEY (`id`),
+  KEY `idx_command_name_started_at` (`command_name`, `started_at`),
+  KEY `idx_status` (`status`),
+  KEY `idx_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='バッチ実行履歴';
+
+CREATE TABLE `batch_job_run_payin_file` (
+  `id` int NOT NULL AUTO_INCREMENT COMMENT '主キー',
+  `batch_job_run_id` int NOT NULL COMMENT 'バッチ実行履歴ID',
+  `payin_file_id` int NOT NULL COMMENT '入金ファイルID',
+  `created_at` datetime DEFAULT NULL COMMENT 'レコード作成日時',
+  `updated_at` datetime DEFAULT NULL COMMENT 'レコード更新日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_batch_job_run_payin_file` (`batch_job_run_id`, `payin_file_id`),
+  KEY `idx_payin_file_id` (`payin_file_id`),
+  CONSTRAINT `fk_batch_job_run_payin_file_run` FOREIGN KEY (`batch_job_run_id`) REFERENCES `batch_job_run` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_batch_job_run_payin_file_file` FOREIGN KEY (`payin_file_id`) REFERENCES `payin_file` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='バッチ実行で処理した入金ファイル';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `batch_job_run_payin_file`;
+DROP TABLE `batch_job_run`;
+-- +goose StatementEnd
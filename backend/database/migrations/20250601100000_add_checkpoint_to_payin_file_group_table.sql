@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `payin_file_group`
+    ADD COLUMN `file_group_name` varchar(255) NOT NULL DEFAULT '' COMMENT 'ファイルグループ名' AFTER `id`,
+    ADD COLUMN `listed_at` datetime DEFAULT NULL COMMENT 'リモートファイルの一覧を登録し終えた日時' AFTER `import_target_date`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `payin_file_group`
+    DROP COLUMN `listed_at`,
+    DROP COLUMN `file_group_name`;
+-- +goose StatementEnd
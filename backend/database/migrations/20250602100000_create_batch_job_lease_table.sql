@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `batch_job_lease` (
+  `id` int NOT NULL AUTO_INCREMENT COMMENT '主キー',
+  `lease_key` varchar(512) NOT NULL COMMENT 'ロック対象のキー（コマンド名・ファイル）',
+  `owner` varchar(255) NOT NULL COMMENT 'ロックを保持しているプロセス',
+  `acquired_at` datetime NOT NULL COMMENT 'ロック取得日時',
+  `expires_at` datetime NOT NULL COMMENT 'ロック有効期限（更新されない場合は期限切れとして他のプロセスが取得できる）',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT 'レコード作成日',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'レコード更新日',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_lease_key` (`lease_key`),
+  KEY `idx_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='バッチの多重実行を防ぐロック';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `batch_job_lease`;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `payin_file`
+    ADD COLUMN `encoding` varchar(32) DEFAULT NULL COMMENT '取り込み時に判定した文字コード（UTF-8 / Shift_JIS）' AFTER `sha256_checksum`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `payin_file`
+    DROP COLUMN `encoding`;
+-- +goose StatementEnd
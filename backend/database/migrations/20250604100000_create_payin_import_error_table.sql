@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `payin_import_error` (
+  `id` int NOT NULL AUTO_INCREMENT COMMENT '主キー',
+  `payin_file_id` int NOT NULL COMMENT '入金ファイルID',
+  `line_number` int NOT NULL COMMENT 'CSVの行番号（1始まり）',
+  `column_name` varchar(100) NOT NULL COMMENT '項目名（正規化後のヘッダー）',
+  `raw_value` text COMMENT '取り込めなかった値',
+  `reason` varchar(255) NOT NULL COMMENT 'エラー理由',
+  `policy` tinyint NOT NULL COMMENT '適用したエラー時の方針 (1: ファイルを取り込まない, 2: 行をスキップ, 3: 警告として取り込む)',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT 'レコード作成日',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT 'レコード更新日',
+  PRIMARY KEY (`id`),
+  KEY `idx_payin_file_id_line_number` (`payin_file_id`, `line_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='入金ファイルの行単位の取り込みエラー';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `payin_import_error`;
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- 再取り込みで重複したレコードは最初に取り込んだレコードに集約する（集約取引詳細の参照も付け替える）
+-- +goose StatementBegin
+UPDATE `transaction_record` tr
+    JOIN `paypay_payin_detail` d ON d.`id` = tr.`payin_detail_id`
+    JOIN (
+        SELECT `payment_merchant_id`, `cutoff_date`, MIN(`id`) AS `keep_id`
+        FROM `paypay_payin_detail`
+        GROUP BY `payment_merchant_id`, `cutoff_date`
+    ) k ON k.`payment_merchant_id` = d.`payment_merchant_id` AND k.`cutoff_date` = d.`cutoff_date`
+SET tr.`payin_detail_id` = k.`keep_id`
+WHERE d.`id` <> k.`keep_id`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DELETE d FROM `paypay_payin_detail` d
+    JOIN `paypay_payin_detail` k ON k.`payment_merchant_id` = d.`payment_merchant_id` AND k.`cutoff_date` = d.`cutoff_date` AND k.`id` < d.`id`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE `transaction_record` tr
+    JOIN `paypay_payin_summary` s ON s.`id` = tr.`payin_summary_id`
+    JOIN (
+        SELECT `corporate_name`, `cutoff_date`, `payment_date`, MIN(`id`) AS `keep_id`
+        FROM `paypay_payin_summary`
+        GROUP BY `corporate_name`, `cutoff_date`, `payment_date`
+    ) k ON k.`corporate_name` = s.`corporate_name` AND k.`cutoff_date` = s.`cutoff_date` AND k.`payment_date` = s.`payment_date`
+SET tr.`payin_summary_id` = k.`keep_id`
+WHERE s.`id` <> k.`keep_id`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DELETE s FROM `paypay_payin_summary` s
+    JOIN `paypay_payin_summary` k ON k.`corporate_name` = s.`corporate_name` AND k.`cutoff_date` = s.`cutoff_date` AND k.`payment_date` = s.`payment_date` AND k.`id` < s.`id`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+DELETE t FROM `paypay_payin_transaction` t
+    JOIN `paypay_payin_transaction` k ON k.`payment_transaction_id` = t.`payment_transaction_id` AND k.`payment_transaction_status` = t.`payment_transaction_status` AND k.`id` < t.`id`;
+-- +goose StatementEnd
+-- 自然キーで重複を防ぎ、同じレコードの再取り込みは更新とする
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_transaction`
+    ADD UNIQUE KEY `uq_payment_transaction_id_status` (`payment_transaction_id`, `payment_transaction_status`);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_detail`
+    ADD UNIQUE KEY `uq_payment_merchant_id_cutoff_date` (`payment_merchant_id`, `cutoff_date`);
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_summary`
+    ADD UNIQUE KEY `uq_corporate_name_cutoff_date_payment_date` (`corporate_name`, `cutoff_date`, `payment_date`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_summary`
+    DROP KEY `uq_corporate_name_cutoff_date_payment_date`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_detail`
+    DROP KEY `uq_payment_merchant_id_cutoff_date`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_transaction`
+    DROP KEY `uq_payment_transaction_id_status`;
+-- +goose StatementEnd
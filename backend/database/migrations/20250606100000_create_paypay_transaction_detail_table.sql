@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `paypay_transaction_detail` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `payin_file_id` int NOT NULL,
+  `shipping_related` tinyint(1) NOT NULL DEFAULT 0 COMMENT '配送関連: 1:配送関連の取引明細, 0:配送関連なしの取引明細',
+  `payment_transaction_id` varchar(100) DEFAULT NULL COMMENT '決済番号: PayPay側で発行している決済番号',
+  `payment_merchant_id` varchar(100) DEFAULT NULL COMMENT '加盟店ID: PayPay側で管理されている加盟店ID',
+  `merchant_business_name` varchar(100) DEFAULT NULL COMMENT '屋号: PayPay側に登録されている屋号',
+  `shop_id` varchar(100) DEFAULT NULL COMMENT '店舗ID: 決済電文の店舗コード',
+  `shop_name` varchar(255) DEFAULT NULL COMMENT '店舗名: MPM方式でのみ設定される項目',
+  `terminal_code` varchar(100) DEFAULT NULL COMMENT '端末番号/PosID: 決済電文の端末コード',
+  `payment_transaction_status` int DEFAULT NULL COMMENT '1:取引完了, 2:取引受付完了, 3:返金完了, 4:取引取消, 5:取引受付取消, 6:調整, 7:送金完了',
+  `transaction_at` datetime DEFAULT NULL COMMENT '取引日時: PayPay側での処理日時',
+  `transaction_amount` decimal(12,2) DEFAULT NULL COMMENT '取引金額: 返金の場合はマイナスで表記される場合あり',
+  `receipt_number` varchar(255) DEFAULT NULL COMMENT 'レシート番号: 決済電文のレシート番号',
+  `paypay_payment_method` varchar(100) DEFAULT NULL COMMENT '支払い方法: レポートに記載された支払い方法',
+  `merchant_order_id` varchar(255) DEFAULT NULL COMMENT '加盟店決済ID: merchant_order_id または merchant_refund_id',
+  `shipping_fee` decimal(12,2) DEFAULT NULL COMMENT '送料: 配送関連の取引明細のみ',
+  `shipped_at` datetime DEFAULT NULL COMMENT '発送日時: 配送関連の取引明細のみ',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT '削除日時（論理削除用）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_payment_transaction_id_status` (`payment_transaction_id`, `payment_transaction_status`),
+  KEY `idx_payin_file_id` (`payin_file_id`),
+  KEY `idx_payment_merchant_id` (`payment_merchant_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='PayPay取引明細ファイル取込用テーブル';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `paypay_transaction_detail`;
+-- +goose StatementEnd
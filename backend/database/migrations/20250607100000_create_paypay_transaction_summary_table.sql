@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `paypay_transaction_summary` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `payin_file_id` int NOT NULL,
+  `payment_merchant_id` varchar(100) DEFAULT NULL COMMENT '加盟店ID: PayPay側で管理されている加盟店ID',
+  `merchant_business_name` varchar(100) DEFAULT NULL COMMENT '屋号: PayPay側に登録されている屋号',
+  `transaction_date` date DEFAULT NULL COMMENT '取引日: 集計対象の取引日',
+  `transaction_count` int NOT NULL DEFAULT 0 COMMENT '取引件数: 取引日の取引件数',
+  `transaction_amount` decimal(18,2) DEFAULT NULL COMMENT '取引額: 取引日の取引額',
+  `refund_count` int NOT NULL DEFAULT 0 COMMENT '返金件数: 取引日の返金件数',
+  `refund_amount` decimal(18,2) DEFAULT NULL COMMENT '返金額: 取引日の返金額',
+  `usage_fee` decimal(18,2) DEFAULT NULL COMMENT '利用料: 取引および返金対象の利用料（負/正あり）',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT '削除日時（論理削除用）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_payment_merchant_id_transaction_date` (`payment_merchant_id`, `transaction_date`),
+  KEY `idx_payin_file_id` (`payin_file_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='PayPay取引明細サマリー（加盟店・取引日ごと）';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `paypay_transaction_summary`;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `paypay_top_up_detail` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `payin_file_id` int NOT NULL,
+  `payment_merchant_id` varchar(100) DEFAULT NULL COMMENT '加盟店ID: PayPay側で管理されている加盟店ID',
+  `merchant_business_name` varchar(100) DEFAULT NULL COMMENT '屋号: PayPay側に登録されている屋号',
+  `cutoff_date` date DEFAULT NULL COMMENT '締め日: 入金サイクルに応じた締め日',
+  `payment_date` date DEFAULT NULL COMMENT '支払日: 入金予定日',
+  `amount` decimal(18,2) DEFAULT NULL COMMENT '支払金額: 入金金額',
+  `bank_name` varchar(100) DEFAULT NULL COMMENT '金融機関名: 入金先の金融機関名',
+  `branch_name` varchar(100) DEFAULT NULL COMMENT '支店名: 入金先の支店名',
+  `account_type` varchar(20) DEFAULT NULL COMMENT '口座種別: 普通、当座など',
+  `account_number` varchar(20) DEFAULT NULL COMMENT '口座番号: 入金先の口座番号',
+  `account_holder` varchar(255) DEFAULT NULL COMMENT '口座名義: 入金先の口座名義',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT '削除日時（論理削除用）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_payment_merchant_id_cutoff_date_payment_date` (`payment_merchant_id`, `cutoff_date`, `payment_date`),
+  KEY `idx_payin_file_id` (`payin_file_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='PayPay入金詳細（加盟店ごとの入金先）';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `paypay_top_up_detail`;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `paypay_invoice` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `payin_file_id` int NOT NULL COMMENT '入金ファイルID: 請求書PDFの取り込み元ファイル',
+  `duplicate` tinyint(1) NOT NULL DEFAULT 0 COMMENT '控えフラグ: 1=適格請求書（控え）',
+  `file_name` varchar(255) NOT NULL COMMENT 'ファイル名: 請求書PDFのファイル名',
+  `storage_key` varchar(1024) NOT NULL COMMENT 'ストレージキー: 請求書PDFの保存先',
+  `registration_number` varchar(14) DEFAULT NULL COMMENT '登録番号: 適格請求書発行事業者の登録番号（T+13桁）',
+  `issue_date` date DEFAULT NULL COMMENT '発行日: 請求書の発行日',
+  `payment_merchant_id` varchar(100) DEFAULT NULL COMMENT '加盟店ID: PayPay側で管理されている加盟店ID',
+  `merchant_business_name` varchar(255) DEFAULT NULL COMMENT '加盟店名: 請求書の宛名',
+  `cutoff_date` date DEFAULT NULL COMMENT '締め日: 請求対象期間の末日',
+  `taxable_amount` decimal(18,2) DEFAULT NULL COMMENT '課税対象額: 10%対象の金額',
+  `tax_amount` decimal(18,2) DEFAULT NULL COMMENT '消費税額',
+  `total_amount` decimal(18,2) DEFAULT NULL COMMENT '請求金額: 税込の合計金額',
+  `paypay_payin_summary_id` int DEFAULT NULL COMMENT '入金レポートID: 紐づく入金サマリー',
+  `paypay_payin_detail_id` int DEFAULT NULL COMMENT '入金明細ID: 紐づく加盟店ごとの入金明細',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  `deleted_at` datetime DEFAULT NULL COMMENT '削除日時（論理削除用）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_payin_file_id` (`payin_file_id`),
+  KEY `idx_registration_number` (`registration_number`),
+  KEY `idx_payment_merchant_id_cutoff_date` (`payment_merchant_id`, `cutoff_date`),
+  KEY `idx_issue_date` (`issue_date`),
+  KEY `idx_paypay_payin_summary_id` (`paypay_payin_summary_id`),
+  KEY `idx_paypay_payin_detail_id` (`paypay_payin_detail_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='PayPay適格請求書';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS `paypay_invoice`;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_transaction`
+    ADD COLUMN `paypay_payment_method_label` varchar(100) DEFAULT NULL COMMENT '支払い方法（ラベル）: レポートに記載された支払い方法' AFTER `paypay_payment_method`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+-- The labels were stored as 0 outside of strict mode, they are not payment methods
+UPDATE `paypay_payin_transaction`
+    SET `paypay_payment_method` = NULL
+    WHERE `paypay_payment_method` NOT BETWEEN 1 AND 11;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_transaction_detail`
+    CHANGE COLUMN `paypay_payment_method` `paypay_payment_method_label` varchar(100) DEFAULT NULL COMMENT '支払い方法（ラベル）: レポートに記載された支払い方法';
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_transaction_detail`
+    ADD COLUMN `paypay_payment_method` int DEFAULT NULL COMMENT '支払い方法\\n 1:PayPay（残高）, 2:クレジットカード, 3:Yahoo!マネー廃⽌, 4:Alipay, 5:あと払い（一括のみ）, 6:プリペイドコード, 7:LinePay, 8:PayPay（クレジット）, 9:PayPay商品券, 10:PayPayポイント, 11:PayPay銀行残高' AFTER `receipt_number`,
+    ADD KEY `idx_paypay_payment_method` (`paypay_payment_method`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `paypay_transaction_detail`
+    DROP KEY `idx_paypay_payment_method`,
+    DROP COLUMN `paypay_payment_method`;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_transaction_detail`
+    CHANGE COLUMN `paypay_payment_method_label` `paypay_payment_method` varchar(100) DEFAULT NULL COMMENT '支払い方法: レポートに記載された支払い方法';
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `paypay_payin_transaction`
+    DROP COLUMN `paypay_payment_method_label`;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `payout_record`
+    MODIFY COLUMN `transfer_status` int NOT NULL COMMENT '振込状態 \n1:振込中, 2:ホワイトリスト追加エラー, 3:振込依頼APIエラー, 4:振込依頼失敗, 5:振込依頼済み, 6:送金手続き済み, 7:未振込';
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE `payout_record` SET `transfer_status` = 7 WHERE `transfer_status` = 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE `payout_record` SET `transfer_status` = 0 WHERE `transfer_status` = 7;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `payout_record`
+    MODIFY COLUMN `transfer_status` int NOT NULL COMMENT '振込状態 \n1:振込中, 2:ホワイトリスト追加エラー, 3:振込依頼APIエラー, 4:振込依頼失敗, 5:振込依頼済み, 6:送金手続き済み';
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `payout`
+    MODIFY COLUMN `payout_status` int NOT NULL COMMENT '振込状態　1:ドラフト, 2:振込データ作成済み, 3:送金手続き済み, 4:振込失敗あり';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE `payout` SET `payout_status` = 2 WHERE `payout_status` = 4;
+-- +goose StatementEnd
+-- +goose StatementBegin
+ALTER TABLE `payout`
+    MODIFY COLUMN `payout_status` int NOT NULL COMMENT '振込状態　1:ドラフト, 2:振込データ作成済み, 3:送金手続き済み';
+-- +goose StatementEnd
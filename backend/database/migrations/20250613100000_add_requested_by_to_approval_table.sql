@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `approval`
+    ADD COLUMN `requested_by` int DEFAULT NULL COMMENT '申請者ID' AFTER `approval_status`,
+    ADD CONSTRAINT `fk_approval_requested_by` FOREIGN KEY (`requested_by`) REFERENCES `user` (`id`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `approval`
+    DROP FOREIGN KEY `fk_approval_requested_by`,
+    DROP COLUMN `requested_by`;
+-- +goose StatementEnd
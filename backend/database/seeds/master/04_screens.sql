@@ -7,7 +7,9 @@ INSERT INTO `screen`
     (3,'プロフィール画面','PROFILE_SCREEN','/profile/*','2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
     (4,'管理者パネル画面','ADMIN_PANEL_SCREEN','/admin/*','2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
     (5,'振込承認画面','TRANSFER_APPROVAL_SCREEN','/transfer/approval/*','2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
-    (6,'振込操作画面','TRANSFER_OPERATION_SCREEN','/transfer/operation/*','2025-03-30 17:51:49','2025-03-30 17:51:49',NULL)
+    (6,'振込操作画面','TRANSFER_OPERATION_SCREEN','/transfer/operation/*','2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
+    (7,'加盟店管理画面','MERCHANT_MANAGEMENT_SCREEN','/merchant/*','2025-05-29 10:00:00','2025-05-29 10:00:00',NULL),
+    (8,'請求書画面','INVOICE_SCREEN','/invoice/*','2025-06-09 10:00:00','2025-06-09 10:00:00',NULL)
 ON DUPLICATE KEY UPDATE
     name = VALUES(name),
     screen_code = VALUES(screen_code),
@@ -9,7 +9,9 @@ INSERT INTO `permission`
     (7,'管理画面の参照権限','VIEW_ADMIN_PANEL',4,'2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
     (8,'振込み承認（事業）','TRANSFER_APPROVE_BUSINESS',5,'2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
     (9,'振込み承認（経理）','TRANSFER_APPROVE_ACCOUNTANT',5,'2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
-    (10,'手動振込機能','MANUAL_TRANSFER',6,'2025-03-30 17:51:49','2025-03-30 17:51:49',NULL)
+    (10,'手動振込機能','MANUAL_TRANSFER',6,'2025-03-30 17:51:49','2025-03-30 17:51:49',NULL),
+    (11,'加盟店管理','MERCHANT_MANAGE',7,'2025-05-29 10:00:00','2025-05-29 10:00:00',NULL),
+    (12,'請求書の閲覧','INVOICE_VIEW',8,'2025-06-09 10:00:00','2025-06-09 10:00:00',NULL)
 ON DUPLICATE KEY UPDATE
     name = VALUES(name),
     code = VALUES(code),
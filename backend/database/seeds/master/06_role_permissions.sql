@@ -13,7 +13,11 @@ INSERT INTO `role_permission`
     (14,4,5,'2025-03-30 17:53:06','2025-03-30 17:53:06',NULL),
     (15,4,10,'2025-03-30 17:53:06','2025-03-30 17:53:06',NULL),
     (16,4,9,'2025-03-30 17:53:06','2025-03-30 17:53:06',NULL),
-    (17,4,6,'2025-03-30 17:53:06','2025-03-30 17:53:06',NULL)
+    (17,4,6,'2025-03-30 17:53:06','2025-03-30 17:53:06',NULL),
+    (18,1,11,'2025-05-29 10:00:00','2025-05-29 10:00:00',NULL),
+    (19,3,11,'2025-05-29 10:00:00','2025-05-29 10:00:00',NULL),
+    (20,1,12,'2025-06-09 10:00:00','2025-06-09 10:00:00',NULL),
+    (21,4,12,'2025-06-09 10:00:00','2025-06-09 10:00:00',NULL)
 ON DUPLICATE KEY UPDATE
     role_id = VALUES(role_id),
     permission_id = VALUES(permission_id);
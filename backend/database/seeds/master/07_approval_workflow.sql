@@ -0,0 +1,21 @@
+-- +goose Up
+INSERT INTO `approval_workflow` (id, name, created_at, updated_at, deleted_at)
+    VALUES
+    (1,'出金承認','2025-05-20 10:00:00','2025-05-20 10:00:00',NULL)
+ON DUPLICATE KEY UPDATE
+    name = VALUES(name),
+    deleted_at = VALUES(deleted_at);
+
+INSERT INTO `approval_workflow_stage` (id, workflow_id, stage_name, level, approver_role_id, approver_count, created_at, updated_at, deleted_at)
+    VALUES
+    (1,1,'事業承認',1,3,1,'2025-05-20 10:00:00','2025-05-20 10:00:00',NULL),
+    (2,1,'経理承認',2,4,1,'2025-05-20 10:00:00','2025-05-20 10:00:00',NULL)
+ON DUPLICATE KEY UPDATE
+    workflow_id = VALUES(workflow_id),
+    stage_name = VALUES(stage_name),
+    level = VALUES(level),
+    approver_role_id = VALUES(approver_role_id),
+    approver_count = VALUES(approver_count),
+    deleted_at = VALUES(deleted_at);
+
+-- +goose Down
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/huydq/test/internal/controller/base"
+	"github.com/huydq/test/internal/controller/batch_job_run/mapper"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+	response "github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
+	messages "github.com/huydq/test/internal/pkg/utils/messages"
+	usecase "github.com/huydq/test/internal/usecase/batch_job_run"
+	"github.com/labstack/echo/v4"
+)
+
+type BatchJobRunController struct {
+	base.BaseController
+	batchJobRunUsecase usecase.BatchJobRunUsecase
+}
+
+func NewBatchJobRunController(batchJobRunUsecase usecase.BatchJobRunUsecase) *BatchJobRunController {
+	return &BatchJobRunController{
+		BaseController:     *base.NewBaseController(),
+		batchJobRunUsecase: batchJobRunUsecase,
+	}
+}
+
+// ListBatchJobRuns handles the request to list batch job runs with pagination and filtering
+func (c *BatchJobRunController) ListBatchJobRuns(ctx echo.Context) error {
+	var request generated.BatchJobRunListRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	inputData := mapper.ToBatchJobRunListInputData(&request)
+	runs, _, totalCount, err := c.batchJobRunUsecase.ListBatchJobRuns(ctx.Request().Context(), inputData)
+	if err != nil {
+		return response.SendError(ctx, toBatchJobRunError(messages.MsgListBatchJobRunsFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgListBatchJobRunsSuccess, mapper.ToBatchJobRunListData(runs, totalCount, inputData.Page, inputData.PageSize))
+}
+
+// GetBatchJobRun handles the request to get a batch job run
+func (c *BatchJobRunController) GetBatchJobRun(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	run, err := c.batchJobRunUsecase.GetBatchJobRun(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toBatchJobRunError(messages.MsgGetBatchJobRunFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgGetBatchJobRunSuccess, mapper.ToBatchJobRunSuccessResponse(run))
+}
+
+// ListBatchJobRunPayinFiles handles the request to list the payin files touched by a batch job run
+func (c *BatchJobRunController) ListBatchJobRunPayinFiles(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	files, err := c.batchJobRunUsecase.ListBatchJobRunPayinFiles(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toBatchJobRunError(messages.MsgListBatchJobRunPayinFilesFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgListBatchJobRunPayinFilesSuccess, mapper.ToBatchJobRunPayinFilesSuccessResponse(files))
+}
+
+func toBatchJobRunError(message string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrBatchJobRunNotFound):
+		return appErrors.NotFoundError(messages.MsgBatchJobRunNotFound)
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+)
+
+func ToBatchJobRunListInputData(request *generated.BatchJobRunListRequest) *inputdata.BatchJobRunListInputData {
+	var startTime, endTime *time.Time
+
+	if request.StartedAtStart != "" {
+		if t, err := time.Parse(time.RFC3339, request.StartedAtStart); err == nil {
+			startTime = &t
+		}
+	}
+
+	if request.StartedAtEnd != "" {
+		if t, err := time.Parse(time.RFC3339, request.StartedAtEnd); err == nil {
+			endTime = &t
+		}
+	}
+
+	return &inputdata.BatchJobRunListInputData{
+		Page:           request.Page,
+		PageSize:       request.PageSize,
+		CommandName:    request.CommandName,
+		Status:         request.Status,
+		StartedAtStart: startTime,
+		StartedAtEnd:   endTime,
+		SortField:      request.SortField,
+		SortOrder:      request.SortOrder,
+	}
+}
@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+)
+
+func ToBatchJobRunListData(runs []*model.BatchJobRun, totalCount int, currentPage int, pageSize int) *generated.BatchJobRunListResponse {
+	runResponses := make([]generated.BatchJobRun, len(runs))
+	for i, run := range runs {
+		runResponses[i] = toBatchJobRunResponse(run)
+	}
+
+	return &generated.BatchJobRunListResponse{
+		BatchJobRuns: runResponses,
+		Page:         currentPage,
+		PageSize:     pageSize,
+		Total:        totalCount,
+	}
+}
+
+type BatchJobRunSuccessResponse struct {
+	BatchJobRun generated.BatchJobRun `json:"batch_job_run"`
+}
+
+func ToBatchJobRunSuccessResponse(run *model.BatchJobRun) *BatchJobRunSuccessResponse {
+	return &BatchJobRunSuccessResponse{
+		BatchJobRun: toBatchJobRunResponse(run),
+	}
+}
+
+type BatchJobRunPayinFilesSuccessResponse struct {
+	PayinFiles []generated.PayinFile `json:"payin_files"`
+}
+
+func ToBatchJobRunPayinFilesSuccessResponse(files []*payinModel.PayinFile) *BatchJobRunPayinFilesSuccessResponse {
+	fileResponses := make([]generated.PayinFile, len(files))
+	for i, file := range files {
+		fileResponses[i] = toPayinFileResponse(file)
+	}
+
+	return &BatchJobRunPayinFilesSuccessResponse{
+		PayinFiles: fileResponses,
+	}
+}
+
+func toBatchJobRunResponse(run *model.BatchJobRun) generated.BatchJobRun {
+	flags := run.Flags
+	if flags == nil {
+		flags = map[string]string{}
+	}
+
+	return generated.BatchJobRun{
+		Id:             run.ID,
+		CommandName:    run.CommandName,
+		Flags:          flags,
+		Status:         int(run.Status),
+		StartedAt:      run.StartedAt,
+		FinishedAt:     run.FinishedAt,
+		ProcessedCount: run.ProcessedCount,
+		FailedCount:    run.FailedCount,
+		ErrorSummary:   run.ErrorSummary,
+		CreatedAt:      run.CreatedAt,
+		UpdatedAt:      run.UpdatedAt,
+	}
+}
+
+func toPayinFileResponse(file *payinModel.PayinFile) generated.PayinFile {
+	var encoding *string
+	if file.Encoding != nil {
+		name := file.Encoding.String()
+		encoding = &name
+	}
+
+	return generated.PayinFile{
+		Id:                file.ID,
+		PaymentProviderId: file.PaymentProviderID,
+		PayinFileGroupId:  file.PayinFileGroupID,
+		FileName:          file.FileName,
+		FileContentKey:    file.FileContentKey,
+		PayinFileType:     int(file.PayinFileType),
+		FileSize:          file.FileSize,
+		Sha256Checksum:    file.SHA256Checksum,
+		Encoding:          encoding,
+		DownloadStatus:    int(file.DownloadStatus),
+		UploadStatus:      int(file.UploadStatus),
+		ImportStatus:      int(file.ImportStatus),
+		CreatedAt:         file.CreatedAt,
+		UpdatedAt:         file.UpdatedAt,
+	}
+}
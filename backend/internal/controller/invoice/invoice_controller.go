@@ -0,0 +1,86 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/huydq/test/internal/controller/base"
+	"github.com/huydq/test/internal/controller/invoice/mapper"
+	"github.com/huydq/test/internal/middleware"
+	"github.com/huydq/test/internal/pkg/api/generated"
+	"github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
+	messages "github.com/huydq/test/internal/pkg/utils/messages"
+	usecase "github.com/huydq/test/internal/usecase/invoice"
+	"github.com/labstack/echo/v4"
+)
+
+type InvoiceController struct {
+	base.BaseController
+	invoiceUsecase usecase.InvoiceUsecase
+}
+
+func NewInvoiceController(invoiceUsecase usecase.InvoiceUsecase) *InvoiceController {
+	return &InvoiceController{
+		BaseController: *base.NewBaseController(),
+		invoiceUsecase: invoiceUsecase,
+	}
+}
+
+func (c *InvoiceController) ListInvoices(ctx echo.Context) error {
+	var request generated.InvoiceListRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	input := mapper.ToInvoiceListInputData(&request)
+
+	invoices, _, totalCount, err := c.invoiceUsecase.ListInvoices(ctx.Request().Context(), input)
+	if err != nil {
+		return response.SendError(ctx, toInvoiceError(messages.MsgListInvoicesFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgListInvoicesSuccess, mapper.ToInvoiceListData(invoices, totalCount, input.Page, input.PageSize))
+}
+
+func (c *InvoiceController) GetInvoice(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	invoice, err := c.invoiceUsecase.GetInvoice(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toInvoiceError(messages.MsgGetInvoiceFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgGetInvoiceSuccess, mapper.ToInvoiceSuccessResponse(invoice))
+}
+
+func (c *InvoiceController) DownloadInvoice(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	invoice, file, err := c.invoiceUsecase.DownloadInvoice(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toInvoiceError(messages.MsgDownloadInvoiceFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayinID), &invoice.PayinFileID)
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.FileName))
+	return ctx.Blob(http.StatusOK, "application/pdf", file.Content)
+}
+
+// toInvoiceError maps usecase errors to API errors
+func toInvoiceError(message string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvoiceNotFound):
+		return appErrors.NotFoundError(messages.MsgInvoiceNotFound)
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
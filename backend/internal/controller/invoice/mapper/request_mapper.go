@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+)
+
+func ToInvoiceListInputData(request *generated.InvoiceListRequest) *inputdata.InvoiceListInputData {
+	var duplicate *bool
+	if request.Duplicate != "" {
+		if d, err := strconv.ParseBool(request.Duplicate); err == nil {
+			duplicate = &d
+		}
+	}
+
+	return &inputdata.InvoiceListInputData{
+		Page:                 request.Page,
+		PageSize:             request.PageSize,
+		RegistrationNumber:   request.RegistrationNumber,
+		PaymentMerchantID:    request.PaymentMerchantId,
+		MerchantBusinessName: request.MerchantBusinessName,
+		IssueDateStart:       parseDate(request.IssueDateStart),
+		IssueDateEnd:         parseDate(request.IssueDateEnd),
+		CutoffDate:           parseDate(request.CutoffDate),
+		Duplicate:            duplicate,
+		SortField:            request.SortField,
+		SortOrder:            request.SortOrder,
+	}
+}
+
+// parseDate parses a yyyy-mm-dd date, ignoring an empty or invalid value
+func parseDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
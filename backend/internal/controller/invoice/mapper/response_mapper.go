@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+)
+
+func ToInvoiceListData(invoices []*model.PaypayInvoice, totalCount int, currentPage int, pageSize int) *generated.InvoiceListResponse {
+	invoiceResponses := make([]generated.Invoice, len(invoices))
+	for i, invoice := range invoices {
+		invoiceResponses[i] = toInvoiceResponse(invoice)
+	}
+
+	return &generated.InvoiceListResponse{
+		Invoices: invoiceResponses,
+		Page:     currentPage,
+		PageSize: pageSize,
+		Total:    totalCount,
+	}
+}
+
+type InvoiceSuccessResponse struct {
+	Invoice generated.Invoice `json:"invoice"`
+}
+
+func ToInvoiceSuccessResponse(invoice *model.PaypayInvoice) *InvoiceSuccessResponse {
+	return &InvoiceSuccessResponse{
+		Invoice: toInvoiceResponse(invoice),
+	}
+}
+
+func toInvoiceResponse(invoice *model.PaypayInvoice) generated.Invoice {
+	return generated.Invoice{
+		Id:                   invoice.ID,
+		PayinFileId:          invoice.PayinFileID,
+		Duplicate:            invoice.Duplicate,
+		FileName:             invoice.FileName,
+		RegistrationNumber:   invoice.RegistrationNumber,
+		IssueDate:            invoice.IssueDate,
+		PaymentMerchantId:    invoice.PaymentMerchantID,
+		MerchantBusinessName: invoice.MerchantBusinessName,
+		CutoffDate:           invoice.CutoffDate,
+		TaxableAmount:        invoice.TaxableAmount,
+		TaxAmount:            invoice.TaxAmount,
+		TotalAmount:          invoice.TotalAmount,
+		PaypayPayinSummaryId: invoice.PaypayPayinSummaryID,
+		PaypayPayinDetailId:  invoice.PaypayPayinDetailID,
+		CreatedAt:            invoice.CreatedAt,
+		UpdatedAt:            invoice.UpdatedAt,
+	}
+}
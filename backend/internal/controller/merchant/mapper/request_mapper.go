@@ -33,3 +33,40 @@ func ToMerchantListInputData(request *generated.MerchantListRequest) *inputdata.
 		SortOrder:      request.SortOrder,
 	}
 }
+
+// ToCreateMerchantInputData converts a create merchant request to usecase input
+func ToCreateMerchantInputData(request *generated.CreateMerchantRequest) *inputdata.MerchantInputData {
+	return &inputdata.MerchantInputData{
+		PaymentProviderID: request.PaymentProviderId,
+		PaymentMerchantID: request.PaymentMerchantId,
+		MerchantName:      request.MerchantName,
+		ShopID:            request.ShopId,
+		ShopURL:           request.ShopUrl,
+	}
+}
+
+// ToUpdateMerchantInputData converts an update merchant request to usecase input
+func ToUpdateMerchantInputData(request *generated.UpdateMerchantRequest) *inputdata.MerchantInputData {
+	return &inputdata.MerchantInputData{
+		PaymentProviderID: request.PaymentProviderId,
+		PaymentMerchantID: request.PaymentMerchantId,
+		MerchantName:      request.MerchantName,
+		ShopID:            request.ShopId,
+		ShopURL:           request.ShopUrl,
+	}
+}
+
+// ToMerchantBankAccountInputData converts a bank account request to usecase input
+func ToMerchantBankAccountInputData(request *generated.MerchantBankAccountRequest, merchantID int, userID int) *inputdata.MerchantBankAccountInputData {
+	return &inputdata.MerchantBankAccountInputData{
+		MerchantID:      merchantID,
+		UserID:          userID,
+		BankName:        request.BankName,
+		BankCode:        request.BankCode,
+		BranchName:      request.BranchName,
+		BranchCode:      request.BranchCode,
+		BankAccountType: request.BankAccountType,
+		AccountNo:       request.AccountNo,
+		AccountName:     request.AccountName,
+	}
+}
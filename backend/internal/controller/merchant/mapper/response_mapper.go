@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"github.com/huydq/test/internal/datastructure/outputdata"
 	model "github.com/huydq/test/internal/domain/model/merchant"
 	generated "github.com/huydq/test/internal/pkg/api/generated"
 	"github.com/labstack/echo/v4"
@@ -25,16 +26,7 @@ func (m *MerchantListSuccessMapper) ToMerchantListData(
 ) *generated.MerchantListResponse {
 	merchantResponses := make([]generated.Merchant, len(merchants))
 	for i, merchant := range merchants {
-		merchantResponses[i] = generated.Merchant{
-			Id:                merchant.ID,
-			MerchantName:      merchant.MerchantName,
-			PaymentMerchantId: merchant.PaymentMerchantID,
-			PaymentProviderId: merchant.PaymentProviderID,
-			ShopId:            merchant.ShopID,
-			ShopUrl:           merchant.ShopURL,
-			CreatedAt:         merchant.CreatedAt,
-			UpdatedAt:         merchant.UpdatedAt,
-		}
+		merchantResponses[i] = toMerchantResponse(merchant)
 	}
 
 	return &generated.MerchantListResponse{
@@ -44,3 +36,107 @@ func (m *MerchantListSuccessMapper) ToMerchantListData(
 		Total:     totalCount,
 	}
 }
+
+type MerchantSuccessResponse struct {
+	Merchant generated.Merchant `json:"merchant"`
+}
+
+func ToMerchantSuccessResponse(merchant *model.Merchant) *MerchantSuccessResponse {
+	return &MerchantSuccessResponse{
+		Merchant: toMerchantResponse(merchant),
+	}
+}
+
+func toMerchantResponse(merchant *model.Merchant) generated.Merchant {
+	result := generated.Merchant{
+		Id:                merchant.ID,
+		MerchantName:      merchant.MerchantName,
+		PaymentMerchantId: merchant.PaymentMerchantID,
+		PaymentProviderId: merchant.PaymentProviderID,
+		ShopId:            merchant.ShopID,
+		ShopUrl:           merchant.ShopURL,
+		CreatedAt:         merchant.CreatedAt,
+		UpdatedAt:         merchant.UpdatedAt,
+	}
+
+	if review := merchant.MerchantPaymentProviderReview; review != nil {
+		result.MerchantPaymentProviderReview = &generated.PaymentProviderReview{
+			Id:                   review.ID,
+			MerchantId:           review.MerchantID,
+			MerchantReviewStatus: review.MerchantReviewStatus,
+			CreatedAt:            review.CreatedAt,
+			UpdatedAt:            review.UpdatedAt,
+		}
+	}
+
+	return result
+}
+
+type MerchantBankAccountSuccessResponse struct {
+	BankAccount generated.MerchantBankAccount `json:"bank_account"`
+}
+
+type MerchantBankAccountListSuccessResponse struct {
+	BankAccounts []generated.MerchantBankAccount `json:"bank_accounts"`
+}
+
+func ToMerchantBankAccountSuccessResponse(account *model.MerchantBankAccount) *MerchantBankAccountSuccessResponse {
+	return &MerchantBankAccountSuccessResponse{
+		BankAccount: toMerchantBankAccountResponse(account),
+	}
+}
+
+func ToMerchantBankAccountListSuccessResponse(accounts []*model.MerchantBankAccount) *MerchantBankAccountListSuccessResponse {
+	responses := make([]generated.MerchantBankAccount, len(accounts))
+	for i, account := range accounts {
+		responses[i] = toMerchantBankAccountResponse(account)
+	}
+
+	return &MerchantBankAccountListSuccessResponse{
+		BankAccounts: responses,
+	}
+}
+
+func toMerchantBankAccountResponse(account *model.MerchantBankAccount) generated.MerchantBankAccount {
+	return generated.MerchantBankAccount{
+		Id:                    account.ID,
+		MerchantId:            account.MerchantID,
+		BankName:              account.BankName,
+		BankCode:              account.BankCode,
+		BranchName:            account.BranchName,
+		BranchCode:            account.BranchCode,
+		BankAccountType:       int(account.BankAccountType),
+		AccountNo:             account.AccountNo,
+		AccountName:           account.AccountName,
+		ChangeType:            int(account.ChangeType),
+		Status:                int(account.Status),
+		PreviousBankAccountId: account.PreviousBankAccountID,
+		RequestedBy:           account.RequestedBy,
+		ReviewedBy:            account.ReviewedBy,
+		ReviewedAt:            account.ReviewedAt,
+		CreatedAt:             account.CreatedAt,
+		UpdatedAt:             account.UpdatedAt,
+	}
+}
+
+func ToMerchantReviewStatusUploadResult(output *outputdata.MerchantReviewStatusUploadOutput) *generated.MerchantReviewStatusUploadResult {
+	rows := make([]generated.MerchantReviewStatusUploadRow, len(output.Rows))
+	for i, row := range output.Rows {
+		rows[i] = generated.MerchantReviewStatusUploadRow{
+			RowNumber:                    row.RowNumber,
+			MerchantId:                   row.MerchantID,
+			MerchantName:                 row.MerchantName,
+			PreviousMerchantReviewStatus: row.PreviousMerchantReviewStatus,
+			MerchantReviewStatus:         row.MerchantReviewStatus,
+			Errors:                       row.Errors,
+		}
+	}
+
+	return &generated.MerchantReviewStatusUploadResult{
+		Applied:   output.Applied,
+		TotalRows: output.TotalRows,
+		ValidRows: output.ValidRows,
+		ErrorRows: output.ErrorRows,
+		Rows:      rows,
+	}
+}
@@ -0,0 +1,208 @@
+package merchant
+
+import (
+	"errors"
+
+	"github.com/huydq/test/internal/controller/merchant/mapper"
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
+	"github.com/huydq/test/internal/middleware"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+	response "github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
+	messages "github.com/huydq/test/internal/pkg/utils/messages"
+	"github.com/huydq/test/internal/usecase/merchant"
+	"github.com/labstack/echo/v4"
+)
+
+// ListBankAccounts handles the request to list the bank account history of a merchant
+func (c *MerchantController) ListBankAccounts(ctx echo.Context) error {
+	merchantID, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	accounts, err := c.bankAccountUsecase.ListBankAccounts(ctx.Request().Context(), merchantID)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgListMerchantBankAccountsFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgListMerchantBankAccountsSuccess, mapper.ToMerchantBankAccountListSuccessResponse(accounts))
+}
+
+// GetBankAccount handles the request to get a bank account of a merchant
+func (c *MerchantController) GetBankAccount(ctx echo.Context) error {
+	merchantID, accountID, err := c.getBankAccountParams(ctx)
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	account, err := c.bankAccountUsecase.GetBankAccount(ctx.Request().Context(), merchantID, accountID)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgGetMerchantBankAccountFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgGetMerchantBankAccountSuccess, mapper.ToMerchantBankAccountSuccessResponse(account))
+}
+
+// CreateBankAccount handles the request to register the bank account of a merchant
+func (c *MerchantController) CreateBankAccount(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	merchantID, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	var request generated.MerchantBankAccountRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	input := mapper.ToMerchantBankAccountInputData(&request, merchantID, userID)
+	account, err := c.bankAccountUsecase.RequestCreate(ctx.Request().Context(), input)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgRequestMerchantBankAccountFailed, err))
+	}
+
+	setBankAccountAuditLogData(ctx, account)
+
+	return response.SendCreated(ctx, messages.MsgRequestMerchantBankAccountSuccess, mapper.ToMerchantBankAccountSuccessResponse(account))
+}
+
+// UpdateBankAccount handles the request to change the active bank account of a merchant
+func (c *MerchantController) UpdateBankAccount(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	merchantID, accountID, err := c.getBankAccountParams(ctx)
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	var request generated.MerchantBankAccountRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	input := mapper.ToMerchantBankAccountInputData(&request, merchantID, userID)
+	account, err := c.bankAccountUsecase.RequestUpdate(ctx.Request().Context(), accountID, input)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgRequestMerchantBankAccountFailed, err))
+	}
+
+	setBankAccountAuditLogData(ctx, account)
+
+	return response.SendCreated(ctx, messages.MsgRequestMerchantBankAccountSuccess, mapper.ToMerchantBankAccountSuccessResponse(account))
+}
+
+// DeleteBankAccount handles the request to delete the active bank account of a merchant
+func (c *MerchantController) DeleteBankAccount(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	merchantID, accountID, err := c.getBankAccountParams(ctx)
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	account, err := c.bankAccountUsecase.RequestDelete(ctx.Request().Context(), merchantID, accountID, userID)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgRequestMerchantBankAccountFailed, err))
+	}
+
+	setBankAccountAuditLogData(ctx, account)
+
+	return response.SendCreated(ctx, messages.MsgRequestMerchantBankAccountSuccess, mapper.ToMerchantBankAccountSuccessResponse(account))
+}
+
+// ConfirmBankAccount handles the request to confirm a pending bank account change
+func (c *MerchantController) ConfirmBankAccount(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	merchantID, accountID, err := c.getBankAccountParams(ctx)
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	account, err := c.bankAccountUsecase.Confirm(ctx.Request().Context(), merchantID, accountID, userID)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgConfirmMerchantBankAccountFailed, err))
+	}
+
+	setBankAccountAuditLogData(ctx, account)
+
+	return response.SendOK(ctx, messages.MsgConfirmMerchantBankAccountSuccess, mapper.ToMerchantBankAccountSuccessResponse(account))
+}
+
+// RejectBankAccount handles the request to reject a pending bank account change
+func (c *MerchantController) RejectBankAccount(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	merchantID, accountID, err := c.getBankAccountParams(ctx)
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	account, err := c.bankAccountUsecase.Reject(ctx.Request().Context(), merchantID, accountID, userID)
+	if err != nil {
+		return response.SendError(ctx, toBankAccountError(messages.MsgRejectMerchantBankAccountFailed, err))
+	}
+
+	setBankAccountAuditLogData(ctx, account)
+
+	return response.SendOK(ctx, messages.MsgRejectMerchantBankAccountSuccess, mapper.ToMerchantBankAccountSuccessResponse(account))
+}
+
+// setBankAccountAuditLogData records the merchant and the account of the change in the audit log
+func setBankAccountAuditLogData(ctx echo.Context, account *merchantModel.MerchantBankAccount) {
+	ctx.Set(string(middleware.ContextKey_AuditLogMerchantID), &account.MerchantID)
+	ctx.Set(string(middleware.ContextKey_AuditLogMerchantBankAccountID), &account.ID)
+}
+
+func (c *MerchantController) getBankAccountParams(ctx echo.Context) (int, int, error) {
+	merchantID, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	accountID, err := c.GetIDParam(ctx, "accountId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return merchantID, accountID, nil
+}
+
+// toBankAccountError maps usecase errors to API errors
+func toBankAccountError(message string, err error) error {
+	switch {
+	case errors.Is(err, merchant.ErrMerchantNotFound):
+		return appErrors.NotFoundError(messages.MsgMerchantNotFound)
+	case errors.Is(err, merchant.ErrBankAccountNotFound):
+		return appErrors.NotFoundError(messages.MsgMerchantBankAccountNotFound)
+	case errors.Is(err, merchant.ErrInvalidBankAccountType),
+		errors.Is(err, merchant.ErrInvalidAccountName),
+		errors.Is(err, merchant.ErrBankAccountAlreadyRegistered),
+		errors.Is(err, merchant.ErrBankAccountNotActive),
+		errors.Is(err, merchant.ErrBankAccountChangePending),
+		errors.Is(err, merchant.ErrBankAccountChangeNotPending),
+		errors.Is(err, merchant.ErrBankAccountSelfConfirmation),
+		errors.Is(err, merchant.ErrBankAccountPreviousNotAvailable):
+		return appErrors.BadRequestError(message, err.Error())
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
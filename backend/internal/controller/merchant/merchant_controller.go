@@ -1,24 +1,38 @@
 package merchant
 
 import (
+	"errors"
+
 	"github.com/huydq/test/internal/controller/base"
 	"github.com/huydq/test/internal/controller/merchant/mapper"
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/middleware"
 	generated "github.com/huydq/test/internal/pkg/api/generated"
 	response "github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
 	messages "github.com/huydq/test/internal/pkg/utils/messages"
 	"github.com/huydq/test/internal/usecase/merchant"
 	"github.com/labstack/echo/v4"
 )
 
+// maxReviewStatusUploadSize is the largest review status CSV accepted (5MB)
+const maxReviewStatusUploadSize = 5 << 20
+
 type MerchantController struct {
 	base.BaseController
-	merchantUsecase merchant.MerchantManagementUsecase
+	merchantUsecase    merchant.MerchantManagementUsecase
+	bankAccountUsecase merchant.MerchantBankAccountUsecase
 }
 
-func NewMerchantController(merchantUsecase merchant.MerchantManagementUsecase) *MerchantController {
+func NewMerchantController(
+	merchantUsecase merchant.MerchantManagementUsecase,
+	bankAccountUsecase merchant.MerchantBankAccountUsecase,
+) *MerchantController {
 	return &MerchantController{
-		BaseController:  *base.NewBaseController(),
-		merchantUsecase: merchantUsecase,
+		BaseController:     *base.NewBaseController(),
+		merchantUsecase:    merchantUsecase,
+		bankAccountUsecase: bankAccountUsecase,
 	}
 }
 
@@ -40,3 +54,116 @@ func (c *MerchantController) ListMerchants(ctx echo.Context) error {
 
 	return response.SendOK(ctx, messages.MsgListMerchantsSuccess, merchantListData)
 }
+
+// GetMerchant handles the request to get a merchant with its latest payment provider review
+func (c *MerchantController) GetMerchant(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	result, err := c.merchantUsecase.GetMerchant(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toMerchantError(messages.MsgGetMerchantFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgGetMerchantSuccess, mapper.ToMerchantSuccessResponse(result))
+}
+
+// CreateMerchant handles the request to create a merchant
+func (c *MerchantController) CreateMerchant(ctx echo.Context) error {
+	var request generated.CreateMerchantRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	result, err := c.merchantUsecase.CreateMerchant(ctx.Request().Context(), mapper.ToCreateMerchantInputData(&request))
+	if err != nil {
+		return response.SendError(ctx, toMerchantError(messages.MsgCreateMerchantFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogMerchantID), &result.ID)
+
+	return response.SendCreated(ctx, messages.MsgCreateMerchantSuccess, mapper.ToMerchantSuccessResponse(result))
+}
+
+// UpdateMerchant handles the request to update a merchant
+func (c *MerchantController) UpdateMerchant(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	var request generated.UpdateMerchantRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	result, err := c.merchantUsecase.UpdateMerchant(ctx.Request().Context(), id, mapper.ToUpdateMerchantInputData(&request))
+	if err != nil {
+		return response.SendError(ctx, toMerchantError(messages.MsgUpdateMerchantFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogMerchantID), &result.ID)
+
+	return response.SendOK(ctx, messages.MsgUpdateMerchantSuccess, mapper.ToMerchantSuccessResponse(result))
+}
+
+// DeleteMerchant handles the request to delete a merchant
+func (c *MerchantController) DeleteMerchant(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	if err := c.merchantUsecase.DeleteMerchant(ctx.Request().Context(), id); err != nil {
+		return response.SendError(ctx, toMerchantError(messages.MsgDeleteMerchantFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogMerchantID), &id)
+
+	return response.SendOK(ctx, messages.MsgDeleteMerchantSuccess, nil)
+}
+
+// UploadReviewStatuses handles the upload of a CSV of merchant review statuses
+func (c *MerchantController) UploadReviewStatuses(ctx echo.Context) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return response.SendError(ctx, appErrors.BadRequestError(messages.MsgUploadMerchantReviewStatusesFailed, messages.MsgUploadFileRequired))
+	}
+	if fileHeader.Size > maxReviewStatusUploadSize {
+		return response.SendError(ctx, appErrors.BadRequestError(messages.MsgUploadMerchantReviewStatusesFailed, messages.MsgUploadFileTooLarge))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return response.SendError(ctx, appErrors.InternalErrorWithCause(messages.MsgUploadMerchantReviewStatusesFailed, err))
+	}
+	defer file.Close()
+
+	input := &inputdata.MerchantReviewStatusUploadInputData{File: file}
+	output, err := c.merchantUsecase.UploadReviewStatuses(ctx.Request().Context(), input)
+	if errors.Is(err, merchant.ErrReviewStatusUploadHasErrors) {
+		return response.SendError(ctx, appErrors.BadRequestError(err.Error(), mapper.ToMerchantReviewStatusUploadResult(output)))
+	}
+	if err != nil {
+		return response.SendError(ctx, toMerchantError(messages.MsgUploadMerchantReviewStatusesFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgUploadMerchantReviewStatusesSuccess, mapper.ToMerchantReviewStatusUploadResult(output))
+}
+
+func toMerchantError(message string, err error) error {
+	switch {
+	case errors.Is(err, merchant.ErrMerchantNotFound):
+		return appErrors.NotFoundError(messages.MsgMerchantNotFound)
+	case errors.Is(err, merchant.ErrPaymentMerchantIDAlreadyExists),
+		errors.Is(err, service.ErrMerchantReviewStatusCSVEmpty),
+		errors.Is(err, service.ErrMerchantReviewStatusCSVHeader),
+		errors.Is(err, service.ErrMerchantReviewStatusCSVTooManyRows),
+		errors.Is(err, service.ErrMerchantReviewStatusCSVMalformed):
+		return appErrors.BadRequestError(message, err.Error())
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
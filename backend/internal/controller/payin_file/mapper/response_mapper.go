@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	model "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	generated "github.com/huydq/test/internal/pkg/api/generated"
+)
+
+type PayinFileImportErrorsSuccessResponse struct {
+	ImportErrors []generated.PayinImportError `json:"import_errors"`
+}
+
+func ToPayinFileImportErrorsSuccessResponse(importErrors []*model.PayinImportError) *PayinFileImportErrorsSuccessResponse {
+	importErrorResponses := make([]generated.PayinImportError, len(importErrors))
+	for i, importError := range importErrors {
+		importErrorResponses[i] = toPayinImportErrorResponse(importError)
+	}
+
+	return &PayinFileImportErrorsSuccessResponse{
+		ImportErrors: importErrorResponses,
+	}
+}
+
+func toPayinImportErrorResponse(importError *model.PayinImportError) generated.PayinImportError {
+	return generated.PayinImportError{
+		Id:          importError.ID,
+		PayinFileId: importError.PayinFileID,
+		LineNumber:  importError.LineNumber,
+		ColumnName:  importError.ColumnName,
+		RawValue:    importError.RawValue,
+		Reason:      importError.Reason,
+		Policy:      int(importError.Policy),
+		CreatedAt:   importError.CreatedAt,
+	}
+}
+
+type PayinFilePaymentMethodTotalsSuccessResponse struct {
+	PaymentMethodTotals        []generated.PaymentMethodTotal `json:"payment_method_totals"`
+	TransactionCount           int                            `json:"transaction_count"`
+	TransactionAmount          float64                        `json:"transaction_amount"`
+	UndetailedTransactionCount int                            `json:"undetailed_transaction_count"`
+}
+
+func ToPayinFilePaymentMethodTotalsSuccessResponse(breakdown *paypayModel.PaymentMethodBreakdown) *PayinFilePaymentMethodTotalsSuccessResponse {
+	totalResponses := make([]generated.PaymentMethodTotal, len(breakdown.Totals))
+	for i, total := range breakdown.Totals {
+		totalResponses[i] = generated.PaymentMethodTotal{
+			PaymentMethod:    int(total.PaymentMethod),
+			Amount:           total.Amount,
+			TransactionCount: total.TransactionCount,
+		}
+	}
+
+	return &PayinFilePaymentMethodTotalsSuccessResponse{
+		PaymentMethodTotals:        totalResponses,
+		TransactionCount:           breakdown.TransactionCount,
+		TransactionAmount:          breakdown.TransactionAmount,
+		UndetailedTransactionCount: breakdown.UndetailedTransactionCount,
+	}
+}
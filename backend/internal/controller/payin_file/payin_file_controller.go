@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/huydq/test/internal/controller/base"
+	"github.com/huydq/test/internal/controller/payin_file/mapper"
+	response "github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
+	messages "github.com/huydq/test/internal/pkg/utils/messages"
+	usecase "github.com/huydq/test/internal/usecase/payin_file"
+	"github.com/labstack/echo/v4"
+)
+
+type PayinFileController struct {
+	base.BaseController
+	payinFileUsecase usecase.PayinFileUsecase
+}
+
+func NewPayinFileController(payinFileUsecase usecase.PayinFileUsecase) *PayinFileController {
+	return &PayinFileController{
+		BaseController:   *base.NewBaseController(),
+		payinFileUsecase: payinFileUsecase,
+	}
+}
+
+// ListPayinFileImportErrors handles the request to list the row errors of the last import of a payin file
+func (c *PayinFileController) ListPayinFileImportErrors(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	importErrors, err := c.payinFileUsecase.ListPayinFileImportErrors(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toPayinFileError(messages.MsgListPayinFileImportErrorsFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgListPayinFileImportErrorsSuccess, mapper.ToPayinFileImportErrorsSuccessResponse(importErrors))
+}
+
+// GetPayinFilePaymentMethodTotals handles the request to sum the PayPay transactions of a payin file per payment method
+func (c *PayinFileController) GetPayinFilePaymentMethodTotals(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	breakdown, err := c.payinFileUsecase.GetPayinFilePaymentMethodTotals(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toPayinFileError(messages.MsgGetPayinFilePaymentMethodTotalsFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgGetPayinFilePaymentMethodTotalsSuccess, mapper.ToPayinFilePaymentMethodTotalsSuccessResponse(breakdown))
+}
+
+func toPayinFileError(message string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrPayinFileNotFound):
+		return appErrors.NotFoundError(messages.MsgPayinFileNotFound)
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
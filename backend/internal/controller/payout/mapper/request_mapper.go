@@ -3,12 +3,16 @@ package mapper
 import (
 	"time"
 
+	"github.com/huydq/test/internal/datastructure/inputdata"
 	payoutModel "github.com/huydq/test/internal/domain/model/payout"
 	"github.com/huydq/test/internal/domain/model/util"
 	objectPayout "github.com/huydq/test/internal/domain/object/payout"
 	generated "github.com/huydq/test/internal/pkg/api/generated"
 )
 
+// sendingDateLayout is the layout of the sending_date request field
+const sendingDateLayout = "2006-01-02"
+
 // ToPayoutFilter converts the request to a payout filter
 func ToPayoutFilter(request *generated.PayoutListRequest) *payoutModel.PayoutFilter {
 	filter := payoutModel.NewPayoutFilter()
@@ -56,3 +60,50 @@ func ToPayoutFilter(request *generated.PayoutListRequest) *payoutModel.PayoutFil
 
 	return filter
 }
+
+// ToCreatePayoutInput converts the create request to usecase input
+func ToCreatePayoutInput(request generated.CreatePayoutRequest, userID int) (*inputdata.CreatePayoutInputData, error) {
+	sendingDate, err := time.ParseInLocation(sendingDateLayout, request.SendingDate, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inputdata.CreatePayoutInputData{
+		UserID:      userID,
+		SendingDate: sendingDate,
+		Records:     toPayoutRecordInputs(request),
+	}, nil
+}
+
+// ToUpdatePayoutInput converts the update request to usecase input
+func ToUpdatePayoutInput(request generated.UpdatePayoutRequest) (*inputdata.UpdatePayoutInputData, error) {
+	sendingDate, err := time.ParseInLocation(sendingDateLayout, request.SendingDate, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inputdata.UpdatePayoutInputData{
+		SendingDate: sendingDate,
+		// Both requests share the same record schema
+		Records: toPayoutRecordInputs(generated.CreatePayoutRequest(request)),
+	}, nil
+}
+
+func toPayoutRecordInputs(request generated.CreatePayoutRequest) []inputdata.PayoutRecordInputData {
+	records := make([]inputdata.PayoutRecordInputData, len(request.Records))
+	for i, r := range request.Records {
+		records[i] = inputdata.PayoutRecordInputData{
+			ShopID:          r.ShopId,
+			TransactionID:   r.TransactionId,
+			BankName:        r.BankName,
+			BankCode:        r.BankCode,
+			BranchName:      r.BranchName,
+			BranchCode:      r.BranchCode,
+			BankAccountType: r.BankAccountType,
+			AccountNo:       r.AccountNo,
+			AccountName:     r.AccountName,
+			Amount:          r.Amount,
+		}
+	}
+	return records
+}
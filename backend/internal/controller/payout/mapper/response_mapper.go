@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/huydq/test/internal/controller/base"
+	approvalModel "github.com/huydq/test/internal/domain/model/approval"
 	payoutModel "github.com/huydq/test/internal/domain/model/payout"
+	payoutRecordModel "github.com/huydq/test/internal/domain/model/payout_record"
 )
 
 type PayoutResponse struct {
@@ -16,6 +18,7 @@ type PayoutResponse struct {
 	SendingDate           time.Time `json:"sending_date"`
 	SentDate              time.Time `json:"sent_date"`
 	AozoraTransferApplyNo string    `json:"aozora_transfer_apply_no"`
+	ApprovalStatus        *int      `json:"approval_status"`
 	PayoutRecordCount     int       `json:"payout_record_count"`
 	PayoutRecordSumAmount float64   `json:"payout_record_sum_amount"`
 	CreatedAt             string    `json:"created_at"`
@@ -23,6 +26,53 @@ type PayoutResponse struct {
 	PayoutIssuer          string    `json:"payout_issuer"`
 }
 
+type PayoutRecordResponse struct {
+	ID                   int        `json:"id"`
+	PayoutID             int        `json:"payout_id"`
+	ShopID               int        `json:"shop_id"`
+	TransactionID        int        `json:"transaction_id"`
+	BankName             string     `json:"bank_name"`
+	BankCode             string     `json:"bank_code"`
+	BranchName           string     `json:"branch_name"`
+	BranchCode           string     `json:"branch_code"`
+	BankAccountType      int        `json:"bank_account_type"`
+	AccountNo            string     `json:"account_no"`
+	AccountName          string     `json:"account_name"`
+	Amount               float64    `json:"amount"`
+	TransferStatus       int        `json:"transfer_status"`
+	TransferRequestedAt  *time.Time `json:"transfer_requested_at"`
+	TransferExecutedAt   *time.Time `json:"transfer_executed_at"`
+	TransferRequestError string     `json:"transfer_request_error"`
+	CreatedAt            string     `json:"created_at"`
+	UpdatedAt            string     `json:"updated_at"`
+}
+
+type ApprovalStageResponse struct {
+	ID                      int    `json:"id"`
+	ApprovalWorkflowStageID int    `json:"approval_workflow_stage_id"`
+	ApproverID              int    `json:"approver_id"`
+	ApprovalResult          int    `json:"approval_result"`
+	CreatedAt               string `json:"created_at"`
+}
+
+type ApprovalResponse struct {
+	ID                 int                     `json:"id"`
+	ApprovalWorkflowID int                     `json:"approval_workflow_id"`
+	ApprovalStatus     int                     `json:"approval_status"`
+	RequestedBy        *int                    `json:"requested_by"`
+	Stages             []ApprovalStageResponse `json:"stages"`
+}
+
+type PayoutDetailResponse struct {
+	PayoutResponse
+	Approval *ApprovalResponse      `json:"approval"`
+	Records  []PayoutRecordResponse `json:"records"`
+}
+
+type PayoutDetailSuccessResponse struct {
+	Payout PayoutDetailResponse `json:"payout"`
+}
+
 type PayoutListSuccessResponse struct {
 	Payouts []PayoutResponse `json:"payouts"`
 	base.PaginationResponse
@@ -47,6 +97,11 @@ func toPayoutResponse(p *payoutModel.Payout) PayoutResponse {
 		response.PayoutIssuer = p.User.FullName
 	}
 
+	if p.Approval != nil {
+		status := int(p.Approval.ApprovalStatus)
+		response.ApprovalStatus = &status
+	}
+
 	return response
 }
 
@@ -66,3 +121,66 @@ func ToPayoutListSuccessResponse(payouts []*payoutModel.Payout, page, pageSize,
 		},
 	}
 }
+
+func toPayoutRecordResponse(r *payoutRecordModel.PayoutRecord) PayoutRecordResponse {
+	return PayoutRecordResponse{
+		ID:                   r.ID,
+		PayoutID:             r.PayoutID,
+		ShopID:               r.ShopID,
+		TransactionID:        r.TransactionID,
+		BankName:             r.BankName,
+		BankCode:             r.BankCode,
+		BranchName:           r.BranchName,
+		BranchCode:           r.BranchCode,
+		BankAccountType:      int(r.BankAccountType),
+		AccountNo:            r.AccountNo,
+		AccountName:          r.AccountName,
+		Amount:               r.Amount,
+		TransferStatus:       int(r.TransferStatus),
+		TransferRequestedAt:  r.TransferRequestedAt,
+		TransferExecutedAt:   r.TransferExecutedAt,
+		TransferRequestError: r.TransferRequestError,
+		CreatedAt:            r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            r.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toApprovalResponse(a *approvalModel.Approval) *ApprovalResponse {
+	if a == nil {
+		return nil
+	}
+
+	stages := make([]ApprovalStageResponse, len(a.ApprovalStages))
+	for i, stage := range a.ApprovalStages {
+		stages[i] = ApprovalStageResponse{
+			ID:                      stage.ID,
+			ApprovalWorkflowStageID: stage.ApprovalWorkflowStageID,
+			ApproverID:              stage.ApproverID,
+			ApprovalResult:          int(stage.ApprovalResult),
+			CreatedAt:               stage.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &ApprovalResponse{
+		ID:                 a.ID,
+		ApprovalWorkflowID: a.ApprovalWorkflowID,
+		ApprovalStatus:     int(a.ApprovalStatus),
+		RequestedBy:        a.RequestedBy,
+		Stages:             stages,
+	}
+}
+
+func ToPayoutDetailSuccessResponse(payout *payoutModel.Payout) PayoutDetailSuccessResponse {
+	records := make([]PayoutRecordResponse, len(payout.PayoutRecords))
+	for i, r := range payout.PayoutRecords {
+		records[i] = toPayoutRecordResponse(r)
+	}
+
+	return PayoutDetailSuccessResponse{
+		Payout: PayoutDetailResponse{
+			PayoutResponse: toPayoutResponse(payout),
+			Approval:       toApprovalResponse(payout.Approval),
+			Records:        records,
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package payout
+
+import (
+	"errors"
+
+	"github.com/huydq/test/internal/controller/payout/mapper"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/middleware"
+	"github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
+	messages "github.com/huydq/test/internal/pkg/utils/messages"
+	approvalUsecase "github.com/huydq/test/internal/usecase/payout_approval"
+	"github.com/labstack/echo/v4"
+)
+
+func (c *PayoutController) SubmitPayout(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	payout, err := c.payoutApprovalUsecase.SubmitPayout(ctx.Request().Context(), id, userID)
+	if err != nil {
+		return response.SendError(ctx, toPayoutApprovalError(messages.MsgSubmitPayoutFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &payout.ID)
+
+	return response.SendOK(ctx, messages.MsgSubmitPayoutSuccess, mapper.ToPayoutDetailSuccessResponse(payout))
+}
+
+func (c *PayoutController) ApprovePayout(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	payout, err := c.payoutApprovalUsecase.ApprovePayout(ctx.Request().Context(), id, userID)
+	if err != nil {
+		return response.SendError(ctx, toPayoutApprovalError(messages.MsgApprovePayoutFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &payout.ID)
+
+	return response.SendOK(ctx, messages.MsgApprovePayoutSuccess, mapper.ToPayoutDetailSuccessResponse(payout))
+}
+
+func (c *PayoutController) RejectPayout(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	payout, err := c.payoutApprovalUsecase.RejectPayout(ctx.Request().Context(), id, userID)
+	if err != nil {
+		return response.SendError(ctx, toPayoutApprovalError(messages.MsgRejectPayoutFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &payout.ID)
+
+	return response.SendOK(ctx, messages.MsgRejectPayoutSuccess, mapper.ToPayoutDetailSuccessResponse(payout))
+}
+
+// toPayoutApprovalError maps approval usecase errors to API errors
+func toPayoutApprovalError(message string, err error) error {
+	switch {
+	case errors.Is(err, approvalUsecase.ErrPayoutNotFound),
+		errors.Is(err, service.ErrApprovalNotFound):
+		return appErrors.NotFoundError(messages.MsgPayoutNotFound)
+	case errors.Is(err, service.ErrApproverRoleNotAllowed),
+		errors.Is(err, service.ErrApproverIsRequester):
+		return appErrors.ForbiddenError(err.Error())
+	case errors.Is(err, approvalUsecase.ErrApproverNotFound),
+		errors.Is(err, approvalUsecase.ErrPayoutNotSubmittable),
+		errors.Is(err, approvalUsecase.ErrPayoutAlreadyUnderApproval),
+		errors.Is(err, approvalUsecase.ErrPayoutNotUnderApproval),
+		errors.Is(err, service.ErrApprovalWorkflowNotFound),
+		errors.Is(err, service.ErrApprovalWorkflowHasNoStages),
+		errors.Is(err, service.ErrApprovalAlreadyCompleted),
+		errors.Is(err, service.ErrApproverAlreadyActed):
+		return appErrors.BadRequestError(message, err.Error())
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
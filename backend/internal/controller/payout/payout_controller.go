@@ -1,24 +1,37 @@
 package payout
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+
 	"github.com/huydq/test/internal/controller/base"
 	"github.com/huydq/test/internal/controller/payout/mapper"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/middleware"
 	"github.com/huydq/test/internal/pkg/api/generated"
 	"github.com/huydq/test/internal/pkg/common/response"
+	appErrors "github.com/huydq/test/internal/pkg/errors"
 	messages "github.com/huydq/test/internal/pkg/utils/messages"
 	usecase "github.com/huydq/test/internal/usecase/payout"
+	approvalUsecase "github.com/huydq/test/internal/usecase/payout_approval"
 	"github.com/labstack/echo/v4"
 )
 
 type PayoutController struct {
 	base.BaseController
-	payoutUsecase usecase.PayoutUsecase
+	payoutUsecase         usecase.PayoutUsecase
+	payoutApprovalUsecase approvalUsecase.PayoutApprovalUsecase
 }
 
-func NewPayoutController(payoutUsecase usecase.PayoutUsecase) *PayoutController {
+func NewPayoutController(
+	payoutUsecase usecase.PayoutUsecase,
+	payoutApprovalUsecase approvalUsecase.PayoutApprovalUsecase,
+) *PayoutController {
 	return &PayoutController{
-		BaseController: *base.NewBaseController(),
-		payoutUsecase:  payoutUsecase,
+		BaseController:        *base.NewBaseController(),
+		payoutUsecase:         payoutUsecase,
+		payoutApprovalUsecase: payoutApprovalUsecase,
 	}
 }
 
@@ -45,3 +58,123 @@ func (c *PayoutController) ListPayouts(ctx echo.Context) error {
 
 	return response.SendOK(ctx, messages.MsgListPayoutsSuccess, payoutListSuccessMapper)
 }
+
+func (c *PayoutController) GetPayout(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	payout, err := c.payoutUsecase.GetPayoutByID(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toPayoutError(messages.MsgGetPayoutFailed, err))
+	}
+
+	return response.SendOK(ctx, messages.MsgGetPayoutSuccess, mapper.ToPayoutDetailSuccessResponse(payout))
+}
+
+func (c *PayoutController) CreatePayout(ctx echo.Context) error {
+	userID, ok := ctx.Get(string(middleware.ContextKey_AuthUserIDKey)).(int)
+	if !ok {
+		return response.SendError(ctx, appErrors.UnauthorizedError(messages.MsgUnauthenticated))
+	}
+
+	var request generated.CreatePayoutRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	input, err := mapper.ToCreatePayoutInput(request, userID)
+	if err != nil {
+		return response.SendError(ctx, appErrors.BadRequestError(messages.MsgCreatePayoutFailed, err.Error()))
+	}
+
+	payout, err := c.payoutUsecase.CreatePayout(ctx.Request().Context(), input)
+	if err != nil {
+		return response.SendError(ctx, toPayoutError(messages.MsgCreatePayoutFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &payout.ID)
+
+	return response.SendCreated(ctx, messages.MsgCreatePayoutSuccess, mapper.ToPayoutDetailSuccessResponse(payout))
+}
+
+func (c *PayoutController) UpdatePayout(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	var request generated.UpdatePayoutRequest
+	if err := c.BindAndValidate(ctx, &request); err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	input, err := mapper.ToUpdatePayoutInput(request)
+	if err != nil {
+		return response.SendError(ctx, appErrors.BadRequestError(messages.MsgUpdatePayoutFailed, err.Error()))
+	}
+
+	payout, err := c.payoutUsecase.UpdatePayout(ctx.Request().Context(), id, input)
+	if err != nil {
+		return response.SendError(ctx, toPayoutError(messages.MsgUpdatePayoutFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &payout.ID)
+
+	return response.SendOK(ctx, messages.MsgUpdatePayoutSuccess, mapper.ToPayoutDetailSuccessResponse(payout))
+}
+
+func (c *PayoutController) DeletePayout(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	if err := c.payoutUsecase.DeletePayout(ctx.Request().Context(), id); err != nil {
+		return response.SendError(ctx, toPayoutError(messages.MsgDeletePayoutFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &id)
+
+	return response.SendOK(ctx, messages.MsgDeletePayoutSuccess, nil)
+}
+
+func (c *PayoutController) DownloadZenginTransferFile(ctx echo.Context) error {
+	id, err := c.GetIDParam(ctx, "id")
+	if err != nil {
+		return response.SendError(ctx, err)
+	}
+
+	file, err := c.payoutUsecase.ExportZenginTransferFile(ctx.Request().Context(), id)
+	if err != nil {
+		return response.SendError(ctx, toPayoutError(messages.MsgExportZenginFailed, err))
+	}
+
+	ctx.Set(string(middleware.ContextKey_AuditLogPayoutID), &id)
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.FileName))
+	return ctx.Blob(http.StatusOK, "text/plain; charset=Shift_JIS", file.Content)
+}
+
+// toPayoutError maps usecase errors to API errors
+func toPayoutError(message string, err error) error {
+	var zenginErr *service.ZenginValidationError
+
+	switch {
+	case errors.Is(err, usecase.ErrPayoutNotFound):
+		return appErrors.NotFoundError(messages.MsgPayoutNotFound)
+	case errors.Is(err, usecase.ErrInvalidInput),
+		errors.Is(err, usecase.ErrPayoutNotEditable),
+		errors.Is(err, usecase.ErrPayoutAwaitingTransfer),
+		errors.Is(err, usecase.ErrPayoutAlreadyProcessed),
+		errors.Is(err, usecase.ErrPayoutUnderApproval),
+		errors.Is(err, usecase.ErrPayoutNotApproved),
+		errors.Is(err, usecase.ErrBankAccountNotActive),
+		errors.Is(err, usecase.ErrBankAccountMismatch),
+		errors.As(err, &zenginErr):
+		return appErrors.BadRequestError(message, err.Error())
+	default:
+		return appErrors.InternalErrorWithCause(message, err)
+	}
+}
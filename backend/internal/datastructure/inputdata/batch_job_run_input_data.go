@@ -0,0 +1,17 @@
+package inputdata
+
+import "time"
+
+// BatchJobRunListInputData represents the filters for listing batch job runs
+type BatchJobRunListInputData struct {
+	Page     int `json:"page" validate:"min=1"`
+	PageSize int `json:"page_size" validate:"min=1,max=100"`
+
+	CommandName    string     `json:"command_name"`
+	Status         []int      `json:"status"`
+	StartedAtStart *time.Time `json:"started_at_start"`
+	StartedAtEnd   *time.Time `json:"started_at_end"`
+
+	SortField string `json:"sort_field"`
+	SortOrder string `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+}
@@ -0,0 +1,20 @@
+package inputdata
+
+import "time"
+
+// InvoiceListInputData represents the filters for listing the qualified invoices
+type InvoiceListInputData struct {
+	Page     int `json:"page" validate:"min=1"`
+	PageSize int `json:"page_size" validate:"min=1,max=100"`
+
+	RegistrationNumber   string     `json:"registration_number"`
+	PaymentMerchantID    string     `json:"payment_merchant_id"`
+	MerchantBusinessName string     `json:"merchant_business_name"`
+	IssueDateStart       *time.Time `json:"issue_date_start"`
+	IssueDateEnd         *time.Time `json:"issue_date_end"`
+	CutoffDate           *time.Time `json:"cutoff_date"`
+	Duplicate            *bool      `json:"duplicate"`
+
+	SortField string `json:"sort_field"`
+	SortOrder string `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+}
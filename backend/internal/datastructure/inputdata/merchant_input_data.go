@@ -1,6 +1,7 @@
 package inputdata
 
 import (
+	"io"
 	"time"
 )
 
@@ -16,3 +17,30 @@ type MerchantListInputData struct {
 	SortField string `json:"sort_field"`
 	SortOrder string `json:"sort_order" validate:"omitempty,oneof=asc desc"`
 }
+
+// MerchantBankAccountInputData represents a request to register or change a merchant's bank account
+type MerchantBankAccountInputData struct {
+	MerchantID      int    `json:"merchant_id"`
+	UserID          int    `json:"user_id"`
+	BankName        string `json:"bank_name"`
+	BankCode        string `json:"bank_code"`
+	BranchName      string `json:"branch_name"`
+	BranchCode      string `json:"branch_code"`
+	BankAccountType int    `json:"bank_account_type"`
+	AccountNo       string `json:"account_no"`
+	AccountName     string `json:"account_name"`
+}
+
+// MerchantInputData represents a request to create or update a merchant
+type MerchantInputData struct {
+	PaymentProviderID int    `json:"payment_provider_id"`
+	PaymentMerchantID string `json:"payment_merchant_id"`
+	MerchantName      string `json:"merchant_name"`
+	ShopID            int    `json:"shop_id"`
+	ShopURL           string `json:"shop_url"`
+}
+
+// MerchantReviewStatusUploadInputData represents an uploaded CSV of merchant IDs and review statuses
+type MerchantReviewStatusUploadInputData struct {
+	File io.Reader
+}
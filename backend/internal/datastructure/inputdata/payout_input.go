@@ -0,0 +1,30 @@
+package inputdata
+
+import "time"
+
+// PayoutRecordInputData represents a single transfer within a payout request
+type PayoutRecordInputData struct {
+	ShopID          int     `json:"shop_id"`
+	TransactionID   int     `json:"transaction_id"`
+	BankName        string  `json:"bank_name"`
+	BankCode        string  `json:"bank_code"`
+	BranchName      string  `json:"branch_name"`
+	BranchCode      string  `json:"branch_code"`
+	BankAccountType int     `json:"bank_account_type"`
+	AccountNo       string  `json:"account_no"`
+	AccountName     string  `json:"account_name"`
+	Amount          float64 `json:"amount"`
+}
+
+// CreatePayoutInputData represents a draft payout creation request
+type CreatePayoutInputData struct {
+	UserID      int                     `json:"user_id"`
+	SendingDate time.Time               `json:"sending_date"`
+	Records     []PayoutRecordInputData `json:"records"`
+}
+
+// UpdatePayoutInputData represents a draft payout update request, replacing all records
+type UpdatePayoutInputData struct {
+	SendingDate time.Time               `json:"sending_date"`
+	Records     []PayoutRecordInputData `json:"records"`
+}
@@ -0,0 +1,20 @@
+package outputdata
+
+// MerchantReviewStatusUploadOutput reports the result of a merchant review status upload
+type MerchantReviewStatusUploadOutput struct {
+	Applied   bool                                   `json:"applied"`
+	TotalRows int                                    `json:"total_rows"`
+	ValidRows int                                    `json:"valid_rows"`
+	ErrorRows int                                    `json:"error_rows"`
+	Rows      []*MerchantReviewStatusUploadRowOutput `json:"rows"`
+}
+
+// MerchantReviewStatusUploadRowOutput reports the result of a single uploaded row
+type MerchantReviewStatusUploadRowOutput struct {
+	RowNumber                    int      `json:"row_number"`
+	MerchantID                   *int     `json:"merchant_id"`
+	MerchantName                 *string  `json:"merchant_name"`
+	PreviousMerchantReviewStatus *int     `json:"previous_merchant_review_status"`
+	MerchantReviewStatus         *int     `json:"merchant_review_status"`
+	Errors                       []string `json:"errors"`
+}
@@ -1,6 +1,7 @@
 package approval
 
 import (
+	approvalStageModel "github.com/huydq/test/internal/domain/model/approval_stage"
 	object "github.com/huydq/test/internal/domain/object/approval"
 	util "github.com/huydq/test/internal/domain/object/basedatetime"
 )
@@ -11,6 +12,7 @@ type ApprovalParams struct {
 	util.BaseColumnTimestamp
 	ApprovalWorkflowID int
 	ApprovalStatus     object.ApprovalStatus
+	RequestedBy        *int
 }
 
 type Approval struct {
@@ -19,6 +21,8 @@ type Approval struct {
 
 	ApprovalWorkflowID int
 	ApprovalStatus     object.ApprovalStatus
+	RequestedBy        *int
+	ApprovalStages     []*approvalStageModel.ApprovalStage
 }
 
 // NewApproval creates a new approval instance with the given parameters
@@ -27,6 +31,7 @@ func NewApproval(params ApprovalParams) *Approval {
 		ID:                  params.ID,
 		ApprovalWorkflowID:  params.ApprovalWorkflowID,
 		ApprovalStatus:      params.ApprovalStatus,
+		RequestedBy:         params.RequestedBy,
 		BaseColumnTimestamp: params.BaseColumnTimestamp,
 	}
 }
@@ -34,3 +39,39 @@ func NewApproval(params ApprovalParams) *Approval {
 func (a *Approval) SetStatus(status object.ApprovalStatus) {
 	a.ApprovalStatus = status
 }
+
+// IsCompleted reports whether the approval has reached a final status
+func (a *Approval) IsCompleted() bool {
+	return a.ApprovalStatus.IsApproved() || a.ApprovalStatus.IsRejected()
+}
+
+// IsRequestedBy reports whether the approval was requested by the given user
+func (a *Approval) IsRequestedBy(userID int) bool {
+	return a.RequestedBy != nil && *a.RequestedBy == userID
+}
+
+// HasApprover reports whether the user has already recorded a result on this approval
+func (a *Approval) HasApprover(approverID int) bool {
+	for _, stage := range a.ApprovalStages {
+		if stage.ApproverID == approverID {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApproved returns the number of approvals recorded for a workflow stage
+func (a *Approval) CountApproved(workflowStageID int) int {
+	count := 0
+	for _, stage := range a.ApprovalStages {
+		if stage.ApprovalWorkflowStageID == workflowStageID && stage.ApprovalResult.IsApproved() {
+			count++
+		}
+	}
+	return count
+}
+
+// AddStage appends a recorded stage result to the approval
+func (a *Approval) AddStage(stage *approvalStageModel.ApprovalStage) {
+	a.ApprovalStages = append(a.ApprovalStages, stage)
+}
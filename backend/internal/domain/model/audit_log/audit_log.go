@@ -27,6 +27,8 @@ const (
 
 	// Payout-related descriptions
 	DescPayoutRequest  = "出金申請しました。"
+	DescPayoutUpdate   = "出金を編集しました。"
+	DescPayoutDelete   = "出金を削除しました。"
 	DescPayoutApproval = "出金承認しました。"
 	DescPayoutReject   = "出金却下しました。"
 	DescPayoutResend   = "振込再依頼を行いました。"
@@ -34,6 +36,18 @@ const (
 
 	// Payin-related descriptions
 	DescManualPayinImport = "手動入金取り込みを行いました。"
+	DescPayinReimport     = "入金ファイル（%d）を再取り込みしました。"
+
+	// Report-related descriptions
+	DescInvoiceDownload = "入金ファイル（%d）の適格請求書をダウンロードしました。"
+
+	// Merchant-related descriptions
+	DescMerchantCreate             = "加盟店（%d）を作成しました。"
+	DescMerchantUpdate             = "加盟店（%d）を編集しました。"
+	DescMerchantDelete             = "加盟店（%d）を削除しました。"
+	DescMerchantBankAccountRequest = "加盟店（%d）の口座（%d）の変更を申請しました。"
+	DescMerchantBankAccountConfirm = "加盟店（%d）の口座（%d）の変更を確認しました。"
+	DescMerchantBankAccountReject  = "加盟店（%d）の口座（%d）の変更を却下しました。"
 
 	// Other descriptions
 	DescMerchantStatusUpload = "加盟店審査状況をアップロードしました。"
@@ -54,6 +68,10 @@ type AuditLog struct {
 	UserAgent     *object.UserAgent
 	IPAddress     *object.IPAddress
 	util.BaseColumnTimestamp
+
+	// MerchantID and MerchantBankAccountID are only recorded in the description
+	MerchantID            *int
+	MerchantBankAccountID *int
 }
 
 type AuditLogGenerator struct {
@@ -70,6 +88,9 @@ type AuditLogGenerator struct {
 	DeletedAt     *time.Time
 	TargetUserID  *int
 	NewRole       *string
+
+	MerchantID            *int
+	MerchantBankAccountID *int
 }
 
 // NewAuditLogGenerator creates a new generator with required base fields
@@ -84,24 +105,34 @@ func NewAuditLogGenerator(userID *int, auditLogType object.AuditLogType, ipAddre
 
 // Map of audit log types to their corresponding description templates
 var descriptionTemplates = map[object.AuditLogType]string{
-	object.AuditLogTypeLogin:                DescLogin,
-	object.AuditLogTypeLogout:               DescLogout,
-	object.AuditLogTypePasswordChange:       DescPasswordChange,
-	object.AuditLogTypePasswordReset:        DescPasswordReset,
-	object.AuditLogTypeUserCreate:           DescUserCreate,
-	object.AuditLogTypeUserUpdate:           DescUserUpdate,
-	object.AuditLogTypeUserDelete:           DescUserDelete,
-	object.AuditLogTypeRoleChange:           DescRoleChange,
-	object.AuditLogType2FAEnable:            Desc2FAEnable,
-	object.AuditLogType2FADisable:           Desc2FADisable,
-	object.AuditLogTypePayoutRequest:        DescPayoutRequest,
-	object.AuditLogTypePayoutApproval:       DescPayoutApproval,
-	object.AuditLogTypePayoutReject:         DescPayoutReject,
-	object.AuditLogTypePayoutResend:         DescPayoutResend,
-	object.AuditLogTypePayoutMarkSent:       DescPayoutMarkSent,
-	object.AuditLogTypeManualPayinImport:    DescManualPayinImport,
-	object.AuditLogTypeMerchantStatusUpload: DescMerchantStatusUpload,
-	object.AuditLogTypeExternalAPIAccess:    DescExternalAPIAccess,
+	object.AuditLogTypeLogin:                      DescLogin,
+	object.AuditLogTypeLogout:                     DescLogout,
+	object.AuditLogTypePasswordChange:             DescPasswordChange,
+	object.AuditLogTypePasswordReset:              DescPasswordReset,
+	object.AuditLogTypeUserCreate:                 DescUserCreate,
+	object.AuditLogTypeUserUpdate:                 DescUserUpdate,
+	object.AuditLogTypeUserDelete:                 DescUserDelete,
+	object.AuditLogTypeRoleChange:                 DescRoleChange,
+	object.AuditLogType2FAEnable:                  Desc2FAEnable,
+	object.AuditLogType2FADisable:                 Desc2FADisable,
+	object.AuditLogTypePayoutRequest:              DescPayoutRequest,
+	object.AuditLogTypePayoutUpdate:               DescPayoutUpdate,
+	object.AuditLogTypePayoutDelete:               DescPayoutDelete,
+	object.AuditLogTypePayoutApproval:             DescPayoutApproval,
+	object.AuditLogTypePayoutReject:               DescPayoutReject,
+	object.AuditLogTypePayoutResend:               DescPayoutResend,
+	object.AuditLogTypePayoutMarkSent:             DescPayoutMarkSent,
+	object.AuditLogTypeManualPayinImport:          DescManualPayinImport,
+	object.AuditLogTypePayinReimport:              DescPayinReimport,
+	object.AuditLogTypeInvoiceDownload:            DescInvoiceDownload,
+	object.AuditLogTypeMerchantStatusUpload:       DescMerchantStatusUpload,
+	object.AuditLogTypeMerchantCreate:             DescMerchantCreate,
+	object.AuditLogTypeMerchantUpdate:             DescMerchantUpdate,
+	object.AuditLogTypeMerchantDelete:             DescMerchantDelete,
+	object.AuditLogTypeMerchantBankAccountRequest: DescMerchantBankAccountRequest,
+	object.AuditLogTypeMerchantBankAccountConfirm: DescMerchantBankAccountConfirm,
+	object.AuditLogTypeMerchantBankAccountReject:  DescMerchantBankAccountReject,
+	object.AuditLogTypeExternalAPIAccess:          DescExternalAPIAccess,
 }
 
 // getDescription returns the appropriate description based on the audit log type
@@ -125,6 +156,20 @@ func (g *AuditLogGenerator) getDescription() string {
 		if g.TargetUserID != nil && g.NewRole != nil {
 			return fmt.Sprintf(template, *g.TargetUserID, *g.NewRole)
 		}
+	case object.AuditLogTypePayinReimport, object.AuditLogTypeInvoiceDownload:
+		if g.PayinID != nil {
+			return fmt.Sprintf(template, *g.PayinID)
+		}
+	case object.AuditLogTypeMerchantCreate, object.AuditLogTypeMerchantUpdate, object.AuditLogTypeMerchantDelete:
+		if g.MerchantID != nil {
+			return fmt.Sprintf(template, *g.MerchantID)
+		}
+	case object.AuditLogTypeMerchantBankAccountRequest,
+		object.AuditLogTypeMerchantBankAccountConfirm,
+		object.AuditLogTypeMerchantBankAccountReject:
+		if g.MerchantID != nil && g.MerchantBankAccountID != nil {
+			return fmt.Sprintf(template, *g.MerchantID, *g.MerchantBankAccountID)
+		}
 	default:
 		return template
 	}
@@ -153,7 +198,29 @@ func (g *AuditLogGenerator) Generate() *AuditLog {
 			UpdatedAt: g.UpdatedAt,
 			DeletedAt: g.DeletedAt,
 		},
+
+		MerchantID:            g.MerchantID,
+		MerchantBankAccountID: g.MerchantBankAccountID,
+	}
+}
+
+// SetDefaultDescription sets the description from the template of the audit log type when none is given
+func (a *AuditLog) SetDefaultDescription() {
+	if a.Description != nil {
+		return
+	}
+
+	generator := &AuditLogGenerator{
+		AuditLogType:          a.AuditLogType,
+		PayoutID:              a.PayoutID,
+		PayinID:               a.PayinID,
+		TargetUserID:          a.TargetUserID,
+		NewRole:               a.NewRole,
+		MerchantID:            a.MerchantID,
+		MerchantBankAccountID: a.MerchantBankAccountID,
 	}
+	description := generator.getDescription()
+	a.Description = &description
 }
 
 func NewAuditLog() *AuditLog {
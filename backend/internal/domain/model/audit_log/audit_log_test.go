@@ -0,0 +1,75 @@
+package model
+
+import (
+	"testing"
+
+	object "github.com/huydq/test/internal/domain/object/audit_log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog_SetDefaultDescription(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+	given := "入力された説明"
+
+	cases := []struct {
+		name     string
+		auditLog AuditLog
+		want     string
+	}{
+		{
+			name:     "a merchant creation names the merchant",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantCreate, MerchantID: intPtr(12)},
+			want:     "加盟店（12）を作成しました。",
+		},
+		{
+			name:     "a merchant update names the merchant",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantUpdate, MerchantID: intPtr(12)},
+			want:     "加盟店（12）を編集しました。",
+		},
+		{
+			name:     "a merchant deletion names the merchant",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantDelete, MerchantID: intPtr(12)},
+			want:     "加盟店（12）を削除しました。",
+		},
+		{
+			name:     "a bank account change request names the merchant and the account",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantBankAccountRequest, MerchantID: intPtr(12), MerchantBankAccountID: intPtr(34)},
+			want:     "加盟店（12）の口座（34）の変更を申請しました。",
+		},
+		{
+			name:     "a bank account confirmation names the merchant and the account",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantBankAccountConfirm, MerchantID: intPtr(12), MerchantBankAccountID: intPtr(34)},
+			want:     "加盟店（12）の口座（34）の変更を確認しました。",
+		},
+		{
+			name:     "a bank account rejection names the merchant and the account",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantBankAccountReject, MerchantID: intPtr(12), MerchantBankAccountID: intPtr(34)},
+			want:     "加盟店（12）の口座（34）の変更を却下しました。",
+		},
+		{
+			name:     "the type is used without the IDs of the template",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypeMerchantBankAccountConfirm, MerchantID: intPtr(12)},
+			want:     string(object.AuditLogTypeMerchantBankAccountConfirm),
+		},
+		{
+			name:     "a template without IDs",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypePayoutRequest, PayoutID: intPtr(5)},
+			want:     DescPayoutRequest,
+		},
+		{
+			name:     "a given description is kept",
+			auditLog: AuditLog{AuditLogType: object.AuditLogTypePayoutRequest, Description: &given},
+			want:     given,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			auditLog := tc.auditLog
+			auditLog.SetDefaultDescription()
+			if assert.NotNil(t, auditLog.Description) {
+				assert.Equal(t, tc.want, *auditLog.Description)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// BatchJobLease represents the batch_job_lease table. A lease is held by one process until it is released or expires.
+type BatchJobLease struct {
+	ID         int
+	LeaseKey   string
+	Owner      string
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+// NewBatchJobLease creates a lease on key for owner that expires after ttl
+func NewBatchJobLease(leaseKey, owner string, ttl time.Duration, now time.Time) *BatchJobLease {
+	return &BatchJobLease{
+		LeaseKey:   leaseKey,
+		Owner:      owner,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+}
+
+// Extend moves the expiry of the lease to ttl from now
+func (l *BatchJobLease) Extend(ttl time.Duration, now time.Time) {
+	l.ExpiresAt = now.Add(ttl)
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+	object "github.com/huydq/test/internal/domain/object/batch_job_run"
+)
+
+// BatchJobRun represents the batch_job_run table
+type BatchJobRun struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	CommandName    string
+	Flags          map[string]string
+	Status         object.BatchJobRunStatus
+	StartedAt      time.Time
+	FinishedAt     *time.Time
+	ProcessedCount int
+	FailedCount    int
+	ErrorSummary   *string
+}
+
+// NewBatchJobRun starts a run of the given command
+func NewBatchJobRun(commandName string, flags map[string]string, startedAt time.Time) *BatchJobRun {
+	return &BatchJobRun{
+		CommandName: commandName,
+		Flags:       flags,
+		Status:      object.BatchJobRunStatusRunning,
+		StartedAt:   startedAt,
+	}
+}
+
+// Finish records the counts of the run. A run that aborted with an error has failed; a run that completed
+// with some failed items has partially failed.
+func (r *BatchJobRun) Finish(processedCount, failedCount int, errorSummary string, aborted bool, finishedAt time.Time) {
+	r.ProcessedCount = processedCount
+	r.FailedCount = failedCount
+	r.FinishedAt = &finishedAt
+	r.ErrorSummary = nil
+	if errorSummary != "" {
+		r.ErrorSummary = &errorSummary
+	}
+
+	switch {
+	case aborted:
+		r.Status = object.BatchJobRunStatusFailed
+	case failedCount > 0:
+		r.Status = object.BatchJobRunStatusPartiallyFailed
+	default:
+		r.Status = object.BatchJobRunStatusSucceeded
+	}
+}
+
+// IsRunning reports whether the run has not finished yet
+func (r *BatchJobRun) IsRunning() bool {
+	return r.Status == object.BatchJobRunStatusRunning
+}
@@ -21,8 +21,18 @@ type Merchant struct {
 // PaymentProviderReview represents the payment provider review entity
 type PaymentProviderReview struct {
 	ID                   int `json:"id"`
+	PaymentProviderID    int `json:"payment_provider_id"`
 	MerchantID           int `json:"merchant_id"`
 	MerchantReviewStatus int `json:"merchant_review_status"`
 
 	util.BaseColumnTimestamp
 }
+
+// NewPaymentProviderReview builds a review recording the merchant's current status at its payment provider
+func (m *Merchant) NewPaymentProviderReview(status int) *PaymentProviderReview {
+	return &PaymentProviderReview{
+		PaymentProviderID:    m.PaymentProviderID,
+		MerchantID:           m.ID,
+		MerchantReviewStatus: status,
+	}
+}
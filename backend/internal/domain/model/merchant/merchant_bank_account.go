@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	object "github.com/huydq/test/internal/domain/object/payout"
+)
+
+// MerchantBankAccount represents the bank account payouts to a merchant are transferred to.
+// Every registration, change and deletion is kept as its own row and only takes effect
+// once it has been confirmed by a user other than the requester.
+type MerchantBankAccount struct {
+	ID              int                    `json:"id"`
+	MerchantID      int                    `json:"merchant_id"`
+	BankName        string                 `json:"bank_name"`
+	BankCode        string                 `json:"bank_code"`
+	BranchName      string                 `json:"branch_name"`
+	BranchCode      string                 `json:"branch_code"`
+	BankAccountType object.BankAccountType `json:"bank_account_type"`
+	AccountNo       string                 `json:"account_no"`
+	AccountName     string                 `json:"account_name"`
+
+	ChangeType            merchantObject.BankAccountChangeType `json:"change_type"`
+	Status                merchantObject.BankAccountStatus     `json:"status"`
+	PreviousBankAccountID *int                                 `json:"previous_bank_account_id"`
+	RequestedBy           *int                                 `json:"requested_by"`
+	ReviewedBy            *int                                 `json:"reviewed_by"`
+	ReviewedAt            *time.Time                           `json:"reviewed_at"`
+
+	util.BaseColumnTimestamp
+}
+
+// IsPending reports whether the account change is waiting for confirmation
+func (a *MerchantBankAccount) IsPending() bool {
+	return a.Status.IsPending()
+}
+
+// IsActive reports whether the account is the one payouts are currently transferred to
+func (a *MerchantBankAccount) IsActive() bool {
+	return a.Status.IsActive()
+}
+
+// IsRequestedBy reports whether the change was requested by the given user
+func (a *MerchantBankAccount) IsRequestedBy(userID int) bool {
+	return a.RequestedBy != nil && *a.RequestedBy == userID
+}
+
+// NewDeletionRequest builds a pending request to delete the account
+func (a *MerchantBankAccount) NewDeletionRequest(requestedBy int) *MerchantBankAccount {
+	previousID := a.ID
+	return &MerchantBankAccount{
+		MerchantID:            a.MerchantID,
+		BankName:              a.BankName,
+		BankCode:              a.BankCode,
+		BranchName:            a.BranchName,
+		BranchCode:            a.BranchCode,
+		BankAccountType:       a.BankAccountType,
+		AccountNo:             a.AccountNo,
+		AccountName:           a.AccountName,
+		ChangeType:            merchantObject.BankAccountChangeTypeDelete,
+		Status:                merchantObject.BankAccountStatusPending,
+		PreviousBankAccountID: &previousID,
+		RequestedBy:           &requestedBy,
+	}
+}
+
+// Confirm applies the change; a confirmed deletion leaves no active account behind
+func (a *MerchantBankAccount) Confirm(reviewedBy int, reviewedAt time.Time) {
+	a.Status = merchantObject.BankAccountStatusActive
+	if a.ChangeType.IsDelete() {
+		a.Status = merchantObject.BankAccountStatusInactive
+	}
+	a.ReviewedBy = &reviewedBy
+	a.ReviewedAt = &reviewedAt
+}
+
+// Reject discards the change
+func (a *MerchantBankAccount) Reject(reviewedBy int, reviewedAt time.Time) {
+	a.Status = merchantObject.BankAccountStatusRejected
+	a.ReviewedBy = &reviewedBy
+	a.ReviewedAt = &reviewedAt
+}
+
+// Deactivate marks an account that has been replaced or deleted as history
+func (a *MerchantBankAccount) Deactivate() {
+	a.Status = merchantObject.BankAccountStatusInactive
+}
@@ -7,10 +7,6 @@ import (
 	object "github.com/huydq/test/internal/domain/object/payin"
 )
 
-const (
-	PaymentProviderID int = 1
-)
-
 // PayinFile represents the payin_file table
 type PayinFile struct {
 	ID int
@@ -24,6 +20,10 @@ type PayinFile struct {
 	HasDataRecord        bool
 	AddedManually        bool
 	ContentAddedManually *string
+	FileSize             *int64
+	MD5Checksum          *string
+	SHA256Checksum       *string
+	Encoding             *object.PayinFileEncoding
 	CreatedAt            time.Time
 	ImportStatus         object.PayinFileStatus
 	DownloadStatus       object.PayinFileStatus
@@ -44,3 +44,17 @@ func (p *PayinFile) UpdateUploadStatus(status object.PayinFileStatus) {
 func (p *PayinFile) UpdateImportStatus(status object.PayinFileStatus) {
 	p.ImportStatus = status
 }
+
+// RecordUpload marks the file as downloaded and uploaded, keeping the size and checksums of the stored object
+func (p *PayinFile) RecordUpload(size int64, md5Checksum string, sha256Checksum string) {
+	p.FileSize = &size
+	p.MD5Checksum = &md5Checksum
+	p.SHA256Checksum = &sha256Checksum
+	p.DownloadStatus = object.StatusSuccess
+	p.UploadStatus = object.StatusSuccess
+}
+
+// RecordEncoding keeps the character encoding the file content was read with
+func (p *PayinFile) RecordEncoding(encoding object.PayinFileEncoding) {
+	p.Encoding = &encoding
+}
@@ -14,5 +14,26 @@ type PayinFileGroup struct {
 	FileGroupName     string
 	PaymentProviderID int
 	ImportTargetDate  time.Time
-	ImportedAt        *time.Time
+	ListedAt          *time.Time // Set once every remote file of the group has been registered
+	ImportedAt        *time.Time // Set once every file of the group has been fetched
+}
+
+// MarkListed records that the remote files of the group have all been registered
+func (g *PayinFileGroup) MarkListed(at time.Time) {
+	g.ListedAt = &at
+}
+
+// MarkImported records that the files of the group have all been fetched
+func (g *PayinFileGroup) MarkImported(at time.Time) {
+	g.ImportedAt = &at
+}
+
+// IsListed reports whether the remote files of the group have all been registered
+func (g *PayinFileGroup) IsListed() bool {
+	return g.ListedAt != nil
+}
+
+// IsImported reports whether the group is complete
+func (g *PayinFileGroup) IsImported() bool {
+	return g.ImportedAt != nil
 }
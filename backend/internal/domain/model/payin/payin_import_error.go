@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	object "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// PayinImportError represents the payin_import_error table: a value of a payin file row that could not be imported as is
+type PayinImportError struct {
+	ID          int
+	PayinFileID int
+	LineNumber  int
+	ColumnName  string
+	RawValue    string
+	Reason      string
+	Policy      object.PayinImportErrorPolicy
+	CreatedAt   time.Time
+}
+
+// NewPayinImportError creates an import error for a value of a payin file row
+func NewPayinImportError(payinFileID int, lineNumber int, columnName, rawValue, reason string) *PayinImportError {
+	return &PayinImportError{
+		PayinFileID: payinFileID,
+		LineNumber:  lineNumber,
+		ColumnName:  columnName,
+		RawValue:    rawValue,
+		Reason:      reason,
+	}
+}
+
+// NewPayinImportWarning creates an import error that is recorded as a warning whatever the configured policy, for a
+// value that is imported although it is not understood
+func NewPayinImportWarning(payinFileID int, lineNumber int, columnName, rawValue, reason string) *PayinImportError {
+	importError := NewPayinImportError(payinFileID, lineNumber, columnName, rawValue, reason)
+	importError.Policy = object.PayinImportErrorPolicyWarn
+	return importError
+}
+
+// IsWarning reports whether the value was imported despite the error
+func (e *PayinImportError) IsWarning() bool {
+	return e.Policy == object.PayinImportErrorPolicyWarn
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+)
+
+// PaymentProvider represents the payment_provider master table
+type PaymentProvider struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	Code string
+	Name *string
+}
@@ -3,6 +3,8 @@ package model
 import (
 	"time"
 
+	approvalModel "github.com/huydq/test/internal/domain/model/approval"
+	payoutRecordModel "github.com/huydq/test/internal/domain/model/payout_record"
 	userModel "github.com/huydq/test/internal/domain/model/user"
 	util "github.com/huydq/test/internal/domain/object/basedatetime"
 	object "github.com/huydq/test/internal/domain/object/payout"
@@ -19,8 +21,10 @@ type Payout struct {
 	SentDate              time.Time
 	AozoraTransferApplyNo string
 	ApprovalID            *int
+	Approval              *approvalModel.Approval
 	UserID                int
 	User                  *userModel.User
+	PayoutRecords         []*payoutRecordModel.PayoutRecord
 
 	PayoutRecordCount     int
 	PayoutRecordSumAmount float64
@@ -72,3 +76,46 @@ func (p *Payout) MarkAsProcessed(sentDate time.Time) {
 	p.PayoutStatus = object.PayoutStatusProcessed
 	p.SentDate = sentDate
 }
+
+// IsTransferFailed reports whether some of the transfers of the payout failed and need to be handled by finance
+func (p *Payout) IsTransferFailed() bool {
+	return p.PayoutStatus.IsTransferFailed()
+}
+
+// MarkAsTransferFailed marks the payout as having failed transfers once none of its transfers is pending anymore
+func (p *Payout) MarkAsTransferFailed() {
+	p.PayoutStatus = object.PayoutStatusTransferFailed
+}
+
+// CanBeEdited reports whether the payout and its records may still be changed
+func (p *Payout) CanBeEdited() bool {
+	return p.PayoutStatus.IsDraft() && !p.IsUnderApproval()
+}
+
+// IsUnderApproval reports whether the payout has an approval that is still pending or in progress
+func (p *Payout) IsUnderApproval() bool {
+	return p.Approval != nil && !p.Approval.IsCompleted()
+}
+
+// StartApproval links the payout to a newly started approval
+func (p *Payout) StartApproval(approval *approvalModel.Approval) {
+	p.ApprovalID = &approval.ID
+	p.Approval = approval
+}
+
+// MarkAsApproved moves an approved draft payout to the transfer data created status
+func (p *Payout) MarkAsApproved() {
+	p.PayoutStatus = object.PayoutStatusCreated
+}
+
+// SetPayoutRecords replaces the records of the payout and recalculates its totals
+func (p *Payout) SetPayoutRecords(records []*payoutRecordModel.PayoutRecord) {
+	p.PayoutRecords = records
+	p.Total = 0
+	p.TotalCount = len(records)
+	for _, record := range records {
+		p.Total += record.Amount
+	}
+	p.PayoutRecordCount = p.TotalCount
+	p.PayoutRecordSumAmount = p.Total
+}
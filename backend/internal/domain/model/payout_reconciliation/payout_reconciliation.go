@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+)
+
+// PayoutReconciliationParams contains parameters for creating a new PayoutReconciliation
+type PayoutReconciliationParams struct {
+	ID                    int
+	PayoutID              int
+	PayoutRecordID        int
+	AozoraTransferApplyNo string
+	LocalAmount           float64
+	BankAmount            float64
+	BankTransferStatus    string
+	DetectedAt            time.Time
+	util.BaseColumnTimestamp
+}
+
+// PayoutReconciliation represents a mismatch between the amount of a payout record and the amount reported by the bank
+type PayoutReconciliation struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	PayoutID              int
+	PayoutRecordID        int
+	AozoraTransferApplyNo string
+	LocalAmount           float64
+	BankAmount            float64
+	BankTransferStatus    string
+	DetectedAt            time.Time
+}
+
+// NewPayoutReconciliation creates a new payout reconciliation instance with the given parameters
+func NewPayoutReconciliation(params PayoutReconciliationParams) *PayoutReconciliation {
+	return &PayoutReconciliation{
+		ID:                    params.ID,
+		PayoutID:              params.PayoutID,
+		PayoutRecordID:        params.PayoutRecordID,
+		AozoraTransferApplyNo: params.AozoraTransferApplyNo,
+		LocalAmount:           params.LocalAmount,
+		BankAmount:            params.BankAmount,
+		BankTransferStatus:    params.BankTransferStatus,
+		DetectedAt:            params.DetectedAt,
+		BaseColumnTimestamp:   params.BaseColumnTimestamp,
+	}
+}
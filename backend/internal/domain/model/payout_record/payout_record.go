@@ -87,3 +87,10 @@ func (p *PayoutRecord) MarkAsProcessed(executedAt time.Time) {
 	p.TransferStatus = object.TransferStatusProcessed
 	p.TransferExecutedAt = &executedAt
 }
+
+// IsTransferSubmitted reports whether the bank has accepted the transfer request for the record
+func (p *PayoutRecord) IsTransferSubmitted() bool {
+	return p.TransferStatus.IsRequested() ||
+		p.TransferStatus.IsInProgress() ||
+		p.TransferStatus.IsProcessed()
+}
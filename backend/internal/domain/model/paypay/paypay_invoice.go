@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+)
+
+// PaypayInvoice represents the paypay_invoice table, a qualified invoice (適格請求書) PDF fetched from PayPay
+type PaypayInvoice struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	PayinFileID          int
+	Duplicate            bool // The invoice is the duplicate (控え) kept by the issuer
+	FileName             string
+	StorageKey           string
+	RegistrationNumber   string
+	IssueDate            *time.Time
+	PaymentMerchantID    string
+	MerchantBusinessName string
+	CutoffDate           *time.Time
+	TaxableAmount        *float64
+	TaxAmount            *float64
+	TotalAmount          *float64
+	PaypayPayinSummaryID *int
+	PaypayPayinDetailID  *int
+
+	PayinFile *model.PayinFile
+}
+
+// LinkPayin links the invoice to the payin detail of its merchant and cutoff date, and to the summary of the payin
+func (i *PaypayInvoice) LinkPayin(detail *PaypayPayinDetail, summary *PaypayPayinSummary) {
+	i.PaypayPayinDetailID = nil
+	i.PaypayPayinSummaryID = nil
+	if detail != nil {
+		i.PaypayPayinDetailID = &detail.ID
+	}
+	if summary != nil {
+		i.PaypayPayinSummaryID = &summary.ID
+	}
+}
@@ -24,12 +24,12 @@ type PaypayPayinTransaction struct {
 	TransactionAt            *time.Time
 	TransactionAmount        *float64
 	ReceiptNumber            *string
-	PaypayPaymentMethod      string
+	PaypayPaymentMethod      *paypayObject.PaypayPaymentMethod
+	PaypayPaymentMethodLabel *string
 	SSID                     *string
 	MerchantOrderID          *string
 
-	// TODO: PaymentDetail 型の正確なJSON構造がまだ判明していないため、定義ができていません。
-	// 詳細な構造が把握できれば、map[string]any の代わりに適切な構造体を作成する方が良いでしょう。
+	// 支払い詳細: 支払い方法ごとの内訳
 	PaymentDetail *PaymentDetail
 
 	PayinFile *model.PayinFile
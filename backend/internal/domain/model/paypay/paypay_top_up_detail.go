@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+)
+
+// PaypayTopUpDetail represents the paypay_top_up_detail table
+type PaypayTopUpDetail struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	PayinFileID          int
+	PaymentMerchantID    string
+	MerchantBusinessName string
+	CutoffDate           *time.Time
+	PaymentDate          *time.Time
+	Amount               float64
+	BankName             string
+	BranchName           string
+	AccountType          string
+	AccountNumber        string
+	AccountHolder        string
+
+	PayinFile *model.PayinFile
+}
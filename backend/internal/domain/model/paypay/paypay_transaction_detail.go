@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+)
+
+// PaypayTransactionDetail represents the paypay_transaction_detail table
+type PaypayTransactionDetail struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	PayinFileID              int
+	ShippingRelated          bool
+	PaymentTransactionID     string
+	PaymentMerchantID        string
+	MerchantBusinessName     string
+	ShopID                   string
+	ShopName                 string
+	TerminalCode             string
+	PaymentTransactionStatus *paypayObject.PaypayTransactionStatus
+	TransactionAt            *time.Time
+	TransactionAmount        float64
+	ReceiptNumber            string
+	PaypayPaymentMethod      *paypayObject.PaypayPaymentMethod
+	PaypayPaymentMethodLabel string
+	MerchantOrderID          string
+
+	// Only set on the shipping related reports
+	ShippingFee *float64
+	ShippedAt   *time.Time
+
+	PayinFile *model.PayinFile
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+)
+
+// PaypayTransactionSummary represents the paypay_transaction_summary table
+type PaypayTransactionSummary struct {
+	ID int
+	util.BaseColumnTimestamp
+
+	PayinFileID          int
+	PaymentMerchantID    string
+	MerchantBusinessName string
+	TransactionDate      *time.Time
+	TransactionCount     int
+	TransactionAmount    float64
+	RefundCount          int
+	RefundAmount         float64
+	UsageFee             float64
+
+	PayinFile *model.PayinFile
+}
@@ -1,18 +1,113 @@
 package model
 
-import "github.com/huydq/test/internal/pkg/utils"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"sort"
 
-// PaymentDetail represents PayPay payment details stored as JSON in the database
-// We're using the generic JSONField until we know the exact structure of the payment details
-type PaymentDetail = utils.JSONField[any]
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+)
 
-// Once we know the exact structure, we can replace it with a proper struct, for example:
-/*
+// PaymentDetail is the breakdown of a PayPay payment by funding source, stored as JSON in the database.
+// A split payment, such as balance and points, has one item per payment method.
 type PaymentDetail struct {
-    PaymentMethod   string  `json:"payment_method"`
-    CardType        string  `json:"card_type,omitempty"`
-    LastFourDigits  string  `json:"last_four_digits,omitempty"`
-    ExpirationDate  string  `json:"expiration_date,omitempty"`
-    // Add other fields as needed when the structure becomes clear
+	Items []PaymentDetailItem `json:"items"`
+}
+
+// PaymentDetailItem is the amount paid with one payment method
+type PaymentDetailItem struct {
+	PaymentMethod paypayObject.PaypayPaymentMethod `json:"payment_method"`
+	Amount        float64                          `json:"amount"`
+}
+
+// Value implements the driver.Valuer interface for database serialization
+func (d PaymentDetail) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+// Scan implements the sql.Scanner interface for database deserialization
+func (d *PaymentDetail) Scan(value any) error {
+	if value == nil {
+		*d = PaymentDetail{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, d)
+}
+
+// Total returns the amount paid with all payment methods
+func (d PaymentDetail) Total() float64 {
+	var total float64
+	for _, item := range d.Items {
+		total += item.Amount
+	}
+	return total
+}
+
+// PaymentMethodTotal is the amount paid with a payment method over a set of transactions
+type PaymentMethodTotal struct {
+	PaymentMethod    paypayObject.PaypayPaymentMethod
+	Amount           float64
+	TransactionCount int
+}
+
+// PaymentMethodBreakdown is the totals per payment method of a set of transactions, with the sum of the transaction
+// amounts to reconcile them against
+type PaymentMethodBreakdown struct {
+	Totals                     []*PaymentMethodTotal
+	TransactionCount           int
+	TransactionAmount          float64
+	UndetailedTransactionCount int
+}
+
+// NewPaymentMethodBreakdown sums the payment details of the transactions, with the totals in the order of the payment
+// methods. Transactions without a payment detail are only counted.
+func NewPaymentMethodBreakdown(transactions []*PaypayPayinTransaction) *PaymentMethodBreakdown {
+	breakdown := &PaymentMethodBreakdown{TransactionCount: len(transactions)}
+	totals := make(map[paypayObject.PaypayPaymentMethod]*PaymentMethodTotal)
+	for _, transaction := range transactions {
+		if transaction.TransactionAmount != nil {
+			breakdown.TransactionAmount += *transaction.TransactionAmount
+		}
+		// A NULL payment detail may be scanned as an empty one
+		if transaction.PaymentDetail == nil || len(transaction.PaymentDetail.Items) == 0 {
+			breakdown.UndetailedTransactionCount++
+			continue
+		}
+
+		// A method listed twice in a detail still counts the transaction once
+		counted := make(map[paypayObject.PaypayPaymentMethod]bool)
+		for _, item := range transaction.PaymentDetail.Items {
+			total, ok := totals[item.PaymentMethod]
+			if !ok {
+				total = &PaymentMethodTotal{PaymentMethod: item.PaymentMethod}
+				totals[item.PaymentMethod] = total
+			}
+			total.Amount += item.Amount
+			if !counted[item.PaymentMethod] {
+				total.TransactionCount++
+				counted[item.PaymentMethod] = true
+			}
+		}
+	}
+
+	breakdown.Totals = make([]*PaymentMethodTotal, 0, len(totals))
+	for _, total := range totals {
+		breakdown.Totals = append(breakdown.Totals, total)
+	}
+	sort.Slice(breakdown.Totals, func(i, j int) bool {
+		return breakdown.Totals[i].PaymentMethod < breakdown.Totals[j].PaymentMethod
+	})
+	return breakdown
 }
-*/
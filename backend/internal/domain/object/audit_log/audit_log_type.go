@@ -19,6 +19,8 @@ const (
 
 	// Payout related audit log types
 	AuditLogTypePayoutRequest  AuditLogType = "出金申請"
+	AuditLogTypePayoutUpdate   AuditLogType = "出金編集"
+	AuditLogTypePayoutDelete   AuditLogType = "出金削除"
 	AuditLogTypePayoutApproval AuditLogType = "出金承認"
 	AuditLogTypePayoutReject   AuditLogType = "出金却下"
 	AuditLogTypePayoutResend   AuditLogType = "振込再依頼"
@@ -26,13 +28,22 @@ const (
 
 	// Payin related audit log types
 	AuditLogTypeManualPayinImport AuditLogType = "手動入金取り込み"
+	AuditLogTypePayinReimport     AuditLogType = "入金ファイル再取り込み"
 
 	// Report related audit log types
 	AuditLogTypePayinReportDownload AuditLogType = "入金レポートをダウンロード"
 	AuditLogTypePayinDetailDownload AuditLogType = "入金明細をダウンロード"
+	AuditLogTypePayoutFileDownload  AuditLogType = "振込ファイルをダウンロード"
+	AuditLogTypeInvoiceDownload     AuditLogType = "適格請求書をダウンロード"
 
 	// Merchant related audit log types
-	AuditLogTypeMerchantStatusUpload AuditLogType = "加盟店審査状況をアップロード"
+	AuditLogTypeMerchantStatusUpload       AuditLogType = "加盟店審査状況をアップロード"
+	AuditLogTypeMerchantCreate             AuditLogType = "加盟店作成"
+	AuditLogTypeMerchantUpdate             AuditLogType = "加盟店編集"
+	AuditLogTypeMerchantDelete             AuditLogType = "加盟店削除"
+	AuditLogTypeMerchantBankAccountRequest AuditLogType = "加盟店口座変更申請"
+	AuditLogTypeMerchantBankAccountConfirm AuditLogType = "加盟店口座変更確認"
+	AuditLogTypeMerchantBankAccountReject  AuditLogType = "加盟店口座変更却下"
 
 	// API related audit log types
 	AuditLogTypeExternalAPIAccess AuditLogType = "外部APIアクセス"
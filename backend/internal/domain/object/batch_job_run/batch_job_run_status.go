@@ -0,0 +1,35 @@
+package object
+
+// BatchJobRunStatus represents the outcome of a batch command run
+type BatchJobRunStatus int
+
+const (
+	BatchJobRunStatusRunning         BatchJobRunStatus = 1 // 実行中
+	BatchJobRunStatusSucceeded       BatchJobRunStatus = 2 // 成功
+	BatchJobRunStatusPartiallyFailed BatchJobRunStatus = 3 // 一部失敗
+	BatchJobRunStatusFailed          BatchJobRunStatus = 4 // 失敗
+)
+
+func (s BatchJobRunStatus) String() string {
+	switch s {
+	case BatchJobRunStatusRunning:
+		return "実行中"
+	case BatchJobRunStatusSucceeded:
+		return "成功"
+	case BatchJobRunStatusPartiallyFailed:
+		return "一部失敗"
+	case BatchJobRunStatusFailed:
+		return "失敗"
+	default:
+		return "不明"
+	}
+}
+
+func (s BatchJobRunStatus) IsValid() bool {
+	switch s {
+	case BatchJobRunStatusRunning, BatchJobRunStatusSucceeded, BatchJobRunStatusPartiallyFailed, BatchJobRunStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,27 @@
+package object
+
+// BankAccountChangeType represents the kind of change a merchant bank account row requests
+type BankAccountChangeType int
+
+const (
+	BankAccountChangeTypeCreate BankAccountChangeType = 1 // 登録
+	BankAccountChangeTypeUpdate BankAccountChangeType = 2 // 変更
+	BankAccountChangeTypeDelete BankAccountChangeType = 3 // 削除
+)
+
+func (t BankAccountChangeType) String() string {
+	switch t {
+	case BankAccountChangeTypeCreate:
+		return "登録"
+	case BankAccountChangeTypeUpdate:
+		return "変更"
+	case BankAccountChangeTypeDelete:
+		return "削除"
+	default:
+		return "不明"
+	}
+}
+
+func (t BankAccountChangeType) IsDelete() bool {
+	return t == BankAccountChangeTypeDelete
+}
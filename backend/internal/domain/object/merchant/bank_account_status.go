@@ -0,0 +1,34 @@
+package object
+
+// BankAccountStatus represents the confirmation status of a merchant bank account
+type BankAccountStatus int
+
+const (
+	BankAccountStatusPending  BankAccountStatus = 1 // 確認待ち
+	BankAccountStatusActive   BankAccountStatus = 2 // 有効
+	BankAccountStatusRejected BankAccountStatus = 3 // 却下
+	BankAccountStatusInactive BankAccountStatus = 4 // 無効
+)
+
+func (s BankAccountStatus) String() string {
+	switch s {
+	case BankAccountStatusPending:
+		return "確認待ち"
+	case BankAccountStatusActive:
+		return "有効"
+	case BankAccountStatusRejected:
+		return "却下"
+	case BankAccountStatusInactive:
+		return "無効"
+	default:
+		return "不明"
+	}
+}
+
+func (s BankAccountStatus) IsPending() bool {
+	return s == BankAccountStatusPending
+}
+
+func (s BankAccountStatus) IsActive() bool {
+	return s == BankAccountStatusActive
+}
@@ -0,0 +1,59 @@
+package object
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MerchantReviewStatus represents the result of a payment provider's review of a merchant
+type MerchantReviewStatus int
+
+const (
+	MerchantReviewStatusUnderReview MerchantReviewStatus = 1 // 審査中
+	MerchantReviewStatusApproved    MerchantReviewStatus = 2 // 審査通過
+	MerchantReviewStatusRejected    MerchantReviewStatus = 3 // 審査否認
+)
+
+func (s MerchantReviewStatus) String() string {
+	switch s {
+	case MerchantReviewStatusUnderReview:
+		return "審査中"
+	case MerchantReviewStatusApproved:
+		return "審査通過"
+	case MerchantReviewStatusRejected:
+		return "審査否認"
+	default:
+		return "不明"
+	}
+}
+
+func (s MerchantReviewStatus) IsValid() bool {
+	switch s {
+	case MerchantReviewStatusUnderReview, MerchantReviewStatusApproved, MerchantReviewStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseMerchantReviewStatus accepts either the numeric code or the Japanese label of a review status
+func ParseMerchantReviewStatus(value string) (MerchantReviewStatus, bool) {
+	value = strings.TrimSpace(value)
+
+	if code, err := strconv.Atoi(value); err == nil {
+		status := MerchantReviewStatus(code)
+		return status, status.IsValid()
+	}
+
+	for _, status := range []MerchantReviewStatus{
+		MerchantReviewStatusUnderReview,
+		MerchantReviewStatusApproved,
+		MerchantReviewStatusRejected,
+	} {
+		if status.String() == value {
+			return status, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,33 @@
+package object
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PayinFileEncoding is the character encoding a payin file was read with
+type PayinFileEncoding string
+
+const (
+	PayinFileEncodingAuto     PayinFileEncoding = ""          // 自動判定
+	PayinFileEncodingUTF8     PayinFileEncoding = "UTF-8"     // UTF-8
+	PayinFileEncodingShiftJIS PayinFileEncoding = "Shift_JIS" // Shift_JIS（CP932 / Windows-31J の拡張文字を含む）
+)
+
+// ParsePayinFileEncoding parses a configured encoding name. An empty name or "auto" selects automatic detection.
+func ParsePayinFileEncoding(name string) (PayinFileEncoding, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "auto":
+		return PayinFileEncodingAuto, nil
+	case "utf-8", "utf8":
+		return PayinFileEncodingUTF8, nil
+	case "shift_jis", "shift-jis", "sjis", "cp932", "windows-31j":
+		return PayinFileEncodingShiftJIS, nil
+	default:
+		return PayinFileEncodingAuto, fmt.Errorf("unsupported payin file encoding: %q", name)
+	}
+}
+
+func (e PayinFileEncoding) String() string {
+	return string(e)
+}
@@ -1,7 +0,0 @@
-package object
-
-type PayinFileProviderIdType int
-
-const (
-	PayinFileProviderId PayinFileProviderIdType = 1
-)
@@ -0,0 +1,7 @@
+package object
+
+// PayinFileRow is a data record of a payin file, keyed by normalized header
+type PayinFileRow struct {
+	Line   int // line of the file the record starts on
+	Values map[string]string
+}
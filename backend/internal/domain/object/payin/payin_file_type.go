@@ -3,7 +3,18 @@ package object
 type PayinFileType int
 
 const (
-	PayinFileTypePaymentSummary     PayinFileType = 0 // 入金レポート
-	PayinFileTypePaymentDetail      PayinFileType = 1 // 入金明細
-	PayinFileTypePaymentTransaction PayinFileType = 2 // 入金取引明細
+	PayinFileTypePaymentSummary            PayinFileType = 0 // 入金レポート
+	PayinFileTypePaymentDetail             PayinFileType = 1 // 入金明細
+	PayinFileTypePaymentTransaction        PayinFileType = 2 // 入金取引明細
+	PayinFileTypeTransactionDetail         PayinFileType = 3 // 取引明細（配送関連なし）
+	PayinFileTypeShippingTransactionDetail PayinFileType = 4 // 取引明細（配送関連）
+	PayinFileTypeTransactionSummary        PayinFileType = 5 // 取引明細サマリー
+	PayinFileTypeTopUpDetail               PayinFileType = 6 // 入金詳細
+	PayinFileTypeInvoice                   PayinFileType = 7 // 適格請求書
+	PayinFileTypeDuplicateInvoice          PayinFileType = 8 // 適格請求書（控え）
 )
+
+// IsInvoice reports whether the files of the type are qualified invoice PDFs rather than zipped CSV reports
+func (t PayinFileType) IsInvoice() bool {
+	return t == PayinFileTypeInvoice || t == PayinFileTypeDuplicateInvoice
+}
@@ -0,0 +1,42 @@
+package object
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PayinImportErrorPolicy decides what happens to a payin file with rows that cannot be imported as is
+type PayinImportErrorPolicy int
+
+const (
+	PayinImportErrorPolicyRejectFile PayinImportErrorPolicy = 1 // ファイルを取り込まない
+	PayinImportErrorPolicySkipRow    PayinImportErrorPolicy = 2 // エラーのある行をスキップ
+	PayinImportErrorPolicyWarn       PayinImportErrorPolicy = 3 // 警告として取り込む（不正な値は空）
+)
+
+// ParsePayinImportErrorPolicy parses a configured policy name. An empty name selects rejecting the file.
+func ParsePayinImportErrorPolicy(name string) (PayinImportErrorPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "reject_file":
+		return PayinImportErrorPolicyRejectFile, nil
+	case "skip_row":
+		return PayinImportErrorPolicySkipRow, nil
+	case "warn":
+		return PayinImportErrorPolicyWarn, nil
+	default:
+		return 0, fmt.Errorf("unsupported payin import error policy: %q", name)
+	}
+}
+
+func (p PayinImportErrorPolicy) String() string {
+	switch p {
+	case PayinImportErrorPolicyRejectFile:
+		return "reject_file"
+	case PayinImportErrorPolicySkipRow:
+		return "skip_row"
+	case PayinImportErrorPolicyWarn:
+		return "warn"
+	default:
+		return "unknown"
+	}
+}
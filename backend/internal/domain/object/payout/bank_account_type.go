@@ -27,3 +27,9 @@ func (b BankAccountType) IsValid() bool {
 		b == BankAccountTypeCurrent ||
 		b == BankAccountTypeFixed
 }
+
+// CanReceiveTransfer reports whether transfers can be paid into the account type. A time deposit cannot be a payout
+// destination.
+func (b BankAccountType) CanReceiveTransfer() bool {
+	return b == BankAccountTypeOrdinary || b == BankAccountTypeCurrent
+}
@@ -4,9 +4,10 @@ package object
 type PayoutStatus int
 
 const (
-	PayoutStatusDraft     PayoutStatus = 1 // ドラフト
-	PayoutStatusCreated   PayoutStatus = 2 // 振込データ作成済み
-	PayoutStatusProcessed PayoutStatus = 3 // 送金手続き済み
+	PayoutStatusDraft          PayoutStatus = 1 // ドラフト
+	PayoutStatusCreated        PayoutStatus = 2 // 振込データ作成済み
+	PayoutStatusProcessed      PayoutStatus = 3 // 送金手続き済み
+	PayoutStatusTransferFailed PayoutStatus = 4 // 振込失敗あり
 )
 
 func (p PayoutStatus) String() string {
@@ -17,6 +18,8 @@ func (p PayoutStatus) String() string {
 		return "振込データ作成済み"
 	case PayoutStatusProcessed:
 		return "送金手続き済み"
+	case PayoutStatusTransferFailed:
+		return "振込失敗あり"
 	default:
 		return "不明"
 	}
@@ -34,6 +37,10 @@ func (p PayoutStatus) IsProcessed() bool {
 	return p == PayoutStatusProcessed
 }
 
+func (p PayoutStatus) IsTransferFailed() bool {
+	return p == PayoutStatusTransferFailed
+}
+
 func GetPayoutStatusFromString(s string) (PayoutStatus, bool) {
 	switch s {
 	case "ドラフト":
@@ -42,6 +49,8 @@ func GetPayoutStatusFromString(s string) (PayoutStatus, bool) {
 		return PayoutStatusCreated, true
 	case "送金手続き済み":
 		return PayoutStatusProcessed, true
+	case "振込失敗あり":
+		return PayoutStatusTransferFailed, true
 	default:
 		return 0, false
 	}
@@ -55,6 +64,8 @@ func GetPayoutStatusFromInt(i int) (PayoutStatus, bool) {
 		return PayoutStatusCreated, true
 	case 3:
 		return PayoutStatusProcessed, true
+	case 4:
+		return PayoutStatusTransferFailed, true
 	default:
 		return 0, false
 	}
@@ -10,6 +10,7 @@ const (
 	TransferStatusFailed         TransferStatus = 4 // 振込依頼失敗
 	TransferStatusRequested      TransferStatus = 5 // 振込依頼済み
 	TransferStatusProcessed      TransferStatus = 6 // 送金手続き済み
+	TransferStatusNotSubmitted   TransferStatus = 7 // 未振込
 )
 
 // String returns the string representation of the transfer status
@@ -27,6 +28,8 @@ func (t TransferStatus) String() string {
 		return "振込依頼済み"
 	case TransferStatusProcessed:
 		return "送金手続き済み"
+	case TransferStatusNotSubmitted:
+		return "未振込"
 	default:
 		return "不明"
 	}
@@ -53,3 +56,8 @@ func (t TransferStatus) IsRequested() bool {
 func (t TransferStatus) IsInProgress() bool {
 	return t == TransferStatusInProgress
 }
+
+// IsNotSubmitted checks if the transfer has not been requested to the bank yet
+func (t TransferStatus) IsNotSubmitted() bool {
+	return t == TransferStatusNotSubmitted
+}
@@ -0,0 +1,35 @@
+package object
+
+// zenginSymbols are the symbols allowed in Zengin (全銀) name fields besides digits, upper-case letters and half-width katakana
+const zenginSymbols = " ().-/,\\｢｣"
+
+// IsZenginCharacters reports whether s only uses characters allowed in Zengin name fields:
+// digits, upper-case letters, half-width katakana (without small kana) and a few symbols
+func IsZenginCharacters(s string) bool {
+	for _, r := range s {
+		if !isZenginRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isZenginRune(r rune) bool {
+	switch {
+	case r >= '0' && r <= '9':
+		return true
+	case r >= 'A' && r <= 'Z':
+		return true
+	case r == 'ｦ':
+		return true
+	case r >= 'ｱ' && r <= 'ﾟ':
+		return true
+	}
+
+	for _, symbol := range zenginSymbols {
+		if r == symbol {
+			return true
+		}
+	}
+	return false
+}
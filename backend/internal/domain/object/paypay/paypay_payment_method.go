@@ -16,3 +16,8 @@ const (
 	PaymentMethodPayPayPoint       PaypayPaymentMethod = 10 // PayPayポイント
 	PaymentMethodPayPayBankBalance PaypayPaymentMethod = 11 // PayPay銀行残高
 )
+
+// IsValid reports whether the payment method is one of the defined payment methods
+func (m PaypayPaymentMethod) IsValid() bool {
+	return m >= PaymentMethodPayPayBalance && m <= PaymentMethodPayPayBankBalance
+}
@@ -17,4 +17,10 @@ const (
 	PermissionCodeTransferApproveBusiness   PermissionCode = "TRANSFER_APPROVE_BUSINESS"
 	PermissionCodeTransferApproveAccountant PermissionCode = "TRANSFER_APPROVE_ACCOUNTANT"
 	PermissionCodeManualTransfer            PermissionCode = "MANUAL_TRANSFER"
+
+	// Merchant-related permissions
+	PermissionCodeMerchantManage PermissionCode = "MERCHANT_MANAGE"
+
+	// Invoice-related permissions
+	PermissionCodeInvoiceView PermissionCode = "INVOICE_VIEW"
 )
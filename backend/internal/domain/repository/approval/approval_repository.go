@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/approval"
+)
+
+// ApprovalRepository defines the interface for approval data access
+type ApprovalRepository interface {
+	// FindByID finds an approval together with its stage results, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.Approval, error)
+
+	// Create creates a new approval
+	Create(ctx context.Context, approval *model.Approval) error
+
+	// Update updates an existing approval
+	Update(ctx context.Context, approval *model.Approval) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/approval_stage"
+)
+
+// ApprovalStageRepository defines the interface for approval stage data access
+type ApprovalStageRepository interface {
+	// Create records a new stage result
+	Create(ctx context.Context, stage *model.ApprovalStage) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/approval_workflow"
+)
+
+// ApprovalWorkflowRepository defines the interface for approval workflow data access
+type ApprovalWorkflowRepository interface {
+	// FindByID finds an approval workflow by ID, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.ApprovalWorkflow, error)
+}
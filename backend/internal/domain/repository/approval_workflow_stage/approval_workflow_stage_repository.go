@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/approval_workflow_stage"
+)
+
+// ApprovalWorkflowStageRepository defines the interface for approval workflow stage data access
+type ApprovalWorkflowStageRepository interface {
+	// FindByWorkflowID lists the stages of a workflow ordered by level
+	FindByWorkflowID(ctx context.Context, workflowID int) ([]*model.ApprovalWorkflowStage, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+)
+
+// BatchJobRunRepository defines the interface for reading the batch job run history
+type BatchJobRunRepository interface {
+	ListBatchJobRuns(ctx context.Context, params *inputdata.BatchJobRunListInputData) ([]*model.BatchJobRun, int, int, error)
+
+	// FindByID finds a batch job run by its ID, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.BatchJobRun, error)
+
+	// ListPayinFiles lists the payin files created or imported by the batch job run
+	ListPayinFiles(ctx context.Context, batchJobRunID int) ([]*payinModel.PayinFile, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"io"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+)
+
+// InvoiceRepository defines the interface for reading the qualified invoices imported from PayPay
+type InvoiceRepository interface {
+	ListInvoices(ctx context.Context, params *inputdata.InvoiceListInputData) ([]*model.PaypayInvoice, int, int, error)
+
+	// FindByID finds an invoice by its ID, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.PaypayInvoice, error)
+}
+
+// InvoiceFileStorage reads the invoice PDFs from the storage the import batch reads them from
+type InvoiceFileStorage interface {
+	DownloadStream(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+}
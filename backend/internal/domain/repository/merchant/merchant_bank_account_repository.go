@@ -0,0 +1,26 @@
+package merchant
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/merchant"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+)
+
+// MerchantBankAccountRepository defines the interface for merchant bank account data operations
+type MerchantBankAccountRepository interface {
+	// ListByMerchantID lists every registration, change and deletion of a merchant's bank account, newest first
+	ListByMerchantID(ctx context.Context, merchantID int) ([]*model.MerchantBankAccount, error)
+
+	// FindByID finds a bank account of a merchant by its ID, returning nil if it does not exist
+	FindByID(ctx context.Context, merchantID int, id int) (*model.MerchantBankAccount, error)
+
+	// FindByStatus finds the latest bank account of a merchant in the given status, returning nil if there is none
+	FindByStatus(ctx context.Context, merchantID int, status merchantObject.BankAccountStatus) (*model.MerchantBankAccount, error)
+
+	// Create creates a bank account and sets the generated ID on the model
+	Create(ctx context.Context, account *model.MerchantBankAccount) error
+
+	// UpdateStatus updates the status and review columns of a bank account
+	UpdateStatus(ctx context.Context, account *model.MerchantBankAccount) error
+}
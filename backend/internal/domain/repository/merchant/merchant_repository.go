@@ -10,4 +10,29 @@ import (
 // MerchantRepository defines the interface for merchant data operations
 type MerchantRepository interface {
 	ListMerchants(ctx context.Context, params *inputdata.MerchantListInputData) ([]*model.Merchant, int, int, error)
+
+	// FindByID finds a merchant by its ID, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.Merchant, error)
+
+	// FindByIDForUpdate finds a merchant by its ID and locks its row until the end of the transaction,
+	// returning nil if it does not exist
+	FindByIDForUpdate(ctx context.Context, id int) (*model.Merchant, error)
+
+	// FindByIDs finds the merchants with the given IDs together with their latest payment provider reviews
+	FindByIDs(ctx context.Context, ids []int) ([]*model.Merchant, error)
+
+	// ExistsByPaymentMerchantID reports whether another merchant of the payment provider uses the payment merchant ID
+	ExistsByPaymentMerchantID(ctx context.Context, paymentProviderID int, paymentMerchantID string, excludeID int) (bool, error)
+
+	// Create creates a new merchant and sets the generated ID on the model
+	Create(ctx context.Context, merchant *model.Merchant) error
+
+	// Update updates an existing merchant
+	Update(ctx context.Context, merchant *model.Merchant) error
+
+	// Delete soft-deletes a merchant by its ID
+	Delete(ctx context.Context, id int) error
+
+	// CreatePaymentProviderReviews records new payment provider reviews of merchants
+	CreatePaymentProviderReviews(ctx context.Context, reviews []*model.PaymentProviderReview) error
 }
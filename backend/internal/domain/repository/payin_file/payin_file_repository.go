@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+)
+
+// PayinFileRepository defines the interface for reading the payin files and the results of their imports
+type PayinFileRepository interface {
+	// FindByID finds a payin file by its ID, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.PayinFile, error)
+
+	// ListImportErrors lists the row errors of the last import of the payin file
+	ListImportErrors(ctx context.Context, payinFileID int) ([]*model.PayinImportError, error)
+
+	// ListPaypayTransactions lists the amounts and payment details of the PayPay transactions of the payin file
+	ListPaypayTransactions(ctx context.Context, payinFileID int) ([]*paypayModel.PaypayPayinTransaction, error)
+}
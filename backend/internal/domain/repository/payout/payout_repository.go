@@ -9,4 +9,16 @@ import (
 type PayoutRepository interface {
 	// List lists all payouts with filtering and pagination
 	List(ctx context.Context, filter *model.PayoutFilter) ([]*model.Payout, int, int64, error)
+
+	// FindByID finds a payout by its ID, returning nil if it does not exist
+	FindByID(ctx context.Context, id int) (*model.Payout, error)
+
+	// Create creates a new payout and sets the generated ID on the model
+	Create(ctx context.Context, payout *model.Payout) error
+
+	// Update updates an existing payout
+	Update(ctx context.Context, payout *model.Payout) error
+
+	// Delete soft-deletes a payout by its ID
+	Delete(ctx context.Context, id int) error
 }
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+
+	model "github.com/huydq/test/internal/domain/model/payout_record"
 )
 
 type PayoutRecordRepository interface {
@@ -10,4 +12,13 @@ type PayoutRecordRepository interface {
 
 	SumAmountByPayoutID(ctx context.Context, payoutID int) (float64, error)
 	SumAmountByPayoutIDs(ctx context.Context, payoutIDs []int) (map[int]float64, error)
+
+	// FindByPayoutID lists the records belonging to a payout
+	FindByPayoutID(ctx context.Context, payoutID int) ([]*model.PayoutRecord, error)
+
+	// CreateBatch creates multiple payout records and sets the generated IDs on the models
+	CreateBatch(ctx context.Context, records []*model.PayoutRecord) error
+
+	// DeleteByPayoutID soft-deletes all records belonging to a payout
+	DeleteByPayoutID(ctx context.Context, payoutID int) error
 }
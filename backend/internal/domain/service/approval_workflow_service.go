@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	approvalModel "github.com/huydq/test/internal/domain/model/approval"
+	approvalStageModel "github.com/huydq/test/internal/domain/model/approval_stage"
+	workflowStageModel "github.com/huydq/test/internal/domain/model/approval_workflow_stage"
+	userModel "github.com/huydq/test/internal/domain/model/user"
+	approvalObject "github.com/huydq/test/internal/domain/object/approval"
+	approvalStageObject "github.com/huydq/test/internal/domain/object/approval_stage"
+	approvalRepository "github.com/huydq/test/internal/domain/repository/approval"
+	approvalStageRepository "github.com/huydq/test/internal/domain/repository/approval_stage"
+	workflowRepository "github.com/huydq/test/internal/domain/repository/approval_workflow"
+	workflowStageRepository "github.com/huydq/test/internal/domain/repository/approval_workflow_stage"
+)
+
+var (
+	ErrApprovalNotFound            = errors.New("承認が見つかりません")
+	ErrApprovalWorkflowNotFound    = errors.New("承認ワークフローが見つかりません")
+	ErrApprovalWorkflowHasNoStages = errors.New("承認ワークフローに承認段階が設定されていません")
+	ErrApprovalAlreadyCompleted    = errors.New("承認は既に完了しています")
+	ErrApproverRoleNotAllowed      = errors.New("現在の承認段階を承認できるロールではありません")
+	ErrApproverAlreadyActed        = errors.New("同じ承認者が重複して承認することはできません")
+	ErrApproverIsRequester         = errors.New("申請者または作成者は承認できません")
+)
+
+type ApprovalWorkflowService interface {
+	StartApproval(ctx context.Context, workflowID int, requesterID int) (*approvalModel.Approval, error)
+	GetApprovalByID(ctx context.Context, id int) (*approvalModel.Approval, error)
+	Approve(ctx context.Context, approvalID int, approver *userModel.User) (*approvalModel.Approval, error)
+	Reject(ctx context.Context, approvalID int, approver *userModel.User) (*approvalModel.Approval, error)
+}
+
+type approvalWorkflowServiceImpl struct {
+	approvalRepo      approvalRepository.ApprovalRepository
+	approvalStageRepo approvalStageRepository.ApprovalStageRepository
+	workflowRepo      workflowRepository.ApprovalWorkflowRepository
+	workflowStageRepo workflowStageRepository.ApprovalWorkflowStageRepository
+}
+
+func NewApprovalWorkflowService(
+	approvalRepo approvalRepository.ApprovalRepository,
+	approvalStageRepo approvalStageRepository.ApprovalStageRepository,
+	workflowRepo workflowRepository.ApprovalWorkflowRepository,
+	workflowStageRepo workflowStageRepository.ApprovalWorkflowStageRepository,
+) ApprovalWorkflowService {
+	return &approvalWorkflowServiceImpl{
+		approvalRepo:      approvalRepo,
+		approvalStageRepo: approvalStageRepo,
+		workflowRepo:      workflowRepo,
+		workflowStageRepo: workflowStageRepo,
+	}
+}
+
+// StartApproval creates a pending approval for the given workflow, requested by the given user
+func (s *approvalWorkflowServiceImpl) StartApproval(ctx context.Context, workflowID int, requesterID int) (*approvalModel.Approval, error) {
+	workflow, err := s.workflowRepo.FindByID(ctx, workflowID)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, ErrApprovalWorkflowNotFound
+	}
+
+	stages, err := s.workflowStageRepo.FindByWorkflowID(ctx, workflow.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return nil, ErrApprovalWorkflowHasNoStages
+	}
+
+	approval := approvalModel.NewApproval(approvalModel.ApprovalParams{
+		ApprovalWorkflowID: workflow.ID,
+		ApprovalStatus:     approvalObject.ApprovalStatusPending,
+		RequestedBy:        &requesterID,
+	})
+	if err := s.approvalRepo.Create(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+// GetApprovalByID retrieves an approval with its recorded stage results
+func (s *approvalWorkflowServiceImpl) GetApprovalByID(ctx context.Context, id int) (*approvalModel.Approval, error) {
+	approval, err := s.approvalRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if approval == nil {
+		return nil, ErrApprovalNotFound
+	}
+
+	return approval, nil
+}
+
+// Approve records an approval on the current stage and advances the approval status.
+// The approval becomes approved once the last stage has collected its required number of approvers.
+func (s *approvalWorkflowServiceImpl) Approve(ctx context.Context, approvalID int, approver *userModel.User) (*approvalModel.Approval, error) {
+	approval, stages, current, err := s.prepareDecision(ctx, approvalID, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordStage(ctx, approval, current, approver, approvalStageObject.ApprovalResultApproved); err != nil {
+		return nil, err
+	}
+
+	if currentStage(approval, stages) == nil {
+		approval.SetStatus(approvalObject.ApprovalStatusApproved)
+	} else {
+		approval.SetStatus(approvalObject.ApprovalStatusWaitApproval)
+	}
+
+	if err := s.approvalRepo.Update(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+// Reject records a rejection on the current stage, which rejects the whole approval
+func (s *approvalWorkflowServiceImpl) Reject(ctx context.Context, approvalID int, approver *userModel.User) (*approvalModel.Approval, error) {
+	approval, _, current, err := s.prepareDecision(ctx, approvalID, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordStage(ctx, approval, current, approver, approvalStageObject.ApprovalResultRejected); err != nil {
+		return nil, err
+	}
+
+	approval.SetStatus(approvalObject.ApprovalStatusRejected)
+	if err := s.approvalRepo.Update(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+// prepareDecision loads the approval and its workflow stages, and checks that the approver may act on the current stage.
+// The requester of the approval may not decide on it.
+func (s *approvalWorkflowServiceImpl) prepareDecision(
+	ctx context.Context,
+	approvalID int,
+	approver *userModel.User,
+) (*approvalModel.Approval, []*workflowStageModel.ApprovalWorkflowStage, *workflowStageModel.ApprovalWorkflowStage, error) {
+	approval, err := s.GetApprovalByID(ctx, approvalID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if approval.IsCompleted() {
+		return nil, nil, nil, ErrApprovalAlreadyCompleted
+	}
+
+	stages, err := s.workflowStageRepo.FindByWorkflowID(ctx, approval.ApprovalWorkflowID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	current := currentStage(approval, stages)
+	if current == nil {
+		return nil, nil, nil, ErrApprovalAlreadyCompleted
+	}
+	if approval.IsRequestedBy(approver.ID) {
+		return nil, nil, nil, ErrApproverIsRequester
+	}
+	if approver.RoleID != current.ApproverRoleID {
+		return nil, nil, nil, ErrApproverRoleNotAllowed
+	}
+	if approval.HasApprover(approver.ID) {
+		return nil, nil, nil, ErrApproverAlreadyActed
+	}
+
+	return approval, stages, current, nil
+}
+
+// recordStage persists the approver's decision for the given workflow stage
+func (s *approvalWorkflowServiceImpl) recordStage(
+	ctx context.Context,
+	approval *approvalModel.Approval,
+	stage *workflowStageModel.ApprovalWorkflowStage,
+	approver *userModel.User,
+	result approvalStageObject.ApprovalResult,
+) error {
+	approvalStage := approvalStageModel.NewApprovalStage(approvalStageModel.ApprovalStageParams{
+		ApprovalID:              approval.ID,
+		ApprovalWorkflowStageID: stage.ID,
+		ApproverID:              approver.ID,
+		ApprovalResult:          result,
+	})
+	if err := s.approvalStageRepo.Create(ctx, approvalStage); err != nil {
+		return err
+	}
+
+	approval.AddStage(approvalStage)
+	return nil
+}
+
+// currentStage returns the lowest level stage that has not yet collected its required approvers,
+// or nil when every stage is satisfied. Stages must be ordered by level.
+func currentStage(approval *approvalModel.Approval, stages []*workflowStageModel.ApprovalWorkflowStage) *workflowStageModel.ApprovalWorkflowStage {
+	for _, stage := range stages {
+		required := max(stage.ApproverCount, 1)
+		if approval.CountApproved(stage.ID) < required {
+			return stage
+		}
+	}
+	return nil
+}
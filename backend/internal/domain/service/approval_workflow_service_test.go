@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	approvalModel "github.com/huydq/test/internal/domain/model/approval"
+	approvalStageModel "github.com/huydq/test/internal/domain/model/approval_stage"
+	workflowModel "github.com/huydq/test/internal/domain/model/approval_workflow"
+	workflowStageModel "github.com/huydq/test/internal/domain/model/approval_workflow_stage"
+	userModel "github.com/huydq/test/internal/domain/model/user"
+	approvalObject "github.com/huydq/test/internal/domain/object/approval"
+	approvalStageObject "github.com/huydq/test/internal/domain/object/approval_stage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testWorkflowID = 1
+	// Roles of the stages of the test workflow
+	testStaffRoleID   = 2
+	testManagerRoleID = 3
+)
+
+// fakeApprovalStore keeps the approvals and their stage results, which FindByID returns together as the preload does
+type fakeApprovalStore struct {
+	approvals map[int]approvalModel.Approval
+	stages    map[int][]*approvalStageModel.ApprovalStage
+	stageSeq  int
+}
+
+func newFakeApprovalStore() *fakeApprovalStore {
+	return &fakeApprovalStore{
+		approvals: map[int]approvalModel.Approval{},
+		stages:    map[int][]*approvalStageModel.ApprovalStage{},
+	}
+}
+
+func (s *fakeApprovalStore) FindByID(_ context.Context, id int) (*approvalModel.Approval, error) {
+	approval, ok := s.approvals[id]
+	if !ok {
+		return nil, nil
+	}
+	approval.ApprovalStages = append([]*approvalStageModel.ApprovalStage(nil), s.stages[id]...)
+	return &approval, nil
+}
+
+func (s *fakeApprovalStore) Create(_ context.Context, approval *approvalModel.Approval) error {
+	approval.ID = len(s.approvals) + 1
+	s.approvals[approval.ID] = *approval
+	return nil
+}
+
+func (s *fakeApprovalStore) Update(_ context.Context, approval *approvalModel.Approval) error {
+	stored := s.approvals[approval.ID]
+	stored.ApprovalStatus = approval.ApprovalStatus
+	s.approvals[approval.ID] = stored
+	return nil
+}
+
+// fakeApprovalStageStore records the stage results into the approval store
+type fakeApprovalStageStore struct {
+	store *fakeApprovalStore
+}
+
+func (s *fakeApprovalStageStore) Create(_ context.Context, stage *approvalStageModel.ApprovalStage) error {
+	s.store.stageSeq++
+	stage.ID = s.store.stageSeq
+	s.store.stages[stage.ApprovalID] = append(s.store.stages[stage.ApprovalID], stage)
+	return nil
+}
+
+type fakeWorkflowRepository struct {
+	workflows map[int]*workflowModel.ApprovalWorkflow
+}
+
+func (r *fakeWorkflowRepository) FindByID(_ context.Context, id int) (*workflowModel.ApprovalWorkflow, error) {
+	return r.workflows[id], nil
+}
+
+// fakeWorkflowStageRepository returns the stages of a workflow as stored, which are ordered by level
+type fakeWorkflowStageRepository struct {
+	stages map[int][]*workflowStageModel.ApprovalWorkflowStage
+}
+
+func (r *fakeWorkflowStageRepository) FindByWorkflowID(_ context.Context, workflowID int) ([]*workflowStageModel.ApprovalWorkflowStage, error) {
+	return r.stages[workflowID], nil
+}
+
+// newTestWorkflowStages returns a workflow where one staff member then two managers approve. The IDs do not follow the
+// levels so that the stages are only walked in level order.
+func newTestWorkflowStages() []*workflowStageModel.ApprovalWorkflowStage {
+	return []*workflowStageModel.ApprovalWorkflowStage{
+		workflowStageModel.NewApprovalWorkflowStage(workflowStageModel.ApprovalWorkflowStageParams{
+			ID: 20, WorkflowID: testWorkflowID, StageName: "事業担当者", Level: 1, ApproverRoleID: testStaffRoleID, ApproverCount: 1,
+		}),
+		workflowStageModel.NewApprovalWorkflowStage(workflowStageModel.ApprovalWorkflowStageParams{
+			ID: 10, WorkflowID: testWorkflowID, StageName: "管理者", Level: 2, ApproverRoleID: testManagerRoleID, ApproverCount: 2,
+		}),
+	}
+}
+
+func newTestApprovalWorkflowService(stages []*workflowStageModel.ApprovalWorkflowStage) (ApprovalWorkflowService, *fakeApprovalStore) {
+	store := newFakeApprovalStore()
+	return NewApprovalWorkflowService(
+		store,
+		&fakeApprovalStageStore{store: store},
+		&fakeWorkflowRepository{workflows: map[int]*workflowModel.ApprovalWorkflow{testWorkflowID: {ID: testWorkflowID}}},
+		&fakeWorkflowStageRepository{stages: map[int][]*workflowStageModel.ApprovalWorkflowStage{testWorkflowID: stages}},
+	), store
+}
+
+func testUser(id, roleID int) *userModel.User {
+	return &userModel.User{ID: id, RoleID: roleID}
+}
+
+func TestApprovalWorkflowService_StartApproval(t *testing.T) {
+	t.Run("the approval is pending and records the requester", func(t *testing.T) {
+		svc, store := newTestApprovalWorkflowService(newTestWorkflowStages())
+
+		approval, err := svc.StartApproval(context.Background(), testWorkflowID, 9)
+		require.NoError(t, err)
+		assert.Equal(t, approvalObject.ApprovalStatusPending, approval.ApprovalStatus)
+		assert.True(t, approval.IsRequestedBy(9))
+
+		stored, err := store.FindByID(context.Background(), approval.ID)
+		require.NoError(t, err)
+		assert.True(t, stored.IsRequestedBy(9))
+	})
+
+	t.Run("the workflow must exist", func(t *testing.T) {
+		svc, _ := newTestApprovalWorkflowService(newTestWorkflowStages())
+
+		_, err := svc.StartApproval(context.Background(), 99, 9)
+		assert.ErrorIs(t, err, ErrApprovalWorkflowNotFound)
+	})
+
+	t.Run("the workflow must have stages", func(t *testing.T) {
+		svc, _ := newTestApprovalWorkflowService(nil)
+
+		_, err := svc.StartApproval(context.Background(), testWorkflowID, 9)
+		assert.ErrorIs(t, err, ErrApprovalWorkflowHasNoStages)
+	})
+}
+
+// approvalDecision is a decision of a user on the approval, and what it results in
+type approvalDecision struct {
+	approver   *userModel.User
+	reject     bool
+	wantErr    error
+	wantStatus approvalObject.ApprovalStatus
+}
+
+func TestApprovalWorkflowService_Decisions(t *testing.T) {
+	const requesterID = 9
+	staff := testUser(1, testStaffRoleID)
+	otherStaff := testUser(2, testStaffRoleID)
+	manager := testUser(3, testManagerRoleID)
+	otherManager := testUser(4, testManagerRoleID)
+
+	cases := []struct {
+		name      string
+		decisions []approvalDecision
+	}{
+		{
+			name: "the stages are approved in level order by their number of approvers",
+			decisions: []approvalDecision{
+				{approver: staff, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: manager, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: otherManager, wantStatus: approvalObject.ApprovalStatusApproved},
+			},
+		},
+		{
+			name: "a role of a later stage cannot approve the current stage",
+			decisions: []approvalDecision{
+				{approver: manager, wantErr: ErrApproverRoleNotAllowed, wantStatus: approvalObject.ApprovalStatusPending},
+				{approver: staff, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: otherStaff, wantErr: ErrApproverRoleNotAllowed, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+			},
+		},
+		{
+			name: "an approver cannot approve twice to fill a stage",
+			decisions: []approvalDecision{
+				{approver: staff, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: manager, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: manager, wantErr: ErrApproverAlreadyActed, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: manager, reject: true, wantErr: ErrApproverAlreadyActed, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+			},
+		},
+		{
+			name: "the requester cannot decide on their approval",
+			decisions: []approvalDecision{
+				{approver: testUser(requesterID, testStaffRoleID), wantErr: ErrApproverIsRequester, wantStatus: approvalObject.ApprovalStatusPending},
+				{approver: testUser(requesterID, testStaffRoleID), reject: true, wantErr: ErrApproverIsRequester, wantStatus: approvalObject.ApprovalStatusPending},
+			},
+		},
+		{
+			name: "a rejection on a pending approval rejects it",
+			decisions: []approvalDecision{
+				{approver: staff, reject: true, wantStatus: approvalObject.ApprovalStatusRejected},
+			},
+		},
+		{
+			name: "a rejection on a later stage rejects the approval",
+			decisions: []approvalDecision{
+				{approver: staff, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: manager, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: otherManager, reject: true, wantStatus: approvalObject.ApprovalStatusRejected},
+			},
+		},
+		{
+			name: "an approved approval takes no more decisions",
+			decisions: []approvalDecision{
+				{approver: staff, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: manager, wantStatus: approvalObject.ApprovalStatusWaitApproval},
+				{approver: otherManager, wantStatus: approvalObject.ApprovalStatusApproved},
+				{approver: testUser(5, testManagerRoleID), wantErr: ErrApprovalAlreadyCompleted, wantStatus: approvalObject.ApprovalStatusApproved},
+				{approver: testUser(5, testManagerRoleID), reject: true, wantErr: ErrApprovalAlreadyCompleted, wantStatus: approvalObject.ApprovalStatusApproved},
+			},
+		},
+		{
+			name: "a rejected approval takes no more decisions",
+			decisions: []approvalDecision{
+				{approver: staff, reject: true, wantStatus: approvalObject.ApprovalStatusRejected},
+				{approver: otherStaff, wantErr: ErrApprovalAlreadyCompleted, wantStatus: approvalObject.ApprovalStatusRejected},
+				{approver: otherStaff, reject: true, wantErr: ErrApprovalAlreadyCompleted, wantStatus: approvalObject.ApprovalStatusRejected},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, store := newTestApprovalWorkflowService(newTestWorkflowStages())
+			approval, err := svc.StartApproval(ctx, testWorkflowID, requesterID)
+			require.NoError(t, err)
+
+			for i, decision := range tc.decisions {
+				decide := svc.Approve
+				if decision.reject {
+					decide = svc.Reject
+				}
+				result, err := decide(ctx, approval.ID, decision.approver)
+				if decision.wantErr != nil {
+					assert.ErrorIs(t, err, decision.wantErr, "decision %d", i)
+				} else {
+					require.NoError(t, err, "decision %d", i)
+					assert.Equal(t, decision.wantStatus, result.ApprovalStatus, "decision %d", i)
+				}
+
+				stored, err := store.FindByID(ctx, approval.ID)
+				require.NoError(t, err)
+				assert.Equal(t, decision.wantStatus, stored.ApprovalStatus, "decision %d", i)
+			}
+		})
+	}
+}
+
+func TestApprovalWorkflowService_RecordsStageResults(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestApprovalWorkflowService(newTestWorkflowStages())
+	approval, err := svc.StartApproval(ctx, testWorkflowID, 9)
+	require.NoError(t, err)
+
+	_, err = svc.Approve(ctx, approval.ID, testUser(1, testStaffRoleID))
+	require.NoError(t, err)
+	result, err := svc.Reject(ctx, approval.ID, testUser(3, testManagerRoleID))
+	require.NoError(t, err)
+
+	require.Len(t, result.ApprovalStages, 2)
+	assert.Equal(t, 20, result.ApprovalStages[0].ApprovalWorkflowStageID)
+	assert.Equal(t, 1, result.ApprovalStages[0].ApproverID)
+	assert.Equal(t, approvalStageObject.ApprovalResultApproved, result.ApprovalStages[0].ApprovalResult)
+	assert.Equal(t, 10, result.ApprovalStages[1].ApprovalWorkflowStageID)
+	assert.Equal(t, 3, result.ApprovalStages[1].ApproverID)
+	assert.Equal(t, approvalStageObject.ApprovalResultRejected, result.ApprovalStages[1].ApprovalResult)
+}
+
+func TestCurrentStage(t *testing.T) {
+	stages := newTestWorkflowStages()
+	approved := func(workflowStageID, approverID int) *approvalStageModel.ApprovalStage {
+		return approvalStageModel.NewApprovalStage(approvalStageModel.ApprovalStageParams{
+			ApprovalWorkflowStageID: workflowStageID,
+			ApproverID:              approverID,
+			ApprovalResult:          approvalStageObject.ApprovalResultApproved,
+		})
+	}
+
+	cases := []struct {
+		name      string
+		stages    []*workflowStageModel.ApprovalWorkflowStage
+		recorded  []*approvalStageModel.ApprovalStage
+		wantStage *workflowStageModel.ApprovalWorkflowStage
+	}{
+		{"the first level comes first", stages, nil, stages[0]},
+		{"the next level once the first has its approver", stages, []*approvalStageModel.ApprovalStage{approved(20, 1)}, stages[1]},
+		{"a level stays current until it has all its approvers", stages, []*approvalStageModel.ApprovalStage{approved(20, 1), approved(10, 3)}, stages[1]},
+		{"none once every level has its approvers", stages, []*approvalStageModel.ApprovalStage{approved(20, 1), approved(10, 3), approved(10, 4)}, nil},
+		{
+			"a stage without an approver count takes one approver",
+			[]*workflowStageModel.ApprovalWorkflowStage{{ID: 30, Level: 1, ApproverRoleID: testStaffRoleID}},
+			[]*approvalStageModel.ApprovalStage{approved(30, 1)},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			approval := approvalModel.NewApproval(approvalModel.ApprovalParams{ApprovalWorkflowID: testWorkflowID})
+			for _, stage := range tc.recorded {
+				approval.AddStage(stage)
+			}
+			assert.Same(t, tc.wantStage, currentStage(approval, tc.stages))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	object "github.com/huydq/test/internal/domain/object/merchant"
+	"golang.org/x/text/encoding/japanese"
+)
+
+const merchantReviewStatusCSVMaxRows = 10000
+
+var (
+	ErrMerchantReviewStatusCSVEmpty       = errors.New("アップロードファイルにデータがありません")
+	ErrMerchantReviewStatusCSVHeader      = errors.New("アップロードファイルのヘッダーに加盟店IDと審査状況の列がありません")
+	ErrMerchantReviewStatusCSVTooManyRows = fmt.Errorf("アップロードファイルの行数が上限(%d行)を超えています", merchantReviewStatusCSVMaxRows)
+	ErrMerchantReviewStatusCSVMalformed   = errors.New("アップロードファイルをCSVとして読み込めません")
+)
+
+var (
+	merchantReviewStatusCSVMerchantIDHeaders = []string{"merchant_id", "加盟店id"}
+	merchantReviewStatusCSVStatusHeaders     = []string{"merchant_review_status", "review_status", "審査状況", "審査ステータス"}
+)
+
+// MerchantReviewStatusRow is a data row of an uploaded merchant review status CSV
+type MerchantReviewStatusRow struct {
+	RowNumber            int
+	MerchantID           *int
+	MerchantReviewStatus *object.MerchantReviewStatus
+	Errors               []string
+}
+
+// IsValid reports whether the row can be applied
+func (r *MerchantReviewStatusRow) IsValid() bool {
+	return len(r.Errors) == 0
+}
+
+// AddError records why the row cannot be applied
+func (r *MerchantReviewStatusRow) AddError(message string) {
+	r.Errors = append(r.Errors, message)
+}
+
+type MerchantReviewStatusCSVService interface {
+	// Parse reads a UTF-8 (with or without BOM) or Shift_JIS CSV of merchant IDs and review statuses.
+	// Problems with individual rows are reported on the rows; only unreadable files return an error.
+	Parse(r io.Reader) ([]*MerchantReviewStatusRow, error)
+}
+
+type merchantReviewStatusCSVServiceImpl struct{}
+
+func NewMerchantReviewStatusCSVService() MerchantReviewStatusCSVService {
+	return &merchantReviewStatusCSVServiceImpl{}
+}
+
+func (s *merchantReviewStatusCSVServiceImpl) Parse(r io.Reader) ([]*MerchantReviewStatusRow, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err = decodeUploadedCSV(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMerchantReviewStatusCSVMalformed, err)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+
+	merchantIDColumn, statusColumn := -1, -1
+	rows := make([]*MerchantReviewStatusRow, 0)
+	seenMerchantRows := make(map[int]int)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMerchantReviewStatusCSVMalformed, err)
+		}
+		if isBlankCSVRecord(record) {
+			continue
+		}
+
+		if merchantIDColumn < 0 {
+			merchantIDColumn, statusColumn = findMerchantReviewStatusColumns(record)
+			if merchantIDColumn >= 0 {
+				continue
+			}
+			if _, err := strconv.Atoi(strings.TrimSpace(record[0])); err != nil {
+				return nil, ErrMerchantReviewStatusCSVHeader
+			}
+			// Files without a header are read as merchant ID, review status
+			merchantIDColumn, statusColumn = 0, 1
+		}
+
+		if len(rows) >= merchantReviewStatusCSVMaxRows {
+			return nil, ErrMerchantReviewStatusCSVTooManyRows
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := parseMerchantReviewStatusRow(line, record, merchantIDColumn, statusColumn)
+		if row.MerchantID != nil {
+			if firstRow, ok := seenMerchantRows[*row.MerchantID]; ok {
+				row.AddError(fmt.Sprintf("加盟店IDが%d行目と重複しています", firstRow))
+			} else {
+				seenMerchantRows[*row.MerchantID] = row.RowNumber
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrMerchantReviewStatusCSVEmpty
+	}
+
+	return rows, nil
+}
+
+func parseMerchantReviewStatusRow(line int, record []string, merchantIDColumn int, statusColumn int) *MerchantReviewStatusRow {
+	row := &MerchantReviewStatusRow{RowNumber: line}
+
+	merchantID := csvCell(record, merchantIDColumn)
+	if merchantID == "" {
+		row.AddError("加盟店IDが入力されていません")
+	} else if id, err := strconv.Atoi(merchantID); err != nil || id <= 0 {
+		row.AddError(fmt.Sprintf("加盟店ID「%s」が不正です", merchantID))
+	} else {
+		row.MerchantID = &id
+	}
+
+	status := csvCell(record, statusColumn)
+	if status == "" {
+		row.AddError("審査状況が入力されていません")
+	} else if reviewStatus, ok := object.ParseMerchantReviewStatus(status); !ok {
+		row.AddError(fmt.Sprintf("審査状況「%s」が不正です（審査中/審査通過/審査否認 または 1/2/3）", status))
+	} else {
+		row.MerchantReviewStatus = &reviewStatus
+	}
+
+	return row
+}
+
+// findMerchantReviewStatusColumns returns the merchant ID and review status columns if the record is a header
+func findMerchantReviewStatusColumns(record []string) (int, int) {
+	merchantIDColumn, statusColumn := -1, -1
+	for i, value := range record {
+		name := strings.ToLower(strings.TrimSpace(value))
+		switch {
+		case merchantIDColumn < 0 && slices.Contains(merchantReviewStatusCSVMerchantIDHeaders, name):
+			merchantIDColumn = i
+		case statusColumn < 0 && slices.Contains(merchantReviewStatusCSVStatusHeaders, name):
+			statusColumn = i
+		}
+	}
+
+	if merchantIDColumn < 0 || statusColumn < 0 {
+		return -1, -1
+	}
+
+	return merchantIDColumn, statusColumn
+}
+
+// decodeUploadedCSV strips a UTF-8 BOM and converts Shift_JIS content, as saved by Excel, to UTF-8
+func decodeUploadedCSV(content []byte) ([]byte, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(content) {
+		return content, nil
+	}
+
+	return japanese.ShiftJIS.NewDecoder().Bytes(content)
+}
+
+func csvCell(record []string, column int) string {
+	if column >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[column])
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
 	model "github.com/huydq/test/internal/domain/model/payout"
 	repository "github.com/huydq/test/internal/domain/repository/payout"
 	prRepository "github.com/huydq/test/internal/domain/repository/payout_record"
@@ -10,6 +11,11 @@ import (
 
 type PayoutManagementService interface {
 	ListPayouts(ctx context.Context, filter *model.PayoutFilter) ([]*model.Payout, int, int64, error)
+	GetPayoutByID(ctx context.Context, id int) (*model.Payout, error)
+	CreatePayout(ctx context.Context, payout *model.Payout) error
+	UpdatePayout(ctx context.Context, payout *model.Payout) error
+	UpdatePayoutStatus(ctx context.Context, payout *model.Payout) error
+	DeletePayout(ctx context.Context, id int) error
 }
 
 type payoutManagementServiceImpl struct {
@@ -57,3 +63,70 @@ func (s *payoutManagementServiceImpl) ListPayouts(ctx context.Context, filter *m
 
 	return payouts, totalPages, count, nil
 }
+
+// GetPayoutByID retrieves a payout together with its records, returning nil if it does not exist
+func (s *payoutManagementServiceImpl) GetPayoutByID(ctx context.Context, id int) (*model.Payout, error) {
+	payout, err := s.payoutRepo.FindByID(ctx, id)
+	if err != nil || payout == nil {
+		return nil, err
+	}
+
+	records, err := s.payoutRecordRepo.FindByPayoutID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	payout.SetPayoutRecords(records)
+
+	return payout, nil
+}
+
+// CreatePayout persists a payout and its records
+func (s *payoutManagementServiceImpl) CreatePayout(ctx context.Context, payout *model.Payout) error {
+	payout.SetPayoutRecords(payout.PayoutRecords)
+	if err := s.payoutRepo.Create(ctx, payout); err != nil {
+		return err
+	}
+
+	return s.createPayoutRecords(ctx, payout)
+}
+
+// UpdatePayout persists the payout and replaces all of its records
+func (s *payoutManagementServiceImpl) UpdatePayout(ctx context.Context, payout *model.Payout) error {
+	payout.SetPayoutRecords(payout.PayoutRecords)
+	if err := s.payoutRepo.Update(ctx, payout); err != nil {
+		return err
+	}
+
+	if err := s.payoutRecordRepo.DeleteByPayoutID(ctx, payout.ID); err != nil {
+		return err
+	}
+
+	return s.createPayoutRecords(ctx, payout)
+}
+
+// UpdatePayoutStatus persists the payout's status and approval link without touching its records
+func (s *payoutManagementServiceImpl) UpdatePayoutStatus(ctx context.Context, payout *model.Payout) error {
+	return s.payoutRepo.Update(ctx, payout)
+}
+
+// DeletePayout soft-deletes a payout and its records
+func (s *payoutManagementServiceImpl) DeletePayout(ctx context.Context, id int) error {
+	if err := s.payoutRecordRepo.DeleteByPayoutID(ctx, id); err != nil {
+		return err
+	}
+
+	return s.payoutRepo.Delete(ctx, id)
+}
+
+// createPayoutRecords links the records to the payout and assigns each one an idempotency key
+func (s *payoutManagementServiceImpl) createPayoutRecords(ctx context.Context, payout *model.Payout) error {
+	for _, record := range payout.PayoutRecords {
+		record.ID = 0
+		record.PayoutID = payout.ID
+		if record.IdempotencyKey == "" {
+			record.IdempotencyKey = uuid.NewString()
+		}
+	}
+
+	return s.payoutRecordRepo.CreateBatch(ctx, payout.PayoutRecords)
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	model "github.com/huydq/test/internal/domain/model/payout"
+	prModel "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// Zengin (全銀) general transfer file layout
+const (
+	zenginRecordLength  = 120
+	zenginLineSeparator = "\r\n"
+
+	zenginDataTypeHeader  = "1"
+	zenginDataTypeData    = "2"
+	zenginDataTypeTrailer = "8"
+	zenginDataTypeEnd     = "9"
+
+	zenginTransferTypeGeneral = "21" // 総合振込
+	zenginCodeTypeShiftJIS    = "0"
+	zenginNewCode             = "0"
+	zenginTransferCategory    = "7" // テレ振込
+
+	zenginMaxAmount      = 9999999999
+	zenginMaxTotalAmount = 999999999999
+	zenginMaxRecordCount = 999999
+)
+
+var (
+	zenginRequesterCodePattern = regexp.MustCompile(`^[0-9]{10}$`)
+	zenginBankCodePattern      = regexp.MustCompile(`^[0-9]{4}$`)
+	zenginBranchCodePattern    = regexp.MustCompile(`^[0-9]{3}$`)
+	zenginAccountNoPattern     = regexp.MustCompile(`^[0-9]{1,7}$`)
+)
+
+// ZenginValidationError lists every field that prevents a Zengin transfer file from being produced
+type ZenginValidationError struct {
+	Errors []string
+}
+
+func (e *ZenginValidationError) Error() string {
+	return "全銀振込ファイルの項目が不正です: " + strings.Join(e.Errors, ", ")
+}
+
+// ZenginRequester is the company account the transfers are paid from
+type ZenginRequester struct {
+	Code        string
+	Name        string
+	BankCode    string
+	BankName    string
+	BranchCode  string
+	BranchName  string
+	AccountType object.BankAccountType
+	AccountNo   string
+}
+
+// ZenginTransferFile is a Shift_JIS encoded Zengin general transfer file
+type ZenginTransferFile struct {
+	FileName string
+	Content  []byte
+}
+
+type ZenginTransferFileService interface {
+	BuildTransferFile(payout *model.Payout, records []*prModel.PayoutRecord) (*ZenginTransferFile, error)
+}
+
+type zenginTransferFileServiceImpl struct {
+	requester ZenginRequester
+}
+
+func NewZenginTransferFileService(requester ZenginRequester) ZenginTransferFileService {
+	return &zenginTransferFileServiceImpl{
+		requester: requester,
+	}
+}
+
+// BuildTransferFile validates the payout records and renders them as header, data, trailer and end records
+func (s *zenginTransferFileServiceImpl) BuildTransferFile(payout *model.Payout, records []*prModel.PayoutRecord) (*ZenginTransferFile, error) {
+	v := &zenginValidator{}
+	s.validateRequester(v)
+	if len(records) == 0 {
+		v.add("振込明細がありません")
+	}
+	if len(records) > zenginMaxRecordCount {
+		v.add(fmt.Sprintf("振込件数は%d件以下である必要があります", zenginMaxRecordCount))
+	}
+
+	var total float64
+	for i, record := range records {
+		validateZenginRecord(v, i+1, record)
+		total += record.Amount
+	}
+	if total > zenginMaxTotalAmount {
+		v.add("振込金額合計が上限を超えています")
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(records)+3)
+	lines = append(lines, s.headerRecord(payout))
+	for _, record := range records {
+		lines = append(lines, dataRecord(record))
+	}
+	lines = append(lines,
+		trailerRecord(len(records), total),
+		zenginDataTypeEnd+strings.Repeat(" ", zenginRecordLength-1),
+	)
+
+	var content bytes.Buffer
+	encoder := japanese.ShiftJIS.NewEncoder()
+	for _, line := range lines {
+		encoded, err := encoder.String(line)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode zengin record: %w", err)
+		}
+		if len(encoded) != zenginRecordLength {
+			return nil, fmt.Errorf("zengin record must be %d bytes, got %d", zenginRecordLength, len(encoded))
+		}
+		content.WriteString(encoded)
+		content.WriteString(zenginLineSeparator)
+	}
+
+	return &ZenginTransferFile{
+		FileName: fmt.Sprintf("zengin_payout_%d_%s.txt", payout.ID, payout.SendingDate.Format("20060102")),
+		Content:  content.Bytes(),
+	}, nil
+}
+
+func (s *zenginTransferFileServiceImpl) validateRequester(v *zenginValidator) {
+	r := s.requester
+	v.match("委託者コード", r.Code, zenginRequesterCodePattern)
+	v.name("委託者名", r.Name, 40, true)
+	v.match("仕向銀行番号", r.BankCode, zenginBankCodePattern)
+	v.name("仕向銀行名", r.BankName, 15, false)
+	v.match("仕向支店番号", r.BranchCode, zenginBranchCodePattern)
+	v.name("仕向支店名", r.BranchName, 15, false)
+	if _, err := toZenginAccountType(r.AccountType); err != nil {
+		v.add("依頼人の" + err.Error())
+	}
+	v.match("依頼人口座番号", r.AccountNo, zenginAccountNoPattern)
+}
+
+func validateZenginRecord(v *zenginValidator, line int, record *prModel.PayoutRecord) {
+	prefix := fmt.Sprintf("%d行目の", line)
+	v.match(prefix+"銀行コード", record.BankCode, zenginBankCodePattern)
+	v.match(prefix+"支店コード", record.BranchCode, zenginBranchCodePattern)
+	v.match(prefix+"口座番号", record.AccountNo, zenginAccountNoPattern)
+	v.name(prefix+"口座名義", record.AccountName, 30, true)
+	if _, err := toZenginAccountType(record.BankAccountType); err != nil {
+		v.add(prefix + err.Error())
+	}
+	if record.Amount <= 0 || record.Amount != math.Trunc(record.Amount) || record.Amount > zenginMaxAmount {
+		v.add(prefix + "振込金額は1円以上の整数で10桁以内である必要があります")
+	}
+}
+
+func (s *zenginTransferFileServiceImpl) headerRecord(payout *model.Payout) string {
+	accountType, _ := toZenginAccountType(s.requester.AccountType)
+	return zenginDataTypeHeader +
+		zenginTransferTypeGeneral +
+		zenginCodeTypeShiftJIS +
+		s.requester.Code +
+		padRight(s.requester.Name, 40) +
+		payout.SendingDate.Format("0102") +
+		s.requester.BankCode +
+		padRight(s.requester.BankName, 15) +
+		s.requester.BranchCode +
+		padRight(s.requester.BranchName, 15) +
+		accountType +
+		padNumber(s.requester.AccountNo, 7) +
+		strings.Repeat(" ", 17)
+}
+
+// dataRecord renders a payout record; the bank and branch names are optional and left blank
+// because the receiving bank resolves them from the codes
+func dataRecord(record *prModel.PayoutRecord) string {
+	accountType, _ := toZenginAccountType(record.BankAccountType)
+	return zenginDataTypeData +
+		record.BankCode +
+		strings.Repeat(" ", 15) +
+		record.BranchCode +
+		strings.Repeat(" ", 15) +
+		strings.Repeat(" ", 4) +
+		accountType +
+		padNumber(record.AccountNo, 7) +
+		padRight(record.AccountName, 30) +
+		fmt.Sprintf("%010d", int64(record.Amount)) +
+		zenginNewCode +
+		strings.Repeat(" ", 20) +
+		zenginTransferCategory +
+		strings.Repeat(" ", 8)
+}
+
+func trailerRecord(count int, total float64) string {
+	return zenginDataTypeTrailer +
+		fmt.Sprintf("%06d", count) +
+		fmt.Sprintf("%012d", int64(total)) +
+		strings.Repeat(" ", 101)
+}
+
+func toZenginAccountType(accountType object.BankAccountType) (string, error) {
+	switch accountType {
+	case object.BankAccountTypeOrdinary:
+		return "1", nil
+	case object.BankAccountTypeCurrent:
+		return "2", nil
+	default:
+		return "", errors.New("預金種目は普通預金または当座預金である必要があります")
+	}
+}
+
+func padRight(value string, width int) string {
+	return value + strings.Repeat(" ", width-utf8.RuneCountInString(value))
+}
+
+func padNumber(value string, width int) string {
+	return strings.Repeat("0", width-len(value)) + value
+}
+
+type zenginValidator struct {
+	errors []string
+}
+
+func (v *zenginValidator) add(message string) {
+	v.errors = append(v.errors, message)
+}
+
+func (v *zenginValidator) match(field, value string, pattern *regexp.Regexp) {
+	if !pattern.MatchString(value) {
+		v.add(field + "の形式が不正です")
+	}
+}
+
+func (v *zenginValidator) name(field, value string, maxLength int, required bool) {
+	switch {
+	case value == "" && required:
+		v.add(field + "は必須です")
+	case utf8.RuneCountInString(value) > maxLength:
+		v.add(fmt.Sprintf("%sは%d文字以内である必要があります", field, maxLength))
+	case !object.IsZenginCharacters(value):
+		v.add(field + "に全銀で使用できない文字が含まれています")
+	}
+}
+
+func (v *zenginValidator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ZenginValidationError{Errors: v.errors}
+}
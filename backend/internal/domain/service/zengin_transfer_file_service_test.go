@@ -0,0 +1,223 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payout"
+	prModel "github.com/huydq/test/internal/domain/model/payout_record"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+func newTestZenginRequester() ZenginRequester {
+	return ZenginRequester{
+		Code:        "1234567890",
+		Name:        "ｶ)ﾃｽﾄｼﾖｳｼﾞ",
+		BankCode:    "0398",
+		BankName:    "ｱｵｿﾞﾗ",
+		BranchCode:  "101",
+		BranchName:  "ﾎﾝﾃﾝ",
+		AccountType: object.BankAccountTypeOrdinary,
+		AccountNo:   "1234567",
+	}
+}
+
+func newTestZenginPayout() *model.Payout {
+	return &model.Payout{
+		ID:          42,
+		SendingDate: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func newTestZenginRecord(accountName string, amount float64) *prModel.PayoutRecord {
+	return prModel.NewPayoutRecord(prModel.PayoutRecordParams{
+		BankCode:        "0005",
+		BranchCode:      "001",
+		BankAccountType: object.BankAccountTypeOrdinary,
+		AccountNo:       "7654321",
+		AccountName:     accountName,
+		Amount:          amount,
+	})
+}
+
+// decodeZenginLines splits the Shift_JIS content into its records, checking that every record ends with CRLF
+func decodeZenginLines(t *testing.T, content []byte) [][]byte {
+	t.Helper()
+
+	require.True(t, bytes.HasSuffix(content, []byte("\r\n")), "the file must end with CRLF")
+	lines := bytes.Split(bytes.TrimSuffix(content, []byte("\r\n")), []byte("\r\n"))
+	for i, line := range lines {
+		require.NotContains(t, string(line), "\n", "record %d has a bare line feed", i+1)
+		require.NotContains(t, string(line), "\r", "record %d has a bare carriage return", i+1)
+	}
+	return lines
+}
+
+func decodeShiftJIS(t *testing.T, b []byte) string {
+	t.Helper()
+
+	decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(b)
+	require.NoError(t, err)
+	return string(decoded)
+}
+
+func TestZenginTransferFileService_BuildTransferFileGolden(t *testing.T) {
+	svc := NewZenginTransferFileService(newTestZenginRequester())
+	records := []*prModel.PayoutRecord{
+		newTestZenginRecord("ﾔﾏﾀﾞ ﾀﾛｳ", 12345),
+		newTestZenginRecord("ｶ)ﾏｲｸｼﾖﾂﾌﾟ", 2500),
+	}
+
+	file, err := svc.BuildTransferFile(newTestZenginPayout(), records)
+	require.NoError(t, err)
+	assert.Equal(t, "zengin_payout_42_20250610.txt", file.FileName)
+
+	golden := filepath.Join("testdata", "zengin_transfer_file.golden")
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(golden, file.Content, 0o644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, want, file.Content)
+}
+
+func TestZenginTransferFileService_BuildTransferFileRecords(t *testing.T) {
+	svc := NewZenginTransferFileService(newTestZenginRequester())
+	records := []*prModel.PayoutRecord{
+		newTestZenginRecord("ﾔﾏﾀﾞ ﾀﾛｳ", 12345),
+		newTestZenginRecord("ｶ)ﾏｲｸｼﾖﾂﾌﾟ", 2500),
+	}
+
+	file, err := svc.BuildTransferFile(newTestZenginPayout(), records)
+	require.NoError(t, err)
+
+	lines := decodeZenginLines(t, file.Content)
+	require.Len(t, lines, 5)
+	for i, line := range lines {
+		assert.Len(t, line, zenginRecordLength, "record %d", i+1)
+	}
+
+	header := lines[0]
+	assert.Equal(t, "1", string(header[0:1]))
+	assert.Equal(t, "21", string(header[1:3]))
+	assert.Equal(t, "0", string(header[3:4]))
+	assert.Equal(t, "1234567890", string(header[4:14]))
+	assert.Equal(t, "ｶ)ﾃｽﾄｼﾖｳｼﾞ", decodeShiftJIS(t, bytes.TrimRight(header[14:54], " ")))
+	assert.Equal(t, "0610", string(header[54:58]))
+	assert.Equal(t, "0398", string(header[58:62]))
+	assert.Equal(t, "101", string(header[77:80]))
+	assert.Equal(t, "1", string(header[95:96]))
+	assert.Equal(t, "1234567", string(header[96:103]))
+
+	data := lines[1]
+	assert.Equal(t, "2", string(data[0:1]))
+	assert.Equal(t, "0005", string(data[1:5]))
+	assert.Equal(t, "001", string(data[20:23]))
+	assert.Equal(t, "1", string(data[42:43]))
+	assert.Equal(t, "7654321", string(data[43:50]))
+	assert.Equal(t, "ﾔﾏﾀﾞ ﾀﾛｳ", decodeShiftJIS(t, bytes.TrimRight(data[50:80], " ")))
+	assert.Equal(t, "0000012345", string(data[80:90]))
+	assert.Equal(t, "7", string(data[111:112]))
+
+	trailer := string(lines[3])
+	assert.Equal(t, "8", trailer[0:1])
+	assert.Equal(t, "000002", trailer[1:7])
+	assert.Equal(t, "000000014845", trailer[7:19])
+
+	end := string(lines[4])
+	assert.Equal(t, "9", end[0:1])
+	assert.Empty(t, bytes.TrimSpace(lines[4][1:]))
+}
+
+func TestZenginTransferFileService_BuildTransferFileValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		accountName string
+		amount      float64
+		wantError   string
+	}{
+		{"empty holder name", "", 1000, "1行目の口座名義は必須です"},
+		{"full-width katakana", "ヤマダ", 1000, "1行目の口座名義に全銀で使用できない文字が含まれています"},
+		{"full-width digits", "ﾔﾏﾀﾞ１", 1000, "1行目の口座名義に全銀で使用できない文字が含まれています"},
+		{"hiragana", "やまだ", 1000, "1行目の口座名義に全銀で使用できない文字が含まれています"},
+		{"kanji", "山田太郎", 1000, "1行目の口座名義に全銀で使用できない文字が含まれています"},
+		{"lower-case letters", "yamada", 1000, "1行目の口座名義に全銀で使用できない文字が含まれています"},
+		{"small kana", "ｶ)ﾏｲｸｼｮｯﾌﾟ", 1000, "1行目の口座名義に全銀で使用できない文字が含まれています"},
+		{"longer than 30 characters", "ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏ", 1000, "1行目の口座名義は30文字以内である必要があります"},
+		{"fractional amount", "ﾔﾏﾀﾞ ﾀﾛｳ", 100.5, "1行目の振込金額は1円以上の整数で10桁以内である必要があります"},
+		{"zero amount", "ﾔﾏﾀﾞ ﾀﾛｳ", 0, "1行目の振込金額は1円以上の整数で10桁以内である必要があります"},
+	}
+
+	svc := NewZenginTransferFileService(newTestZenginRequester())
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := svc.BuildTransferFile(newTestZenginPayout(), []*prModel.PayoutRecord{
+				newTestZenginRecord(tc.accountName, tc.amount),
+			})
+			require.Error(t, err)
+			assert.Nil(t, file)
+
+			var validationErr *ZenginValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, []string{tc.wantError}, validationErr.Errors)
+		})
+	}
+}
+
+func TestZenginTransferFileService_BuildTransferFileAcceptsHolderNames(t *testing.T) {
+	names := []string{
+		"ﾔﾏﾀﾞ ﾀﾛｳ",
+		"ｶ)ﾏｲｸｼﾖﾂﾌﾟ",
+		"ABC(ｶ",
+		"ｲﾛﾊ.ﾆﾎﾍ-ﾄ/ﾁﾘ,ﾇﾙ",
+		"ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎ",
+	}
+
+	svc := NewZenginTransferFileService(newTestZenginRequester())
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			file, err := svc.BuildTransferFile(newTestZenginPayout(), []*prModel.PayoutRecord{
+				newTestZenginRecord(name, 1000),
+			})
+			require.NoError(t, err)
+
+			for i, line := range decodeZenginLines(t, file.Content) {
+				assert.Len(t, line, zenginRecordLength, "record %d", i+1)
+			}
+		})
+	}
+}
+
+func TestZenginTransferFileService_BuildTransferFileRejectsInvalidRecords(t *testing.T) {
+	requester := newTestZenginRequester()
+	requester.Name = "テスト商事"
+	svc := NewZenginTransferFileService(requester)
+
+	record := newTestZenginRecord("ﾔﾏﾀﾞ ﾀﾛｳ", 1000)
+	record.BankCode = "398"
+	record.BankAccountType = object.BankAccountTypeFixed
+
+	_, err := svc.BuildTransferFile(newTestZenginPayout(), []*prModel.PayoutRecord{record})
+
+	var validationErr *ZenginValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.ElementsMatch(t, []string{
+		"委託者名に全銀で使用できない文字が含まれています",
+		"1行目の銀行コードの形式が不正です",
+		"1行目の預金種目は普通預金または当座預金である必要があります",
+	}, validationErr.Errors)
+
+	_, err = svc.BuildTransferFile(newTestZenginPayout(), nil)
+	require.True(t, errors.As(err, &validationErr))
+	assert.Contains(t, validationErr.Errors, "振込明細がありません")
+}
@@ -0,0 +1,81 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/huydq/test/internal/domain/model/approval"
+	repository "github.com/huydq/test/internal/domain/repository/approval"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval/convert"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ApprovalRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewApprovalRepository(db *gorm.DB) repository.ApprovalRepository {
+	return &ApprovalRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindByID finds an approval with its stage results, returning nil if it does not exist.
+// The row is locked so that concurrent decisions on the same approval are serialized within a transaction.
+func (r *ApprovalRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Approval, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var approvalDTO dto.Approval
+	err = db.WithContext(ctx).
+		Preload("ApprovalStages", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("id ASC")
+		}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_at IS NULL").
+		First(&approvalDTO, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convert.ToApprovalModel(&approvalDTO), nil
+}
+
+// Create creates a new approval and sets the generated ID on the model
+func (r *ApprovalRepositoryImpl) Create(ctx context.Context, approval *model.Approval) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	approvalDTO := convert.ToApprovalDTO(approval)
+	result := db.WithContext(ctx).Omit(clause.Associations).Create(approvalDTO)
+	if result.Error == nil {
+		approval.ID = approvalDTO.ID
+		approval.CreatedAt = approvalDTO.CreatedAt
+		approval.UpdatedAt = approvalDTO.UpdatedAt
+	}
+
+	return result.Error
+}
+
+// Update updates the status of an existing approval
+func (r *ApprovalRepositoryImpl) Update(ctx context.Context, approval *model.Approval) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).
+		Model(&dto.Approval{}).
+		Where("id = ?", approval.ID).
+		Update("approval_status", int(approval.ApprovalStatus)).Error
+}
@@ -5,6 +5,7 @@ import (
 	objectApproval "github.com/huydq/test/internal/domain/object/approval"
 	util "github.com/huydq/test/internal/domain/object/basedatetime"
 	"github.com/huydq/test/internal/infrastructure/persistence/approval/dto"
+	approvalStageConvert "github.com/huydq/test/internal/infrastructure/persistence/approval_stage/convert"
 )
 
 // ToApprovalDTO converts an Approval domain model to an Approval
@@ -17,6 +18,7 @@ func ToApprovalDTO(approval *modelApproval.Approval) *dto.Approval {
 		ID:                 approval.ID,
 		ApprovalWorkflowID: approval.ApprovalWorkflowID,
 		ApprovalStatus:     int(approval.ApprovalStatus),
+		RequestedBy:        approval.RequestedBy,
 		BaseColumnTimestamp: util.BaseColumnTimestamp{
 			CreatedAt: approval.CreatedAt,
 			UpdatedAt: approval.UpdatedAt,
@@ -31,16 +33,23 @@ func ToApprovalModel(dtoObj *dto.Approval) *modelApproval.Approval {
 		return nil
 	}
 
-	return &modelApproval.Approval{
+	result := &modelApproval.Approval{
 		ID:                 dtoObj.ID,
 		ApprovalWorkflowID: dtoObj.ApprovalWorkflowID,
 		ApprovalStatus:     objectApproval.ApprovalStatus(dtoObj.ApprovalStatus),
+		RequestedBy:        dtoObj.RequestedBy,
 		BaseColumnTimestamp: util.BaseColumnTimestamp{
 			CreatedAt: dtoObj.CreatedAt,
 			UpdatedAt: dtoObj.UpdatedAt,
 			DeletedAt: dtoObj.DeletedAt,
 		},
 	}
+
+	for i := range dtoObj.ApprovalStages {
+		result.ApprovalStages = append(result.ApprovalStages, approvalStageConvert.ToApprovalStageModel(&dtoObj.ApprovalStages[i]))
+	}
+
+	return result
 }
 
 // ToApprovalDTOs converts a list of Approval domain models to a list of ApprovalDTOs
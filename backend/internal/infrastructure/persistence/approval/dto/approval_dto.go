@@ -17,8 +17,9 @@ type Approval struct {
 	ID int `json:"id"`
 	util.BaseColumnTimestamp
 
-	ApprovalWorkflowID int `json:"approval_workflow_id"`
-	ApprovalStatus     int `json:"approval_status"`
+	ApprovalWorkflowID int  `json:"approval_workflow_id"`
+	ApprovalStatus     int  `json:"approval_status"`
+	RequestedBy        *int `json:"requested_by"`
 
 	ApprovalWorkflow *approvalWorkflowDto.ApprovalWorkflow `json:"approval_workflow,omitempty" gorm:"foreignKey:ApprovalWorkflowID"`
 	ApprovalStages   []approvalStageDto.ApprovalStage      `json:"approval_stages,omitempty" gorm:"foreignKey:ApprovalID"`
@@ -0,0 +1,40 @@
+package persistence
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/approval_stage"
+	repository "github.com/huydq/test/internal/domain/repository/approval_stage"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval_stage/convert"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ApprovalStageRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewApprovalStageRepository(db *gorm.DB) repository.ApprovalStageRepository {
+	return &ApprovalStageRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create records a new stage result and sets the generated ID on the model
+func (r *ApprovalStageRepositoryImpl) Create(ctx context.Context, stage *model.ApprovalStage) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	stageDTO := convert.ToApprovalStageDTO(stage)
+	result := db.WithContext(ctx).Omit(clause.Associations).Create(stageDTO)
+	if result.Error == nil {
+		stage.ID = stageDTO.ID
+		stage.CreatedAt = stageDTO.CreatedAt
+		stage.UpdatedAt = stageDTO.UpdatedAt
+	}
+
+	return result.Error
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/huydq/test/internal/domain/model/approval_workflow"
+	repository "github.com/huydq/test/internal/domain/repository/approval_workflow"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval_workflow/convert"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval_workflow/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type ApprovalWorkflowRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewApprovalWorkflowRepository(db *gorm.DB) repository.ApprovalWorkflowRepository {
+	return &ApprovalWorkflowRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindByID finds an approval workflow by ID, returning nil if it does not exist
+func (r *ApprovalWorkflowRepositoryImpl) FindByID(ctx context.Context, id int) (*model.ApprovalWorkflow, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var workflowDTO dto.ApprovalWorkflow
+	err = db.WithContext(ctx).
+		Where("deleted_at IS NULL").
+		First(&workflowDTO, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convert.ToApprovalWorkflowModel(&workflowDTO), nil
+}
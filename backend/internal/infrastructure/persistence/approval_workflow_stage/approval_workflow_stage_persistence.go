@@ -0,0 +1,42 @@
+package persistence
+
+import (
+	"context"
+
+	model "github.com/huydq/test/internal/domain/model/approval_workflow_stage"
+	repository "github.com/huydq/test/internal/domain/repository/approval_workflow_stage"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval_workflow_stage/convert"
+	"github.com/huydq/test/internal/infrastructure/persistence/approval_workflow_stage/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type ApprovalWorkflowStageRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewApprovalWorkflowStageRepository(db *gorm.DB) repository.ApprovalWorkflowStageRepository {
+	return &ApprovalWorkflowStageRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindByWorkflowID lists the stages of a workflow ordered by level
+func (r *ApprovalWorkflowStageRepositoryImpl) FindByWorkflowID(ctx context.Context, workflowID int) ([]*model.ApprovalWorkflowStage, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var stageDTOs []*dto.ApprovalWorkflowStage
+	err = db.WithContext(ctx).
+		Where("workflow_id = ? AND deleted_at IS NULL", workflowID).
+		Order("level ASC").
+		Order("id ASC").
+		Find(&stageDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ToApprovalWorkflowStageModels(stageDTOs), nil
+}
@@ -0,0 +1,153 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	repository "github.com/huydq/test/internal/domain/repository/batch_job_run"
+	"github.com/huydq/test/internal/infrastructure/persistence/batch_job_run/dto"
+	payinDTO "github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type BatchJobRunRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBatchJobRunRepository(db *gorm.DB) repository.BatchJobRunRepository {
+	return &BatchJobRunRepositoryImpl{
+		db: db,
+	}
+}
+
+// ListBatchJobRuns retrieves batch job runs with optional filtering
+func (r *BatchJobRunRepositoryImpl) ListBatchJobRuns(ctx context.Context, params *inputdata.BatchJobRunListInputData) ([]*model.BatchJobRun, int, int, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var count int64
+	query := r.applyFilters(db.WithContext(ctx).Model(&dto.BatchJobRun{}), params)
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, 0, err
+	}
+
+	query = r.applyFilters(db.WithContext(ctx).Model(&dto.BatchJobRun{}), params)
+	query = r.applyPagination(query, params)
+	query = r.applySorting(query, params)
+
+	var runDTOs []dto.BatchJobRun
+	if err := query.Find(&runDTOs).Error; err != nil {
+		return nil, 0, 0, err
+	}
+
+	totalPages := int(math.Ceil(float64(count) / float64(params.PageSize)))
+
+	runs := make([]*model.BatchJobRun, len(runDTOs))
+	for i := range runDTOs {
+		runs[i] = runDTOs[i].ToBatchJobRunModel()
+	}
+
+	return runs, totalPages, int(count), nil
+}
+
+// FindByID finds a batch job run by its ID
+func (r *BatchJobRunRepositoryImpl) FindByID(ctx context.Context, id int) (*model.BatchJobRun, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var runDTO dto.BatchJobRun
+	if err := db.WithContext(ctx).First(&runDTO, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return runDTO.ToBatchJobRunModel(), nil
+}
+
+// ListPayinFiles lists the payin files touched by the batch job run in the order they were recorded
+func (r *BatchJobRunRepositoryImpl) ListPayinFiles(ctx context.Context, batchJobRunID int) ([]*payinModel.PayinFile, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileDTOs []payinDTO.PayinFile
+	err = db.WithContext(ctx).
+		Joins("JOIN batch_job_run_payin_file ON batch_job_run_payin_file.payin_file_id = payin_file.id").
+		Where("batch_job_run_payin_file.batch_job_run_id = ?", batchJobRunID).
+		Order("batch_job_run_payin_file.id ASC").
+		Find(&fileDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*payinModel.PayinFile, len(fileDTOs))
+	for i := range fileDTOs {
+		files[i] = fileDTOs[i].ToPayinFileModel()
+	}
+
+	return files, nil
+}
+
+// applyFilters applies the list filters to the query
+func (r *BatchJobRunRepositoryImpl) applyFilters(query *gorm.DB, params *inputdata.BatchJobRunListInputData) *gorm.DB {
+	if params.CommandName != "" {
+		query = query.Where("batch_job_run.command_name = ?", params.CommandName)
+	}
+
+	if len(params.Status) > 0 {
+		query = query.Where("batch_job_run.status IN ?", params.Status)
+	}
+
+	if params.StartedAtStart != nil {
+		query = query.Where("batch_job_run.started_at >= ?", params.StartedAtStart)
+	}
+
+	if params.StartedAtEnd != nil {
+		query = query.Where("batch_job_run.started_at <= ?", params.StartedAtEnd)
+	}
+
+	return query
+}
+
+// applyPagination applies pagination to the query
+func (r *BatchJobRunRepositoryImpl) applyPagination(query *gorm.DB, params *inputdata.BatchJobRunListInputData) *gorm.DB {
+	offset := (params.Page - 1) * params.PageSize
+	return query.Offset(offset).Limit(params.PageSize)
+}
+
+// applySorting applies sorting to the query, newest runs first by default
+func (r *BatchJobRunRepositoryImpl) applySorting(query *gorm.DB, params *inputdata.BatchJobRunListInputData) *gorm.DB {
+	sortOrder := "DESC"
+	if params.SortOrder == "asc" {
+		sortOrder = "ASC"
+	}
+
+	allowedSortFields := map[string]string{
+		"id":              "batch_job_run.id",
+		"command_name":    "batch_job_run.command_name",
+		"status":          "batch_job_run.status",
+		"started_at":      "batch_job_run.started_at",
+		"finished_at":     "batch_job_run.finished_at",
+		"processed_count": "batch_job_run.processed_count",
+		"failed_count":    "batch_job_run.failed_count",
+	}
+
+	sortField := "batch_job_run.started_at"
+	if dbField, ok := allowedSortFields[params.SortField]; ok {
+		sortField = dbField
+	}
+
+	return query.Order(sortField + " " + sortOrder).Order("batch_job_run.id " + sortOrder)
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+)
+
+// BatchJobLease represents the batch_job_lease table
+type BatchJobLease struct {
+	ID         int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	LeaseKey   string    `json:"lease_key"`
+	Owner      string    `json:"owner"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for BatchJobLease
+func (BatchJobLease) TableName() string {
+	return "batch_job_lease"
+}
+
+func (dto *BatchJobLease) ToBatchJobLeaseModel() *model.BatchJobLease {
+	return &model.BatchJobLease{
+		ID:         dto.ID,
+		LeaseKey:   dto.LeaseKey,
+		Owner:      dto.Owner,
+		AcquiredAt: dto.AcquiredAt,
+		ExpiresAt:  dto.ExpiresAt,
+	}
+}
+
+func ToBatchJobLeaseDTO(lease *model.BatchJobLease) *BatchJobLease {
+	return &BatchJobLease{
+		ID:         lease.ID,
+		LeaseKey:   lease.LeaseKey,
+		Owner:      lease.Owner,
+		AcquiredAt: lease.AcquiredAt,
+		ExpiresAt:  lease.ExpiresAt,
+	}
+}
@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	object "github.com/huydq/test/internal/domain/object/batch_job_run"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// BatchJobRun represents the batch_job_run table
+type BatchJobRun struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	CommandName    string                   `json:"command_name"`
+	Flags          *string                  `gorm:"type:json" json:"flags"`
+	Status         object.BatchJobRunStatus `json:"status"` // 1:running, 2:succeeded, 3:partially failed, 4:failed
+	StartedAt      time.Time                `json:"started_at"`
+	FinishedAt     *time.Time               `json:"finished_at"`
+	ProcessedCount int                      `json:"processed_count"`
+	FailedCount    int                      `json:"failed_count"`
+	ErrorSummary   *string                  `json:"error_summary"`
+}
+
+// TableName specifies the table name for BatchJobRun
+func (BatchJobRun) TableName() string {
+	return "batch_job_run"
+}
+
+// BatchJobRunPayinFile represents the batch_job_run_payin_file table
+type BatchJobRunPayinFile struct {
+	ID            int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	BatchJobRunID int       `json:"batch_job_run_id"`
+	PayinFileID   int       `json:"payin_file_id"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for BatchJobRunPayinFile
+func (BatchJobRunPayinFile) TableName() string {
+	return "batch_job_run_payin_file"
+}
+
+func (dto *BatchJobRun) ToBatchJobRunModel() *model.BatchJobRun {
+	var flags map[string]string
+	if dto.Flags != nil {
+		// Flags are only ever written by ToBatchJobRunDTO, an unreadable value is dropped rather than failing the read
+		_ = json.Unmarshal([]byte(*dto.Flags), &flags)
+	}
+
+	batchJobRunModel := &model.BatchJobRun{
+		ID:             dto.ID,
+		CommandName:    dto.CommandName,
+		Flags:          flags,
+		Status:         dto.Status,
+		StartedAt:      dto.StartedAt,
+		FinishedAt:     dto.FinishedAt,
+		ProcessedCount: dto.ProcessedCount,
+		FailedCount:    dto.FailedCount,
+		ErrorSummary:   dto.ErrorSummary,
+	}
+	batchJobRunModel.CreatedAt = dto.CreatedAt
+	batchJobRunModel.UpdatedAt = dto.UpdatedAt
+	return batchJobRunModel
+}
+
+func ToBatchJobRunDTO(run *model.BatchJobRun) *BatchJobRun {
+	var flags *string
+	if run.Flags != nil {
+		if data, err := json.Marshal(run.Flags); err == nil {
+			encoded := string(data)
+			flags = &encoded
+		}
+	}
+
+	batchJobRunDTO := &BatchJobRun{
+		ID:             run.ID,
+		CommandName:    run.CommandName,
+		Flags:          flags,
+		Status:         run.Status,
+		StartedAt:      run.StartedAt,
+		FinishedAt:     run.FinishedAt,
+		ProcessedCount: run.ProcessedCount,
+		FailedCount:    run.FailedCount,
+		ErrorSummary:   run.ErrorSummary,
+	}
+	batchJobRunDTO.CreatedAt = run.CreatedAt
+	batchJobRunDTO.UpdatedAt = run.UpdatedAt
+	return batchJobRunDTO
+}
@@ -0,0 +1,134 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	repository "github.com/huydq/test/internal/domain/repository/invoice"
+	"github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type InvoiceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) repository.InvoiceRepository {
+	return &InvoiceRepositoryImpl{
+		db: db,
+	}
+}
+
+// ListInvoices retrieves invoices with optional filtering
+func (r *InvoiceRepositoryImpl) ListInvoices(ctx context.Context, params *inputdata.InvoiceListInputData) ([]*model.PaypayInvoice, int, int, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var count int64
+	query := r.applyFilters(db.WithContext(ctx).Model(&dto.PaypayInvoice{}), params)
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, 0, err
+	}
+
+	query = r.applyFilters(db.WithContext(ctx).Model(&dto.PaypayInvoice{}), params)
+	query = r.applyPagination(query, params)
+	query = r.applySorting(query, params)
+
+	var invoiceDTOs []*dto.PaypayInvoice
+	if err := query.Find(&invoiceDTOs).Error; err != nil {
+		return nil, 0, 0, err
+	}
+
+	totalPages := int(math.Ceil(float64(count) / float64(params.PageSize)))
+
+	return dto.ToPaypayInvoiceModels(invoiceDTOs), totalPages, int(count), nil
+}
+
+// FindByID finds an invoice by its ID
+func (r *InvoiceRepositoryImpl) FindByID(ctx context.Context, id int) (*model.PaypayInvoice, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoiceDTO dto.PaypayInvoice
+	if err := db.WithContext(ctx).First(&invoiceDTO, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return invoiceDTO.ToPaypayInvoiceModel(), nil
+}
+
+// applyFilters applies the list filters to the query
+func (r *InvoiceRepositoryImpl) applyFilters(query *gorm.DB, params *inputdata.InvoiceListInputData) *gorm.DB {
+	if params.RegistrationNumber != "" {
+		query = query.Where("paypay_invoice.registration_number = ?", params.RegistrationNumber)
+	}
+
+	if params.PaymentMerchantID != "" {
+		query = query.Where("paypay_invoice.payment_merchant_id = ?", params.PaymentMerchantID)
+	}
+
+	if params.MerchantBusinessName != "" {
+		query = query.Where("paypay_invoice.merchant_business_name LIKE ?", "%"+params.MerchantBusinessName+"%")
+	}
+
+	if params.IssueDateStart != nil {
+		query = query.Where("paypay_invoice.issue_date >= ?", params.IssueDateStart.Format("2006-01-02"))
+	}
+
+	if params.IssueDateEnd != nil {
+		query = query.Where("paypay_invoice.issue_date <= ?", params.IssueDateEnd.Format("2006-01-02"))
+	}
+
+	if params.CutoffDate != nil {
+		query = query.Where("paypay_invoice.cutoff_date = ?", params.CutoffDate.Format("2006-01-02"))
+	}
+
+	if params.Duplicate != nil {
+		query = query.Where("paypay_invoice.duplicate = ?", *params.Duplicate)
+	}
+
+	return query
+}
+
+// applyPagination applies pagination to the query
+func (r *InvoiceRepositoryImpl) applyPagination(query *gorm.DB, params *inputdata.InvoiceListInputData) *gorm.DB {
+	offset := (params.Page - 1) * params.PageSize
+	return query.Offset(offset).Limit(params.PageSize)
+}
+
+// applySorting applies sorting to the query, latest issued invoices first by default
+func (r *InvoiceRepositoryImpl) applySorting(query *gorm.DB, params *inputdata.InvoiceListInputData) *gorm.DB {
+	sortOrder := "DESC"
+	if params.SortOrder == "asc" {
+		sortOrder = "ASC"
+	}
+
+	allowedSortFields := map[string]string{
+		"id":                     "paypay_invoice.id",
+		"registration_number":    "paypay_invoice.registration_number",
+		"issue_date":             "paypay_invoice.issue_date",
+		"payment_merchant_id":    "paypay_invoice.payment_merchant_id",
+		"merchant_business_name": "paypay_invoice.merchant_business_name",
+		"cutoff_date":            "paypay_invoice.cutoff_date",
+		"total_amount":           "paypay_invoice.total_amount",
+		"created_at":             "paypay_invoice.created_at",
+	}
+
+	sortField := "paypay_invoice.issue_date"
+	if dbField, ok := allowedSortFields[params.SortField]; ok {
+		sortField = dbField
+	}
+
+	return query.Order(sortField + " " + sortOrder).Order("paypay_invoice.id " + sortOrder)
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/merchant"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// MerchantBankAccount is the database representation of a merchant bank account
+type MerchantBankAccount struct {
+	ID                    int        `gorm:"column:id;primaryKey"`
+	MerchantID            int        `gorm:"column:merchant_id"`
+	BankName              string     `gorm:"column:bank_name"`
+	BankCode              string     `gorm:"column:bank_code"`
+	BranchName            string     `gorm:"column:branch_name"`
+	BranchCode            string     `gorm:"column:branch_code"`
+	BankAccountType       int        `gorm:"column:bank_account_type"`
+	AccountNo             string     `gorm:"column:account_no"`
+	AccountName           string     `gorm:"column:account_name"`
+	ChangeType            int        `gorm:"column:change_type"`
+	Status                int        `gorm:"column:status"`
+	PreviousBankAccountID *int       `gorm:"column:previous_bank_account_id"`
+	RequestedBy           *int       `gorm:"column:requested_by"`
+	ReviewedBy            *int       `gorm:"column:reviewed_by"`
+	ReviewedAt            *time.Time `gorm:"column:reviewed_at"`
+
+	persistence.BaseColumnTimestamp
+}
+
+// TableName specifies the table name for MerchantBankAccount
+func (MerchantBankAccount) TableName() string {
+	return "merchant_bank_account"
+}
+
+// ToMerchantBankAccountModel converts a MerchantBankAccount to a MerchantBankAccount model
+func (dto *MerchantBankAccount) ToMerchantBankAccountModel() *model.MerchantBankAccount {
+	result := &model.MerchantBankAccount{
+		ID:                    dto.ID,
+		MerchantID:            dto.MerchantID,
+		BankName:              dto.BankName,
+		BankCode:              dto.BankCode,
+		BranchName:            dto.BranchName,
+		BranchCode:            dto.BranchCode,
+		BankAccountType:       object.BankAccountType(dto.BankAccountType),
+		AccountNo:             dto.AccountNo,
+		AccountName:           dto.AccountName,
+		ChangeType:            merchantObject.BankAccountChangeType(dto.ChangeType),
+		Status:                merchantObject.BankAccountStatus(dto.Status),
+		PreviousBankAccountID: dto.PreviousBankAccountID,
+		RequestedBy:           dto.RequestedBy,
+		ReviewedBy:            dto.ReviewedBy,
+		ReviewedAt:            dto.ReviewedAt,
+	}
+
+	result.CreatedAt = dto.CreatedAt
+	result.UpdatedAt = dto.UpdatedAt
+
+	return result
+}
+
+// ToMerchantBankAccountDTO converts a MerchantBankAccount model to a MerchantBankAccount
+func ToMerchantBankAccountDTO(m *model.MerchantBankAccount) *MerchantBankAccount {
+	result := &MerchantBankAccount{
+		ID:                    m.ID,
+		MerchantID:            m.MerchantID,
+		BankName:              m.BankName,
+		BankCode:              m.BankCode,
+		BranchName:            m.BranchName,
+		BranchCode:            m.BranchCode,
+		BankAccountType:       int(m.BankAccountType),
+		AccountNo:             m.AccountNo,
+		AccountName:           m.AccountName,
+		ChangeType:            int(m.ChangeType),
+		Status:                int(m.Status),
+		PreviousBankAccountID: m.PreviousBankAccountID,
+		RequestedBy:           m.RequestedBy,
+		ReviewedBy:            m.ReviewedBy,
+		ReviewedAt:            m.ReviewedAt,
+	}
+
+	result.CreatedAt = m.CreatedAt
+	result.UpdatedAt = m.UpdatedAt
+
+	return result
+}
@@ -28,6 +28,7 @@ func (Merchant) TableName() string {
 // PaymentProviderReview is the database representation of a payment provider review
 type PaymentProviderReview struct {
 	ID                   int `gorm:"column:id;primaryKey"`
+	PaymentProviderID    int `gorm:"column:payment_provider_id"`
 	MerchantID           int `gorm:"column:merchant_id"`
 	MerchantReviewStatus int `gorm:"column:merchant_review_status"`
 
@@ -56,9 +57,12 @@ func (dto *Merchant) ToMerchantModel() *model.Merchant {
 	if dto.MerchantPaymentProviderReview != nil {
 		result.MerchantPaymentProviderReview = &model.PaymentProviderReview{
 			ID:                   dto.MerchantPaymentProviderReview.ID,
+			PaymentProviderID:    dto.MerchantPaymentProviderReview.PaymentProviderID,
 			MerchantID:           dto.MerchantPaymentProviderReview.MerchantID,
 			MerchantReviewStatus: dto.MerchantPaymentProviderReview.MerchantReviewStatus,
 		}
+		result.MerchantPaymentProviderReview.CreatedAt = dto.MerchantPaymentProviderReview.CreatedAt
+		result.MerchantPaymentProviderReview.UpdatedAt = dto.MerchantPaymentProviderReview.UpdatedAt
 	}
 
 	return result
@@ -81,6 +85,7 @@ func ToMerchantDTO(m *model.Merchant) *Merchant {
 	if m.MerchantPaymentProviderReview != nil {
 		result.MerchantPaymentProviderReview = &PaymentProviderReview{
 			ID:                   m.MerchantPaymentProviderReview.ID,
+			PaymentProviderID:    m.MerchantPaymentProviderReview.PaymentProviderID,
 			MerchantID:           m.MerchantPaymentProviderReview.MerchantID,
 			MerchantReviewStatus: m.MerchantPaymentProviderReview.MerchantReviewStatus,
 		}
@@ -88,3 +93,18 @@ func ToMerchantDTO(m *model.Merchant) *Merchant {
 
 	return result
 }
+
+// ToPaymentProviderReviewDTO converts a PaymentProviderReview model to a PaymentProviderReview
+func ToPaymentProviderReviewDTO(m *model.PaymentProviderReview) *PaymentProviderReview {
+	result := &PaymentProviderReview{
+		ID:                   m.ID,
+		PaymentProviderID:    m.PaymentProviderID,
+		MerchantID:           m.MerchantID,
+		MerchantReviewStatus: m.MerchantReviewStatus,
+	}
+
+	result.CreatedAt = m.CreatedAt
+	result.UpdatedAt = m.UpdatedAt
+
+	return result
+}
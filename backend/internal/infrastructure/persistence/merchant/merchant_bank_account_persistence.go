@@ -0,0 +1,121 @@
+package merchant
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/huydq/test/internal/domain/model/merchant"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	repository "github.com/huydq/test/internal/domain/repository/merchant"
+	"github.com/huydq/test/internal/infrastructure/persistence/merchant/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type MerchantBankAccountRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMerchantBankAccountRepository(db *gorm.DB) repository.MerchantBankAccountRepository {
+	return &MerchantBankAccountRepositoryImpl{
+		db: db,
+	}
+}
+
+// ListByMerchantID lists every registration, change and deletion of a merchant's bank account, newest first
+func (r *MerchantBankAccountRepositoryImpl) ListByMerchantID(ctx context.Context, merchantID int) ([]*model.MerchantBankAccount, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountDTOs []dto.MerchantBankAccount
+	err = db.WithContext(ctx).
+		Where("merchant_id = ?", merchantID).
+		Order("id DESC").
+		Find(&accountDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*model.MerchantBankAccount, len(accountDTOs))
+	for i := range accountDTOs {
+		accounts[i] = accountDTOs[i].ToMerchantBankAccountModel()
+	}
+
+	return accounts, nil
+}
+
+// FindByID finds a bank account of a merchant by its ID, returning nil if it does not exist
+func (r *MerchantBankAccountRepositoryImpl) FindByID(ctx context.Context, merchantID int, id int) (*model.MerchantBankAccount, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountDTO dto.MerchantBankAccount
+	err = db.WithContext(ctx).
+		Where("merchant_id = ?", merchantID).
+		First(&accountDTO, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return accountDTO.ToMerchantBankAccountModel(), nil
+}
+
+// FindByStatus finds the latest bank account of a merchant in the given status, returning nil if there is none
+func (r *MerchantBankAccountRepositoryImpl) FindByStatus(ctx context.Context, merchantID int, status merchantObject.BankAccountStatus) (*model.MerchantBankAccount, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountDTO dto.MerchantBankAccount
+	err = db.WithContext(ctx).
+		Where("merchant_id = ? AND status = ?", merchantID, status).
+		Order("id DESC").
+		First(&accountDTO).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return accountDTO.ToMerchantBankAccountModel(), nil
+}
+
+// Create creates a bank account and sets the generated ID on the model
+func (r *MerchantBankAccountRepositoryImpl) Create(ctx context.Context, account *model.MerchantBankAccount) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	accountDTO := dto.ToMerchantBankAccountDTO(account)
+	if err := db.WithContext(ctx).Create(accountDTO).Error; err != nil {
+		return err
+	}
+
+	account.ID = accountDTO.ID
+	account.CreatedAt = accountDTO.CreatedAt
+	account.UpdatedAt = accountDTO.UpdatedAt
+	return nil
+}
+
+// UpdateStatus updates the status and review columns of a bank account
+func (r *MerchantBankAccountRepositoryImpl) UpdateStatus(ctx context.Context, account *model.MerchantBankAccount) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).
+		Model(&dto.MerchantBankAccount{ID: account.ID}).
+		Select("status", "reviewed_by", "reviewed_at").
+		Updates(dto.ToMerchantBankAccountDTO(account)).Error
+}
@@ -2,6 +2,7 @@ package merchant
 
 import (
 	"context"
+	"errors"
 	"math"
 
 	"github.com/huydq/test/internal/datastructure/inputdata"
@@ -10,6 +11,7 @@ import (
 	"github.com/huydq/test/internal/infrastructure/persistence/merchant/dto"
 	"github.com/huydq/test/internal/pkg/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MerchantRepositoryImpl struct {
@@ -41,25 +43,219 @@ func (r *MerchantRepositoryImpl) ListMerchants(ctx context.Context, params *inpu
 	query = r.applyFilters(query, params)
 	query = r.applyPagination(query, params)
 	query = r.applySorting(query, params)
-	query = query.Preload("MerchantPaymentProviderReview", func(db *gorm.DB) *gorm.DB {
-		return db.Order("created_at DESC").Limit(1)
-	})
 
 	var merchantDTOs []dto.Merchant
 	if err := query.Find(&merchantDTOs).Error; err != nil {
 		return nil, 0, 0, err
 	}
 
+	merchantIDs := make([]int, len(merchantDTOs))
+	for i, merchantDTO := range merchantDTOs {
+		merchantIDs[i] = merchantDTO.ID
+	}
+
+	latestReviews, err := r.findLatestReviews(ctx, db, merchantIDs)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
 	totalPages := int(math.Ceil(float64(count) / float64(params.PageSize)))
 
 	merchants := make([]*model.Merchant, len(merchantDTOs))
-	for i, dto := range merchantDTOs {
-		merchants[i] = dto.ToMerchantModel()
+	for i := range merchantDTOs {
+		merchantDTOs[i].MerchantPaymentProviderReview = latestReviews[merchantDTOs[i].ID]
+		merchants[i] = merchantDTOs[i].ToMerchantModel()
 	}
 
 	return merchants, totalPages, int(count), nil
 }
 
+// FindByID finds a merchant by its ID together with its latest payment provider review
+func (r *MerchantRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Merchant, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var merchantDTO dto.Merchant
+	err = db.WithContext(ctx).
+		Preload("MerchantPaymentProviderReview", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at DESC, id DESC").Limit(1)
+		}).
+		First(&merchantDTO, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return merchantDTO.ToMerchantModel(), nil
+}
+
+// FindByIDForUpdate finds a merchant by its ID, returning nil if it does not exist.
+// The row is locked so that concurrent changes of the merchant's bank account are serialized within a transaction.
+func (r *MerchantRepositoryImpl) FindByIDForUpdate(ctx context.Context, id int) (*model.Merchant, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var merchantDTO dto.Merchant
+	err = db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&merchantDTO, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return merchantDTO.ToMerchantModel(), nil
+}
+
+// FindByIDs finds the merchants with the given IDs together with their latest payment provider reviews
+func (r *MerchantRepositoryImpl) FindByIDs(ctx context.Context, ids []int) ([]*model.Merchant, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*model.Merchant{}, nil
+	}
+
+	var merchantDTOs []dto.Merchant
+	if err := db.WithContext(ctx).Where("id IN ?", ids).Find(&merchantDTOs).Error; err != nil {
+		return nil, err
+	}
+
+	latestReviews, err := r.findLatestReviews(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	merchants := make([]*model.Merchant, len(merchantDTOs))
+	for i := range merchantDTOs {
+		merchantDTOs[i].MerchantPaymentProviderReview = latestReviews[merchantDTOs[i].ID]
+		merchants[i] = merchantDTOs[i].ToMerchantModel()
+	}
+
+	return merchants, nil
+}
+
+// ExistsByPaymentMerchantID reports whether another merchant of the payment provider uses the payment merchant ID
+func (r *MerchantRepositoryImpl) ExistsByPaymentMerchantID(ctx context.Context, paymentProviderID int, paymentMerchantID string, excludeID int) (bool, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	err = db.WithContext(ctx).
+		Model(&dto.Merchant{}).
+		Where("payment_provider_id = ? AND payment_merchant_id = ? AND id <> ?", paymentProviderID, paymentMerchantID, excludeID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Create creates a new merchant and sets the generated ID on the model
+func (r *MerchantRepositoryImpl) Create(ctx context.Context, merchant *model.Merchant) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	merchantDTO := dto.ToMerchantDTO(merchant)
+	merchantDTO.MerchantPaymentProviderReview = nil
+	if err := db.WithContext(ctx).Create(merchantDTO).Error; err != nil {
+		return err
+	}
+
+	merchant.ID = merchantDTO.ID
+	return nil
+}
+
+// Update updates an existing merchant
+func (r *MerchantRepositoryImpl) Update(ctx context.Context, merchant *model.Merchant) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).
+		Model(&dto.Merchant{ID: merchant.ID}).
+		Select("payment_provider_id", "payment_merchant_id", "merchant_name", "shop_id", "shop_url").
+		Updates(dto.ToMerchantDTO(merchant)).Error
+}
+
+// Delete soft-deletes a merchant by its ID
+func (r *MerchantRepositoryImpl) Delete(ctx context.Context, id int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).Delete(&dto.Merchant{}, id).Error
+}
+
+// CreatePaymentProviderReviews records new payment provider reviews of merchants
+func (r *MerchantRepositoryImpl) CreatePaymentProviderReviews(ctx context.Context, reviews []*model.PaymentProviderReview) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	reviewDTOs := make([]*dto.PaymentProviderReview, len(reviews))
+	for i, review := range reviews {
+		reviewDTOs[i] = dto.ToPaymentProviderReviewDTO(review)
+	}
+
+	if err := db.WithContext(ctx).Create(&reviewDTOs).Error; err != nil {
+		return err
+	}
+
+	for i, reviewDTO := range reviewDTOs {
+		reviews[i].ID = reviewDTO.ID
+	}
+
+	return nil
+}
+
+// findLatestReviews returns the latest payment provider review of each merchant keyed by merchant ID
+func (r *MerchantRepositoryImpl) findLatestReviews(ctx context.Context, db *gorm.DB, merchantIDs []int) (map[int]*dto.PaymentProviderReview, error) {
+	if len(merchantIDs) == 0 {
+		return map[int]*dto.PaymentProviderReview{}, nil
+	}
+
+	var reviewDTOs []*dto.PaymentProviderReview
+	err := db.WithContext(ctx).
+		Where("merchant_id IN ?", merchantIDs).
+		Order("created_at DESC, id DESC").
+		Find(&reviewDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	latestReviews := make(map[int]*dto.PaymentProviderReview, len(merchantIDs))
+	for _, reviewDTO := range reviewDTOs {
+		if _, ok := latestReviews[reviewDTO.MerchantID]; !ok {
+			latestReviews[reviewDTO.MerchantID] = reviewDTO
+		}
+	}
+
+	return latestReviews, nil
+}
+
 // applyFilters applies search and review status filters to the query
 func (r *MerchantRepositoryImpl) applyFilters(query *gorm.DB, params *inputdata.MerchantListInputData) *gorm.DB {
 	if params.CreatedAtStart != nil {
@@ -18,6 +18,11 @@ type PayinFile struct {
 	HasDataRecord        bool    `json:"has_data_record"`
 	AddedManually        bool    `json:"added_manually"`
 	ContentAddedManually *string `json:"content_added_manually"`
+	FileSize             *int64  `gorm:"column:file_size" json:"file_size"`
+	MD5Checksum          *string `gorm:"column:md5_checksum" json:"md5_checksum"`
+	SHA256Checksum       *string `gorm:"column:sha256_checksum" json:"sha256_checksum"`
+
+	Encoding *object.PayinFileEncoding `json:"encoding"`
 
 	PayinFileType object.PayinFileType `json:"payin_file_type"`
 
@@ -41,6 +46,10 @@ func (dto *PayinFile) ToPayinFileModel() *payinFile.PayinFile {
 		HasDataRecord:        dto.HasDataRecord,
 		AddedManually:        dto.AddedManually,
 		ContentAddedManually: dto.ContentAddedManually,
+		FileSize:             dto.FileSize,
+		MD5Checksum:          dto.MD5Checksum,
+		SHA256Checksum:       dto.SHA256Checksum,
+		Encoding:             dto.Encoding,
 		PayinFileType:        dto.PayinFileType,
 		ImportStatus:         dto.ImportStatus,
 		DownloadStatus:       dto.DownloadStatus,
@@ -61,6 +70,10 @@ func ToPayinFileDTO(pf *payinFile.PayinFile) *PayinFile {
 		HasDataRecord:        pf.HasDataRecord,
 		AddedManually:        pf.AddedManually,
 		ContentAddedManually: pf.ContentAddedManually,
+		FileSize:             pf.FileSize,
+		MD5Checksum:          pf.MD5Checksum,
+		SHA256Checksum:       pf.SHA256Checksum,
+		Encoding:             pf.Encoding,
 		PayinFileType:        pf.PayinFileType,
 		ImportStatus:         pf.ImportStatus,
 		DownloadStatus:       pf.DownloadStatus,
@@ -15,6 +15,7 @@ type PayinFileGroup struct {
 	FileGroupName     string     `json:"file_group_name"`
 	PaymentProviderID int        `json:"payment_provider_id"`
 	ImportTargetDate  time.Time  `json:"import_target_date"`
+	ListedAt          *time.Time `json:"listed_at"`
 	ImportedAt        *time.Time `json:"imported_at"`
 }
 
@@ -29,6 +30,8 @@ func (dto *PayinFileGroup) ToPayinFileGroupModel() *payinFileGroup.PayinFileGrou
 		PaymentProviderID: dto.PaymentProviderID,
 		FileGroupName:     dto.FileGroupName,
 		ImportTargetDate:  dto.ImportTargetDate,
+		ListedAt:          dto.ListedAt,
+		ImportedAt:        dto.ImportedAt,
 	}
 	payinFileGroupModel.CreatedAt = dto.CreatedAt
 	payinFileGroupModel.UpdatedAt = dto.UpdatedAt
@@ -41,6 +44,7 @@ func ToPayinFileGroupDTO(pfg *payinFileGroup.PayinFileGroup) *PayinFileGroup {
 		PaymentProviderID: pfg.PaymentProviderID,
 		FileGroupName:     pfg.FileGroupName,
 		ImportTargetDate:  pfg.ImportTargetDate,
+		ListedAt:          pfg.ListedAt,
 		ImportedAt:        pfg.ImportedAt,
 	}
 	payinFileGroupDTO.CreatedAt = pfg.CreatedAt
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	object "github.com/huydq/test/internal/domain/object/payin"
+)
+
+// PayinImportError represents the payin_import_error table
+type PayinImportError struct {
+	ID          int                           `gorm:"primaryKey;autoIncrement" json:"id"`
+	PayinFileID int                           `json:"payin_file_id"`
+	LineNumber  int                           `json:"line_number"`
+	ColumnName  string                        `json:"column_name"`
+	RawValue    string                        `json:"raw_value"`
+	Reason      string                        `json:"reason"`
+	Policy      object.PayinImportErrorPolicy `json:"policy"`
+	CreatedAt   time.Time                     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time                     `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName specifies the table name for PayinImportError
+func (PayinImportError) TableName() string {
+	return "payin_import_error"
+}
+
+func (dto *PayinImportError) ToPayinImportErrorModel() *model.PayinImportError {
+	return &model.PayinImportError{
+		ID:          dto.ID,
+		PayinFileID: dto.PayinFileID,
+		LineNumber:  dto.LineNumber,
+		ColumnName:  dto.ColumnName,
+		RawValue:    dto.RawValue,
+		Reason:      dto.Reason,
+		Policy:      dto.Policy,
+		CreatedAt:   dto.CreatedAt,
+	}
+}
+
+func ToPayinImportErrorDTO(importError *model.PayinImportError) *PayinImportError {
+	return &PayinImportError{
+		ID:          importError.ID,
+		PayinFileID: importError.PayinFileID,
+		LineNumber:  importError.LineNumber,
+		ColumnName:  importError.ColumnName,
+		RawValue:    importError.RawValue,
+		Reason:      importError.Reason,
+		Policy:      importError.Policy,
+		CreatedAt:   importError.CreatedAt,
+	}
+}
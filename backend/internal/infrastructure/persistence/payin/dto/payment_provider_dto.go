@@ -0,0 +1,31 @@
+package dto
+
+import (
+	paymentProvider "github.com/huydq/test/internal/domain/model/payin"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// PaymentProvider represents the payment_provider table
+type PaymentProvider struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	Code string  `json:"code"`
+	Name *string `json:"name"`
+}
+
+// TableName specifies the table name for PaymentProvider
+func (PaymentProvider) TableName() string {
+	return "payment_provider"
+}
+
+func (dto *PaymentProvider) ToPaymentProviderModel() *paymentProvider.PaymentProvider {
+	paymentProviderModel := &paymentProvider.PaymentProvider{
+		ID:   dto.ID,
+		Code: dto.Code,
+		Name: dto.Name,
+	}
+	paymentProviderModel.CreatedAt = dto.CreatedAt
+	paymentProviderModel.UpdatedAt = dto.UpdatedAt
+	return paymentProviderModel
+}
@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	repository "github.com/huydq/test/internal/domain/repository/payin_file"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	paypayDTO "github.com/huydq/test/internal/infrastructure/persistence/paypay/dto"
+	"github.com/huydq/test/internal/pkg/database"
+	"gorm.io/gorm"
+)
+
+type PayinFileRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPayinFileRepository(db *gorm.DB) repository.PayinFileRepository {
+	return &PayinFileRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindByID finds a payin file by its ID
+func (r *PayinFileRepositoryImpl) FindByID(ctx context.Context, id int) (*model.PayinFile, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileDTO dto.PayinFile
+	if err := db.WithContext(ctx).First(&fileDTO, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return fileDTO.ToPayinFileModel(), nil
+}
+
+// ListImportErrors lists the import errors of the payin file in the order of the file lines
+func (r *PayinFileRepositoryImpl) ListImportErrors(ctx context.Context, payinFileID int) ([]*model.PayinImportError, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var importErrorDTOs []dto.PayinImportError
+	err = db.WithContext(ctx).
+		Where("payin_file_id = ?", payinFileID).
+		Order("line_number ASC, id ASC").
+		Find(&importErrorDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	importErrors := make([]*model.PayinImportError, len(importErrorDTOs))
+	for i := range importErrorDTOs {
+		importErrors[i] = importErrorDTOs[i].ToPayinImportErrorModel()
+	}
+
+	return importErrors, nil
+}
+
+// ListPaypayTransactions lists the amounts and payment details of the PayPay transactions of the payin file
+func (r *PayinFileRepositoryImpl) ListPaypayTransactions(ctx context.Context, payinFileID int) ([]*paypayModel.PaypayPayinTransaction, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactionDTOs []*paypayDTO.PaypayPayinTransaction
+	err = db.WithContext(ctx).
+		Select("id", "payin_file_id", "transaction_amount", "payment_detail").
+		Where("payin_file_id = ?", payinFileID).
+		Order("id ASC").
+		Find(&transactionDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return paypayDTO.ToPaypayPayinTransactionModels(transactionDTOs), nil
+}
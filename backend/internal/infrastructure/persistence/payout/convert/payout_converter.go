@@ -1,11 +1,16 @@
 package convert
 
 import (
+	"time"
+
 	modelPayout "github.com/huydq/test/internal/domain/model/payout"
 	util "github.com/huydq/test/internal/domain/object/basedatetime"
 	objectPayout "github.com/huydq/test/internal/domain/object/payout"
+	approvalConvert "github.com/huydq/test/internal/infrastructure/persistence/approval/convert"
 	"github.com/huydq/test/internal/infrastructure/persistence/payout/dto"
 	userDto "github.com/huydq/test/internal/infrastructure/persistence/user/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+	"gorm.io/gorm"
 )
 
 // ToPayoutDTO converts a Payout domain model to a Payout
@@ -14,23 +19,32 @@ func ToPayoutDTO(payout *modelPayout.Payout) *dto.Payout {
 		return nil
 	}
 
-	return &dto.Payout{
+	result := &dto.Payout{
 		ID:                    payout.ID,
 		PayoutStatus:          int(payout.PayoutStatus),
 		Total:                 payout.Total,
 		TotalCount:            payout.TotalCount,
 		SendingDate:           payout.SendingDate,
-		SentDate:              payout.SentDate,
+		SentDate:              toTimePtr(payout.SentDate),
 		AozoraTransferApplyNo: payout.AozoraTransferApplyNo,
 		ApprovalID:            payout.ApprovalID,
 		UserID:                payout.UserID,
 		User:                  userDto.ToUserDTO(payout.User),
-		BaseColumnTimestamp: util.BaseColumnTimestamp{
+		BaseColumnTimestamp: persistence.BaseColumnTimestamp{
 			CreatedAt: payout.CreatedAt,
 			UpdatedAt: payout.UpdatedAt,
-			DeletedAt: payout.DeletedAt,
 		},
 	}
+
+	// Handle the conversion from *time.Time to gorm.DeletedAt
+	if payout.DeletedAt != nil {
+		result.DeletedAt = gorm.DeletedAt{
+			Time:  *payout.DeletedAt,
+			Valid: true,
+		}
+	}
+
+	return result
 }
 
 func ToPayoutModel(dtoObj *dto.Payout) *modelPayout.Payout {
@@ -38,23 +52,34 @@ func ToPayoutModel(dtoObj *dto.Payout) *modelPayout.Payout {
 		return nil
 	}
 
-	return &modelPayout.Payout{
+	result := &modelPayout.Payout{
 		ID:                    dtoObj.ID,
 		PayoutStatus:          objectPayout.PayoutStatus(dtoObj.PayoutStatus),
 		Total:                 dtoObj.Total,
 		TotalCount:            dtoObj.TotalCount,
 		SendingDate:           dtoObj.SendingDate,
-		SentDate:              dtoObj.SentDate,
 		AozoraTransferApplyNo: dtoObj.AozoraTransferApplyNo,
 		ApprovalID:            dtoObj.ApprovalID,
+		Approval:              approvalConvert.ToApprovalModel(dtoObj.Approval),
 		UserID:                dtoObj.UserID,
 		User:                  dtoObj.User.ToUserModel(),
 		BaseColumnTimestamp: util.BaseColumnTimestamp{
 			CreatedAt: dtoObj.CreatedAt,
 			UpdatedAt: dtoObj.UpdatedAt,
-			DeletedAt: dtoObj.DeletedAt,
 		},
 	}
+
+	if dtoObj.SentDate != nil {
+		result.SentDate = *dtoObj.SentDate
+	}
+
+	// Handle the conversion from gorm.DeletedAt to *time.Time
+	if dtoObj.DeletedAt.Valid {
+		deletedAt := dtoObj.DeletedAt.Time
+		result.DeletedAt = &deletedAt
+	}
+
+	return result
 }
 
 // ToPayoutDTOs converts a list of Payout domain models to a list of PayoutDTOs
@@ -81,3 +106,11 @@ func ToPayoutModels(dtos []*dto.Payout) []*modelPayout.Payout {
 	}
 	return result
 }
+
+// toTimePtr returns nil for a zero time (e.g. a payout that has not been sent yet)
+func toTimePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
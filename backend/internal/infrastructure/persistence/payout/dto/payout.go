@@ -3,20 +3,20 @@ package dto
 import (
 	"time"
 
-	util "github.com/huydq/test/internal/domain/object/basedatetime"
 	approvalDto "github.com/huydq/test/internal/infrastructure/persistence/approval/dto"
 	userDto "github.com/huydq/test/internal/infrastructure/persistence/user/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
 )
 
 type Payout struct {
 	ID int
-	util.BaseColumnTimestamp
+	persistence.BaseColumnTimestamp
 
 	PayoutStatus          int
 	Total                 float64
 	TotalCount            int
 	SendingDate           time.Time
-	SentDate              time.Time
+	SentDate              *time.Time
 	AozoraTransferApplyNo string
 	ApprovalID            *int
 	UserID                int
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"math"
 
 	model "github.com/huydq/test/internal/domain/model/payout"
@@ -11,6 +12,7 @@ import (
 	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
 	"github.com/huydq/test/internal/pkg/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PayoutRepositoryImpl struct {
@@ -50,7 +52,7 @@ func (r *PayoutRepositoryImpl) List(ctx context.Context, filter *model.PayoutFil
 
 	query = r.filterBuilder.ApplyPagination(query, filter.Pagination)
 
-	query = query.Preload("User")
+	query = query.Preload("User").Preload("Approval")
 
 	if err := query.Find(&payoutDTOs).Error; err != nil {
 		return nil, 0, 0, err
@@ -62,3 +64,66 @@ func (r *PayoutRepositoryImpl) List(ctx context.Context, filter *model.PayoutFil
 
 	return payouts, totalPages, int64(count), nil
 }
+
+// FindByID finds a payout by its ID, returning nil if it does not exist
+func (r *PayoutRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Payout, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var payoutDTO dto.Payout
+	err = db.WithContext(ctx).
+		Preload("User").
+		Preload("Approval.ApprovalStages", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("id ASC")
+		}).
+		First(&payoutDTO, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return convert.ToPayoutModel(&payoutDTO), nil
+}
+
+// Create creates a new payout and sets the generated ID on the model
+func (r *PayoutRepositoryImpl) Create(ctx context.Context, payout *model.Payout) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	payoutDTO := convert.ToPayoutDTO(payout)
+	result := db.WithContext(ctx).Omit(clause.Associations).Create(payoutDTO)
+	if result.Error == nil {
+		payout.ID = payoutDTO.ID
+		payout.CreatedAt = payoutDTO.CreatedAt
+		payout.UpdatedAt = payoutDTO.UpdatedAt
+	}
+
+	return result.Error
+}
+
+// Update updates an existing payout
+func (r *PayoutRepositoryImpl) Update(ctx context.Context, payout *model.Payout) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	payoutDTO := convert.ToPayoutDTO(payout)
+	return db.WithContext(ctx).Omit(clause.Associations).Save(payoutDTO).Error
+}
+
+// Delete soft-deletes a payout by its ID
+func (r *PayoutRepositoryImpl) Delete(ctx context.Context, id int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).Delete(&dto.Payout{}, id).Error
+}
@@ -0,0 +1,71 @@
+package convert
+
+import (
+	model "github.com/huydq/test/internal/domain/model/payout_reconciliation"
+	util "github.com/huydq/test/internal/domain/object/basedatetime"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout_reconciliation/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+	"gorm.io/gorm"
+)
+
+// ToPayoutReconciliationDTO converts a PayoutReconciliation domain model to a PayoutReconciliation
+func ToPayoutReconciliationDTO(reconciliation *model.PayoutReconciliation) *dto.PayoutReconciliation {
+	if reconciliation == nil {
+		return nil
+	}
+
+	result := &dto.PayoutReconciliation{
+		ID:                    reconciliation.ID,
+		PayoutID:              reconciliation.PayoutID,
+		PayoutRecordID:        reconciliation.PayoutRecordID,
+		AozoraTransferApplyNo: reconciliation.AozoraTransferApplyNo,
+		LocalAmount:           reconciliation.LocalAmount,
+		BankAmount:            reconciliation.BankAmount,
+		BankTransferStatus:    reconciliation.BankTransferStatus,
+		DetectedAt:            reconciliation.DetectedAt,
+		BaseColumnTimestamp: persistence.BaseColumnTimestamp{
+			CreatedAt: reconciliation.CreatedAt,
+			UpdatedAt: reconciliation.UpdatedAt,
+		},
+	}
+
+	// Handle the conversion from *time.Time to gorm.DeletedAt
+	if reconciliation.DeletedAt != nil {
+		result.DeletedAt = gorm.DeletedAt{
+			Time:  *reconciliation.DeletedAt,
+			Valid: true,
+		}
+	}
+
+	return result
+}
+
+// ToPayoutReconciliationModel converts a PayoutReconciliation to a PayoutReconciliation domain model
+func ToPayoutReconciliationModel(dtoObj *dto.PayoutReconciliation) *model.PayoutReconciliation {
+	if dtoObj == nil {
+		return nil
+	}
+
+	result := &model.PayoutReconciliation{
+		ID:                    dtoObj.ID,
+		PayoutID:              dtoObj.PayoutID,
+		PayoutRecordID:        dtoObj.PayoutRecordID,
+		AozoraTransferApplyNo: dtoObj.AozoraTransferApplyNo,
+		LocalAmount:           dtoObj.LocalAmount,
+		BankAmount:            dtoObj.BankAmount,
+		BankTransferStatus:    dtoObj.BankTransferStatus,
+		DetectedAt:            dtoObj.DetectedAt,
+		BaseColumnTimestamp: util.BaseColumnTimestamp{
+			CreatedAt: dtoObj.CreatedAt,
+			UpdatedAt: dtoObj.UpdatedAt,
+		},
+	}
+
+	// Handle the conversion from gorm.DeletedAt to *time.Time
+	if dtoObj.DeletedAt.Valid {
+		deletedAt := dtoObj.DeletedAt.Time
+		result.DeletedAt = &deletedAt
+	}
+
+	return result
+}
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+type PayoutReconciliation struct {
+	ID int `json:"id"`
+	persistence.BaseColumnTimestamp
+
+	PayoutID              int       `json:"payout_id"`
+	PayoutRecordID        int       `json:"payout_record_id"`
+	AozoraTransferApplyNo string    `json:"aozora_transfer_apply_no"`
+	LocalAmount           float64   `json:"local_amount"`
+	BankAmount            float64   `json:"bank_amount"`
+	BankTransferStatus    string    `json:"bank_transfer_status"`
+	DetectedAt            time.Time `json:"detected_at"`
+}
+
+func (PayoutReconciliation) TableName() string {
+	return "payout_reconciliation"
+}
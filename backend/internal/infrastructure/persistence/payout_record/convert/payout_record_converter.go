@@ -5,6 +5,8 @@ import (
 	util "github.com/huydq/test/internal/domain/object/basedatetime"
 	objectPayout "github.com/huydq/test/internal/domain/object/payout"
 	"github.com/huydq/test/internal/infrastructure/persistence/payout_record/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+	"gorm.io/gorm"
 )
 
 // ToPayoutRecordDTO converts a PayoutRecord domain model to a PayoutRecord
@@ -13,7 +15,7 @@ func ToPayoutRecordDTO(record *modelPayoutRecord.PayoutRecord) *dto.PayoutRecord
 		return nil
 	}
 
-	return &dto.PayoutRecord{
+	result := &dto.PayoutRecord{
 		ID:                    record.ID,
 		ShopID:                record.ShopID,
 		PayoutID:              record.PayoutID,
@@ -33,12 +35,21 @@ func ToPayoutRecordDTO(record *modelPayoutRecord.PayoutRecord) *dto.PayoutRecord
 		TransferExecutedAt:    record.TransferExecutedAt,
 		TransferRequestError:  record.TransferRequestError,
 		IdempotencyKey:        record.IdempotencyKey,
-		BaseColumnTimestamp: util.BaseColumnTimestamp{
+		BaseColumnTimestamp: persistence.BaseColumnTimestamp{
 			CreatedAt: record.CreatedAt,
 			UpdatedAt: record.UpdatedAt,
-			DeletedAt: record.DeletedAt,
 		},
 	}
+
+	// Handle the conversion from *time.Time to gorm.DeletedAt
+	if record.DeletedAt != nil {
+		result.DeletedAt = gorm.DeletedAt{
+			Time:  *record.DeletedAt,
+			Valid: true,
+		}
+	}
+
+	return result
 }
 
 // ToPayoutRecordModel converts a PayoutRecord to a PayoutRecord domain model
@@ -47,7 +58,7 @@ func ToPayoutRecordModel(dtoObj *dto.PayoutRecord) *modelPayoutRecord.PayoutReco
 		return nil
 	}
 
-	return &modelPayoutRecord.PayoutRecord{
+	result := &modelPayoutRecord.PayoutRecord{
 		ID:                    dtoObj.ID,
 		ShopID:                dtoObj.ShopID,
 		PayoutID:              dtoObj.PayoutID,
@@ -70,9 +81,16 @@ func ToPayoutRecordModel(dtoObj *dto.PayoutRecord) *modelPayoutRecord.PayoutReco
 		BaseColumnTimestamp: util.BaseColumnTimestamp{
 			CreatedAt: dtoObj.CreatedAt,
 			UpdatedAt: dtoObj.UpdatedAt,
-			DeletedAt: dtoObj.DeletedAt,
 		},
 	}
+
+	// Handle the conversion from gorm.DeletedAt to *time.Time
+	if dtoObj.DeletedAt.Valid {
+		deletedAt := dtoObj.DeletedAt.Time
+		result.DeletedAt = &deletedAt
+	}
+
+	return result
 }
 
 // ToPayoutRecordDTOs converts a list of PayoutRecord domain models to a list of PayoutRecordDTOs
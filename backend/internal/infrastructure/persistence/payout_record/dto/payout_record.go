@@ -3,8 +3,8 @@ package dto
 import (
 	"time"
 
-	util "github.com/huydq/test/internal/domain/object/basedatetime"
 	payoutDto "github.com/huydq/test/internal/infrastructure/persistence/payout/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
 )
 
 const (
@@ -22,7 +22,7 @@ const (
 
 type PayoutRecord struct {
 	ID int `json:"id"`
-	util.BaseColumnTimestamp
+	persistence.BaseColumnTimestamp
 
 	ShopID                int        `json:"shop_id"`
 	PayoutID              int        `json:"payout_id"`
@@ -3,10 +3,14 @@ package persistence
 import (
 	"context"
 
+	model "github.com/huydq/test/internal/domain/model/payout_record"
 	repository "github.com/huydq/test/internal/domain/repository/payout_record"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout_record/convert"
+	"github.com/huydq/test/internal/infrastructure/persistence/payout_record/dto"
 	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
 	"github.com/huydq/test/internal/pkg/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PayoutRecordRepositoryImpl struct {
@@ -30,7 +34,7 @@ func (r *PayoutRecordRepositoryImpl) CountByPayoutID(ctx context.Context, payout
 	var count int64
 
 	err = db.
-		Table("payout_record").
+		Model(&dto.PayoutRecord{}).
 		Where("payout_id = ?", payoutID).
 		Count(&count).Error
 
@@ -59,7 +63,7 @@ func (r *PayoutRecordRepositoryImpl) CountByPayoutIDs(ctx context.Context, payou
 	}
 
 	err = db.
-		Table("payout_record").
+		Model(&dto.PayoutRecord{}).
 		Select("payout_id, COUNT(*) AS count").
 		Where("payout_id IN (?)", payoutIDs).
 		Group("payout_id").
@@ -85,7 +89,7 @@ func (r *PayoutRecordRepositoryImpl) SumAmountByPayoutID(ctx context.Context, pa
 	var totalAmount float64
 
 	err = db.WithContext(ctx).
-		Table("payout_record").
+		Model(&dto.PayoutRecord{}).
 		Where("payout_id = ?", payoutID).
 		Select("SUM(amount)").
 		Scan(&totalAmount).Error
@@ -116,7 +120,7 @@ func (r *PayoutRecordRepositoryImpl) SumAmountByPayoutIDs(ctx context.Context, p
 	}
 
 	err = db.
-		Table("payout_record").
+		Model(&dto.PayoutRecord{}).
 		Select("payout_id, SUM(amount) AS sum").
 		Where("payout_id IN (?)", payoutIDs).
 		Group("payout_id").
@@ -132,3 +136,57 @@ func (r *PayoutRecordRepositoryImpl) SumAmountByPayoutIDs(ctx context.Context, p
 
 	return totalAmounts, nil
 }
+
+// FindByPayoutID lists the records belonging to a payout
+func (r *PayoutRecordRepositoryImpl) FindByPayoutID(ctx context.Context, payoutID int) ([]*model.PayoutRecord, error) {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordDTOs []*dto.PayoutRecord
+	err = db.WithContext(ctx).
+		Where("payout_id = ?", payoutID).
+		Order("id ASC").
+		Find(&recordDTOs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ToPayoutRecordModels(recordDTOs), nil
+}
+
+// CreateBatch creates multiple payout records and sets the generated IDs on the models
+func (r *PayoutRecordRepositoryImpl) CreateBatch(ctx context.Context, records []*model.PayoutRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	recordDTOs := convert.ToPayoutRecordDTOs(records)
+	if err := db.WithContext(ctx).Omit(clause.Associations).Create(&recordDTOs).Error; err != nil {
+		return err
+	}
+
+	for i, recordDTO := range recordDTOs {
+		records[i].ID = recordDTO.ID
+	}
+
+	return nil
+}
+
+// DeleteByPayoutID soft-deletes all records belonging to a payout
+func (r *PayoutRecordRepositoryImpl) DeleteByPayoutID(ctx context.Context, payoutID int) error {
+	db, err := database.GetTxOrDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.WithContext(ctx).
+		Where("payout_id = ?", payoutID).
+		Delete(&dto.PayoutRecord{}).Error
+}
@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// PaypayInvoice represents the paypay_invoice table
+type PaypayInvoice struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	PayinFileID          int        `json:"payin_file_id"`
+	Duplicate            bool       `json:"duplicate"`
+	FileName             string     `json:"file_name"`
+	StorageKey           string     `json:"storage_key"`
+	RegistrationNumber   string     `json:"registration_number"`
+	IssueDate            *time.Time `json:"issue_date"`
+	PaymentMerchantID    string     `json:"payment_merchant_id"`
+	MerchantBusinessName string     `json:"merchant_business_name"`
+	CutoffDate           *time.Time `json:"cutoff_date"`
+	TaxableAmount        *float64   `json:"taxable_amount"`
+	TaxAmount            *float64   `json:"tax_amount"`
+	TotalAmount          *float64   `json:"total_amount"`
+	PaypayPayinSummaryID *int       `json:"paypay_payin_summary_id"`
+	PaypayPayinDetailID  *int       `json:"paypay_payin_detail_id"`
+
+	PayinFile *dto.PayinFile `json:"payin_file,omitempty"`
+}
+
+// TableName specifies the table name for PaypayInvoice
+func (PaypayInvoice) TableName() string {
+	return "paypay_invoice"
+}
+
+func (dto *PaypayInvoice) ToPaypayInvoiceModel() *model.PaypayInvoice {
+	paypayInvoiceModel := &model.PaypayInvoice{
+		ID:                   dto.ID,
+		PayinFileID:          dto.PayinFileID,
+		Duplicate:            dto.Duplicate,
+		FileName:             dto.FileName,
+		StorageKey:           dto.StorageKey,
+		RegistrationNumber:   dto.RegistrationNumber,
+		IssueDate:            dto.IssueDate,
+		PaymentMerchantID:    dto.PaymentMerchantID,
+		MerchantBusinessName: dto.MerchantBusinessName,
+		CutoffDate:           dto.CutoffDate,
+		TaxableAmount:        dto.TaxableAmount,
+		TaxAmount:            dto.TaxAmount,
+		TotalAmount:          dto.TotalAmount,
+		PaypayPayinSummaryID: dto.PaypayPayinSummaryID,
+		PaypayPayinDetailID:  dto.PaypayPayinDetailID,
+	}
+	paypayInvoiceModel.CreatedAt = dto.CreatedAt
+	paypayInvoiceModel.UpdatedAt = dto.UpdatedAt
+	return paypayInvoiceModel
+}
+
+func ToPaypayInvoiceDTO(p *model.PaypayInvoice) *PaypayInvoice {
+	paypayInvoiceDTO := &PaypayInvoice{
+		ID:                   p.ID,
+		PayinFileID:          p.PayinFileID,
+		Duplicate:            p.Duplicate,
+		FileName:             p.FileName,
+		StorageKey:           p.StorageKey,
+		RegistrationNumber:   p.RegistrationNumber,
+		IssueDate:            p.IssueDate,
+		PaymentMerchantID:    p.PaymentMerchantID,
+		MerchantBusinessName: p.MerchantBusinessName,
+		CutoffDate:           p.CutoffDate,
+		TaxableAmount:        p.TaxableAmount,
+		TaxAmount:            p.TaxAmount,
+		TotalAmount:          p.TotalAmount,
+		PaypayPayinSummaryID: p.PaypayPayinSummaryID,
+		PaypayPayinDetailID:  p.PaypayPayinDetailID,
+	}
+	paypayInvoiceDTO.CreatedAt = p.CreatedAt
+	paypayInvoiceDTO.UpdatedAt = p.UpdatedAt
+	return paypayInvoiceDTO
+}
+
+func ToPaypayInvoiceDTOs(paypayInvoices []*model.PaypayInvoice) []*PaypayInvoice {
+	paypayInvoiceDTOs := make([]*PaypayInvoice, len(paypayInvoices))
+	for i, paypayInvoice := range paypayInvoices {
+		paypayInvoiceDTOs[i] = ToPaypayInvoiceDTO(paypayInvoice)
+	}
+	return paypayInvoiceDTOs
+}
+
+func ToPaypayInvoiceModels(paypayInvoiceDTOs []*PaypayInvoice) []*model.PaypayInvoice {
+	paypayInvoices := make([]*model.PaypayInvoice, len(paypayInvoiceDTOs))
+	for i, paypayInvoiceDTO := range paypayInvoiceDTOs {
+		paypayInvoices[i] = paypayInvoiceDTO.ToPaypayInvoiceModel()
+	}
+	return paypayInvoices
+}
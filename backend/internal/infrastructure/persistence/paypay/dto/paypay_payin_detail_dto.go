@@ -52,6 +52,7 @@ func (dto *PaypayPayinDetail) ToPaypayPayinDetailModel() *model.PaypayPayinDetai
 		Cashback:             dto.Cashback,
 		Adjustment:           dto.Adjustment,
 		Fee:                  dto.Fee,
+		Amount:               dto.Amount,
 	}
 	paypayPayinDetailModel.CreatedAt = dto.CreatedAt
 	paypayPayinDetailModel.UpdatedAt = dto.UpdatedAt
@@ -74,6 +75,7 @@ func ToPaypayPayinDetailDTO(p *model.PaypayPayinDetail) *PaypayPayinDetail {
 		Cashback:             p.Cashback,
 		Adjustment:           p.Adjustment,
 		Fee:                  p.Fee,
+		Amount:               p.Amount,
 	}
 	paypayPayinDetailDTO.CreatedAt = p.CreatedAt
 	paypayPayinDetailDTO.UpdatedAt = p.UpdatedAt
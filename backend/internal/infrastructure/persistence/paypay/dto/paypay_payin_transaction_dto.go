@@ -29,7 +29,8 @@ type PaypayPayinTransaction struct {
 	PaymentDetail        *paypayModel.PaymentDetail `json:"payment_detail"`
 
 	PaymentTransactionStatus *paypayObject.PaypayTransactionStatus `json:"payment_transaction_status"`
-	PaypayPaymentMethod      string                                `json:"paypay_payment_method"`
+	PaypayPaymentMethod      *paypayObject.PaypayPaymentMethod     `json:"paypay_payment_method"`
+	PaypayPaymentMethodLabel *string                               `json:"paypay_payment_method_label"`
 
 	PayinFile *dto.PayinFile `json:"payin_file,omitempty"`
 }
@@ -55,6 +56,10 @@ func (dto *PaypayPayinTransaction) ToPaypayPayinTransactionModel() *paypayModel.
 		SSID:                 dto.SSID,
 		MerchantOrderID:      dto.MerchantOrderID,
 		PaymentDetail:        dto.PaymentDetail,
+
+		PaymentTransactionStatus: dto.PaymentTransactionStatus,
+		PaypayPaymentMethod:      dto.PaypayPaymentMethod,
+		PaypayPaymentMethodLabel: dto.PaypayPaymentMethodLabel,
 	}
 	paypayPayinTransactionModel.CreatedAt = dto.CreatedAt
 	paypayPayinTransactionModel.UpdatedAt = dto.UpdatedAt
@@ -77,6 +82,10 @@ func ToPaypayPayinTransactionDTO(paypayPayinTransactions *paypayModel.PaypayPayi
 		SSID:                 paypayPayinTransactions.SSID,
 		MerchantOrderID:      paypayPayinTransactions.MerchantOrderID,
 		PaymentDetail:        paypayPayinTransactions.PaymentDetail,
+
+		PaymentTransactionStatus: paypayPayinTransactions.PaymentTransactionStatus,
+		PaypayPaymentMethod:      paypayPayinTransactions.PaypayPaymentMethod,
+		PaypayPaymentMethodLabel: paypayPayinTransactions.PaypayPaymentMethodLabel,
 	}
 	paypayPayinTransactionDTO.CreatedAt = paypayPayinTransactions.CreatedAt
 	paypayPayinTransactionDTO.UpdatedAt = paypayPayinTransactions.UpdatedAt
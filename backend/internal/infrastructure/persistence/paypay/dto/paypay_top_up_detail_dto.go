@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// PaypayTopUpDetail represents the paypay_top_up_detail table
+type PaypayTopUpDetail struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	PayinFileID          int        `json:"payin_file_id"`
+	PaymentMerchantID    string     `json:"payment_merchant_id"`
+	MerchantBusinessName string     `json:"merchant_business_name"`
+	CutoffDate           *time.Time `json:"cutoff_date"`
+	PaymentDate          *time.Time `json:"payment_date"`
+	Amount               float64    `json:"amount"`
+	BankName             string     `json:"bank_name"`
+	BranchName           string     `json:"branch_name"`
+	AccountType          string     `json:"account_type"`
+	AccountNumber        string     `json:"account_number"`
+	AccountHolder        string     `json:"account_holder"`
+
+	PayinFile *dto.PayinFile `json:"payin_file,omitempty"`
+}
+
+// TableName specifies the table name for PaypayTopUpDetail
+func (PaypayTopUpDetail) TableName() string {
+	return "paypay_top_up_detail"
+}
+
+func (dto *PaypayTopUpDetail) ToPaypayTopUpDetailModel() *model.PaypayTopUpDetail {
+	paypayTopUpDetailModel := &model.PaypayTopUpDetail{
+		ID:                   dto.ID,
+		PayinFileID:          dto.PayinFileID,
+		PaymentMerchantID:    dto.PaymentMerchantID,
+		MerchantBusinessName: dto.MerchantBusinessName,
+		CutoffDate:           dto.CutoffDate,
+		PaymentDate:          dto.PaymentDate,
+		Amount:               dto.Amount,
+		BankName:             dto.BankName,
+		BranchName:           dto.BranchName,
+		AccountType:          dto.AccountType,
+		AccountNumber:        dto.AccountNumber,
+		AccountHolder:        dto.AccountHolder,
+	}
+	paypayTopUpDetailModel.CreatedAt = dto.CreatedAt
+	paypayTopUpDetailModel.UpdatedAt = dto.UpdatedAt
+	return paypayTopUpDetailModel
+}
+
+func ToPaypayTopUpDetailDTO(p *model.PaypayTopUpDetail) *PaypayTopUpDetail {
+	paypayTopUpDetailDTO := &PaypayTopUpDetail{
+		ID:                   p.ID,
+		PayinFileID:          p.PayinFileID,
+		PaymentMerchantID:    p.PaymentMerchantID,
+		MerchantBusinessName: p.MerchantBusinessName,
+		CutoffDate:           p.CutoffDate,
+		PaymentDate:          p.PaymentDate,
+		Amount:               p.Amount,
+		BankName:             p.BankName,
+		BranchName:           p.BranchName,
+		AccountType:          p.AccountType,
+		AccountNumber:        p.AccountNumber,
+		AccountHolder:        p.AccountHolder,
+	}
+	paypayTopUpDetailDTO.CreatedAt = p.CreatedAt
+	paypayTopUpDetailDTO.UpdatedAt = p.UpdatedAt
+	return paypayTopUpDetailDTO
+}
+
+func ToPaypayTopUpDetailDTOs(paypayTopUpDetails []*model.PaypayTopUpDetail) []*PaypayTopUpDetail {
+	paypayTopUpDetailDTOs := make([]*PaypayTopUpDetail, len(paypayTopUpDetails))
+	for i, paypayTopUpDetail := range paypayTopUpDetails {
+		paypayTopUpDetailDTOs[i] = ToPaypayTopUpDetailDTO(paypayTopUpDetail)
+	}
+	return paypayTopUpDetailDTOs
+}
+
+func ToPaypayTopUpDetailModels(paypayTopUpDetailDTOs []*PaypayTopUpDetail) []*model.PaypayTopUpDetail {
+	paypayTopUpDetails := make([]*model.PaypayTopUpDetail, len(paypayTopUpDetailDTOs))
+	for i, paypayTopUpDetailDTO := range paypayTopUpDetailDTOs {
+		paypayTopUpDetails[i] = paypayTopUpDetailDTO.ToPaypayTopUpDetailModel()
+	}
+	return paypayTopUpDetails
+}
@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	paypayObject "github.com/huydq/test/internal/domain/object/paypay"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// PaypayTransactionDetail represents the paypay_transaction_detail table
+type PaypayTransactionDetail struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	PayinFileID              int        `json:"payin_file_id"`
+	ShippingRelated          bool       `json:"shipping_related"`
+	PaymentTransactionID     string     `json:"payment_transaction_id"`
+	PaymentMerchantID        string     `json:"payment_merchant_id"`
+	MerchantBusinessName     string     `json:"merchant_business_name"`
+	ShopID                   string     `json:"shop_id"`
+	ShopName                 string     `json:"shop_name"`
+	TerminalCode             string     `json:"terminal_code"`
+	TransactionAt            *time.Time `json:"transaction_at"`
+	TransactionAmount        float64    `json:"transaction_amount"`
+	ReceiptNumber            string     `json:"receipt_number"`
+	PaypayPaymentMethodLabel string     `json:"paypay_payment_method_label"`
+	MerchantOrderID          string     `json:"merchant_order_id"`
+	ShippingFee              *float64   `json:"shipping_fee"`
+	ShippedAt                *time.Time `json:"shipped_at"`
+
+	PaymentTransactionStatus *paypayObject.PaypayTransactionStatus `json:"payment_transaction_status"`
+	PaypayPaymentMethod      *paypayObject.PaypayPaymentMethod     `json:"paypay_payment_method"`
+
+	PayinFile *dto.PayinFile `json:"payin_file,omitempty"`
+}
+
+// TableName specifies the table name for PaypayTransactionDetail
+func (PaypayTransactionDetail) TableName() string {
+	return "paypay_transaction_detail"
+}
+
+func (dto *PaypayTransactionDetail) ToPaypayTransactionDetailModel() *model.PaypayTransactionDetail {
+	paypayTransactionDetailModel := &model.PaypayTransactionDetail{
+		ID:                       dto.ID,
+		PayinFileID:              dto.PayinFileID,
+		ShippingRelated:          dto.ShippingRelated,
+		PaymentTransactionID:     dto.PaymentTransactionID,
+		PaymentMerchantID:        dto.PaymentMerchantID,
+		MerchantBusinessName:     dto.MerchantBusinessName,
+		ShopID:                   dto.ShopID,
+		ShopName:                 dto.ShopName,
+		TerminalCode:             dto.TerminalCode,
+		PaymentTransactionStatus: dto.PaymentTransactionStatus,
+		TransactionAt:            dto.TransactionAt,
+		TransactionAmount:        dto.TransactionAmount,
+		ReceiptNumber:            dto.ReceiptNumber,
+		PaypayPaymentMethod:      dto.PaypayPaymentMethod,
+		PaypayPaymentMethodLabel: dto.PaypayPaymentMethodLabel,
+		MerchantOrderID:          dto.MerchantOrderID,
+		ShippingFee:              dto.ShippingFee,
+		ShippedAt:                dto.ShippedAt,
+	}
+	paypayTransactionDetailModel.CreatedAt = dto.CreatedAt
+	paypayTransactionDetailModel.UpdatedAt = dto.UpdatedAt
+	return paypayTransactionDetailModel
+}
+
+func ToPaypayTransactionDetailDTO(p *model.PaypayTransactionDetail) *PaypayTransactionDetail {
+	paypayTransactionDetailDTO := &PaypayTransactionDetail{
+		ID:                       p.ID,
+		PayinFileID:              p.PayinFileID,
+		ShippingRelated:          p.ShippingRelated,
+		PaymentTransactionID:     p.PaymentTransactionID,
+		PaymentMerchantID:        p.PaymentMerchantID,
+		MerchantBusinessName:     p.MerchantBusinessName,
+		ShopID:                   p.ShopID,
+		ShopName:                 p.ShopName,
+		TerminalCode:             p.TerminalCode,
+		PaymentTransactionStatus: p.PaymentTransactionStatus,
+		TransactionAt:            p.TransactionAt,
+		TransactionAmount:        p.TransactionAmount,
+		ReceiptNumber:            p.ReceiptNumber,
+		PaypayPaymentMethod:      p.PaypayPaymentMethod,
+		PaypayPaymentMethodLabel: p.PaypayPaymentMethodLabel,
+		MerchantOrderID:          p.MerchantOrderID,
+		ShippingFee:              p.ShippingFee,
+		ShippedAt:                p.ShippedAt,
+	}
+	paypayTransactionDetailDTO.CreatedAt = p.CreatedAt
+	paypayTransactionDetailDTO.UpdatedAt = p.UpdatedAt
+	return paypayTransactionDetailDTO
+}
+
+func ToPaypayTransactionDetailDTOs(paypayTransactionDetails []*model.PaypayTransactionDetail) []*PaypayTransactionDetail {
+	paypayTransactionDetailDTOs := make([]*PaypayTransactionDetail, len(paypayTransactionDetails))
+	for i, paypayTransactionDetail := range paypayTransactionDetails {
+		paypayTransactionDetailDTOs[i] = ToPaypayTransactionDetailDTO(paypayTransactionDetail)
+	}
+	return paypayTransactionDetailDTOs
+}
+
+func ToPaypayTransactionDetailModels(paypayTransactionDetailDTOs []*PaypayTransactionDetail) []*model.PaypayTransactionDetail {
+	paypayTransactionDetails := make([]*model.PaypayTransactionDetail, len(paypayTransactionDetailDTOs))
+	for i, paypayTransactionDetailDTO := range paypayTransactionDetailDTOs {
+		paypayTransactionDetails[i] = paypayTransactionDetailDTO.ToPaypayTransactionDetailModel()
+	}
+	return paypayTransactionDetails
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	"github.com/huydq/test/internal/infrastructure/persistence/payin/dto"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// PaypayTransactionSummary represents the paypay_transaction_summary table
+type PaypayTransactionSummary struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	PayinFileID          int        `json:"payin_file_id"`
+	PaymentMerchantID    string     `json:"payment_merchant_id"`
+	MerchantBusinessName string     `json:"merchant_business_name"`
+	TransactionDate      *time.Time `json:"transaction_date"`
+	TransactionCount     int        `json:"transaction_count"`
+	TransactionAmount    float64    `json:"transaction_amount"`
+	RefundCount          int        `json:"refund_count"`
+	RefundAmount         float64    `json:"refund_amount"`
+	UsageFee             float64    `json:"usage_fee"`
+
+	PayinFile *dto.PayinFile `json:"payin_file,omitempty"`
+}
+
+// TableName specifies the table name for PaypayTransactionSummary
+func (PaypayTransactionSummary) TableName() string {
+	return "paypay_transaction_summary"
+}
+
+func (dto *PaypayTransactionSummary) ToPaypayTransactionSummaryModel() *model.PaypayTransactionSummary {
+	paypayTransactionSummaryModel := &model.PaypayTransactionSummary{
+		ID:                   dto.ID,
+		PayinFileID:          dto.PayinFileID,
+		PaymentMerchantID:    dto.PaymentMerchantID,
+		MerchantBusinessName: dto.MerchantBusinessName,
+		TransactionDate:      dto.TransactionDate,
+		TransactionCount:     dto.TransactionCount,
+		TransactionAmount:    dto.TransactionAmount,
+		RefundCount:          dto.RefundCount,
+		RefundAmount:         dto.RefundAmount,
+		UsageFee:             dto.UsageFee,
+	}
+	paypayTransactionSummaryModel.CreatedAt = dto.CreatedAt
+	paypayTransactionSummaryModel.UpdatedAt = dto.UpdatedAt
+	return paypayTransactionSummaryModel
+}
+
+func ToPaypayTransactionSummaryDTO(p *model.PaypayTransactionSummary) *PaypayTransactionSummary {
+	paypayTransactionSummaryDTO := &PaypayTransactionSummary{
+		ID:                   p.ID,
+		PayinFileID:          p.PayinFileID,
+		PaymentMerchantID:    p.PaymentMerchantID,
+		MerchantBusinessName: p.MerchantBusinessName,
+		TransactionDate:      p.TransactionDate,
+		TransactionCount:     p.TransactionCount,
+		TransactionAmount:    p.TransactionAmount,
+		RefundCount:          p.RefundCount,
+		RefundAmount:         p.RefundAmount,
+		UsageFee:             p.UsageFee,
+	}
+	paypayTransactionSummaryDTO.CreatedAt = p.CreatedAt
+	paypayTransactionSummaryDTO.UpdatedAt = p.UpdatedAt
+	return paypayTransactionSummaryDTO
+}
+
+func ToPaypayTransactionSummaryDTOs(paypayTransactionSummaries []*model.PaypayTransactionSummary) []*PaypayTransactionSummary {
+	paypayTransactionSummaryDTOs := make([]*PaypayTransactionSummary, len(paypayTransactionSummaries))
+	for i, paypayTransactionSummary := range paypayTransactionSummaries {
+		paypayTransactionSummaryDTOs[i] = ToPaypayTransactionSummaryDTO(paypayTransactionSummary)
+	}
+	return paypayTransactionSummaryDTOs
+}
+
+func ToPaypayTransactionSummaryModels(paypayTransactionSummaryDTOs []*PaypayTransactionSummary) []*model.PaypayTransactionSummary {
+	paypayTransactionSummaries := make([]*model.PaypayTransactionSummary, len(paypayTransactionSummaryDTOs))
+	for i, paypayTransactionSummaryDTO := range paypayTransactionSummaryDTOs {
+		paypayTransactionSummaries[i] = paypayTransactionSummaryDTO.ToPaypayTransactionSummaryModel()
+	}
+	return paypayTransactionSummaries
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	model "github.com/huydq/test/internal/domain/model/transaction"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// Transaction represents the transaction table
+type Transaction struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	ShopID            int `json:"shop_id"`
+	TransactionStatus int `json:"transaction_status"`
+	PayoutID          int `json:"payout_id"`
+	PayoutRecordID    int `json:"payout_record_id"`
+}
+
+// TableName specifies the table name for Transaction
+func (Transaction) TableName() string {
+	return "transaction"
+}
+
+func (dto *Transaction) ToTransactionModel() *model.Transaction {
+	transactionModel := &model.Transaction{
+		ID:                dto.ID,
+		ShopID:            dto.ShopID,
+		TransactionStatus: dto.TransactionStatus,
+		PayoutID:          dto.PayoutID,
+		PayoutRecordID:    dto.PayoutRecordID,
+	}
+	transactionModel.CreatedAt = dto.CreatedAt
+	transactionModel.UpdatedAt = dto.UpdatedAt
+	return transactionModel
+}
+
+func ToTransactionDTO(t *model.Transaction) *Transaction {
+	transactionDTO := &Transaction{
+		ID:                t.ID,
+		ShopID:            t.ShopID,
+		TransactionStatus: t.TransactionStatus,
+		PayoutID:          t.PayoutID,
+		PayoutRecordID:    t.PayoutRecordID,
+	}
+	transactionDTO.CreatedAt = t.CreatedAt
+	transactionDTO.UpdatedAt = t.UpdatedAt
+	return transactionDTO
+}
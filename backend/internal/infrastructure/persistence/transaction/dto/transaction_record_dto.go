@@ -0,0 +1,65 @@
+package dto
+
+import (
+	model "github.com/huydq/test/internal/domain/model/transaction"
+	persistence "github.com/huydq/test/internal/infrastructure/persistence/util"
+)
+
+// TransactionRecord represents the transaction_record table
+type TransactionRecord struct {
+	ID int `gorm:"primaryKey;autoIncrement" json:"id"`
+	persistence.BaseColumnTimestamp
+
+	TransactionID         int     `json:"transaction_id"`
+	MerchantID            *int    `json:"merchant_id"`
+	PayinDetailID         int     `json:"payin_detail_id"`
+	PayinSummaryID        *int    `json:"payin_summary_id"`
+	TransactionRecordType int     `json:"transaction_record_type"`
+	Title                 string  `json:"title"`
+	Amount                float64 `json:"amount"`
+}
+
+// TableName specifies the table name for TransactionRecord
+func (TransactionRecord) TableName() string {
+	return "transaction_record"
+}
+
+func (dto *TransactionRecord) ToTransactionRecordModel() *model.TransactionRecord {
+	transactionRecordModel := &model.TransactionRecord{
+		ID:                    dto.ID,
+		TransactionID:         dto.TransactionID,
+		MerchantID:            dto.MerchantID,
+		PayinDetailID:         dto.PayinDetailID,
+		PayinSummaryID:        dto.PayinSummaryID,
+		TransactionRecordType: dto.TransactionRecordType,
+		Title:                 dto.Title,
+		Amount:                dto.Amount,
+	}
+	transactionRecordModel.CreatedAt = dto.CreatedAt
+	transactionRecordModel.UpdatedAt = dto.UpdatedAt
+	return transactionRecordModel
+}
+
+func ToTransactionRecordDTO(r *model.TransactionRecord) *TransactionRecord {
+	transactionRecordDTO := &TransactionRecord{
+		ID:                    r.ID,
+		TransactionID:         r.TransactionID,
+		MerchantID:            r.MerchantID,
+		PayinDetailID:         r.PayinDetailID,
+		PayinSummaryID:        r.PayinSummaryID,
+		TransactionRecordType: r.TransactionRecordType,
+		Title:                 r.Title,
+		Amount:                r.Amount,
+	}
+	transactionRecordDTO.CreatedAt = r.CreatedAt
+	transactionRecordDTO.UpdatedAt = r.UpdatedAt
+	return transactionRecordDTO
+}
+
+func ToTransactionRecordDTOs(records []model.TransactionRecord) []*TransactionRecord {
+	transactionRecordDTOs := make([]*TransactionRecord, len(records))
+	for i := range records {
+		transactionRecordDTOs[i] = ToTransactionRecordDTO(&records[i])
+	}
+	return transactionRecordDTOs
+}
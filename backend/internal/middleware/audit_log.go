@@ -17,6 +17,9 @@ const (
 	ContextKey_AuditLogNewRole      ContextKey = "newRole"
 	ContextKey_AuditLogPayoutID     ContextKey = "payoutId"
 	ContextKey_AuditLogPayinID      ContextKey = "payinId"
+
+	ContextKey_AuditLogMerchantID            ContextKey = "merchantId"
+	ContextKey_AuditLogMerchantBankAccountID ContextKey = "merchantBankAccountId"
 )
 
 type AuditLogOptions struct {
@@ -95,6 +98,8 @@ func (a *AuditLogBuilder) logAuditEvent(c echo.Context) {
 	var newRole string
 	var payoutID *int
 	var payinID *int
+	var merchantID *int
+	var merchantBankAccountID *int
 
 	if targetIDVal := c.Get(string(ContextKey_AuditLogTargetUserID)); targetIDVal != nil {
 		switch v := targetIDVal.(type) {
@@ -123,10 +128,22 @@ func (a *AuditLogBuilder) logAuditEvent(c echo.Context) {
 		}
 	}
 
+	if mID := c.Get(string(ContextKey_AuditLogMerchantID)); mID != nil {
+		if id, ok := mID.(*int); ok {
+			merchantID = id
+		}
+	}
+
+	if accountID := c.Get(string(ContextKey_AuditLogMerchantBankAccountID)); accountID != nil {
+		if id, ok := accountID.(*int); ok {
+			merchantBankAccountID = id
+		}
+	}
+
 	ipAddressPtr := &ipAddress
 	userAgentPtr := &userAgent
 
-	if !hasEnoughDataForAuditLog(a.options.AuditLogType, userIDInt, targetUserID, newRole, payoutID, payinID) {
+	if !hasEnoughDataForAuditLog(a.options.AuditLogType, userIDInt, targetUserID, newRole, payoutID, payinID, merchantID, merchantBankAccountID) {
 		log.Printf("Skipping audit logging due to insufficient data for event type: %s", a.options.AuditLogType)
 		return
 	}
@@ -143,6 +160,9 @@ func (a *AuditLogBuilder) logAuditEvent(c echo.Context) {
 		payoutID,
 		payinID,
 	)
+	auditLogModel.MerchantID = merchantID
+	auditLogModel.MerchantBankAccountID = merchantBankAccountID
+	auditLogModel.SetDefaultDescription()
 
 	err := a.auditLogService.CreateAuditLog(ctx, auditLogModel)
 	if err != nil {
@@ -168,7 +188,7 @@ func parseInt(s string) int {
 	return i
 }
 
-func hasEnoughDataForAuditLog(auditLogType object.AuditLogType, userIDInt *int, targetUserID int, newRole string, payoutID *int, payinID *int) bool {
+func hasEnoughDataForAuditLog(auditLogType object.AuditLogType, userIDInt *int, targetUserID int, newRole string, payoutID *int, payinID *int, merchantID *int, merchantBankAccountID *int) bool {
 	switch auditLogType {
 	case object.AuditLogTypeLogin:
 		return targetUserID != 0
@@ -176,12 +196,17 @@ func hasEnoughDataForAuditLog(auditLogType object.AuditLogType, userIDInt *int,
 		return userIDInt != nil
 	case object.AuditLogTypeUserCreate, object.AuditLogTypeUserUpdate, object.AuditLogTypeUserDelete, object.AuditLogTypeRoleChange:
 		return userIDInt != nil && targetUserID != 0
-	case object.AuditLogTypePayoutRequest, object.AuditLogTypePayoutApproval, object.AuditLogTypePayoutReject, object.AuditLogTypePayoutResend, object.AuditLogTypePayoutMarkSent:
+	case object.AuditLogTypePayoutRequest, object.AuditLogTypePayoutUpdate, object.AuditLogTypePayoutDelete,
+		object.AuditLogTypePayoutApproval, object.AuditLogTypePayoutReject, object.AuditLogTypePayoutResend, object.AuditLogTypePayoutMarkSent:
 		return userIDInt != nil && payoutID != nil
-	case object.AuditLogTypeManualPayinImport:
+	case object.AuditLogTypeManualPayinImport, object.AuditLogTypeInvoiceDownload:
 		return userIDInt != nil && payinID != nil
 	case object.AuditLogType2FAEnable, object.AuditLogType2FADisable:
 		return userIDInt != nil && targetUserID != 0
+	case object.AuditLogTypeMerchantCreate, object.AuditLogTypeMerchantUpdate, object.AuditLogTypeMerchantDelete:
+		return userIDInt != nil && merchantID != nil
+	case object.AuditLogTypeMerchantBankAccountRequest, object.AuditLogTypeMerchantBankAccountConfirm, object.AuditLogTypeMerchantBankAccountReject:
+		return userIDInt != nil && merchantID != nil && merchantBankAccountID != nil
 	default:
 		return userIDInt != nil
 	}
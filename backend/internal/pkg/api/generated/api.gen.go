@@ -24,44 +24,12 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
-// Defines values for PayoutRecordsStatus.
-const (
-	PayoutRecordsStatusCompleted  PayoutRecordsStatus = "completed"
-	PayoutRecordsStatusFailed     PayoutRecordsStatus = "failed"
-	PayoutRecordsStatusPending    PayoutRecordsStatus = "pending"
-	PayoutRecordsStatusProcessing PayoutRecordsStatus = "processing"
-)
-
-// Defines values for PayoutStatus.
-const (
-	PayoutStatusCompleted  PayoutStatus = "completed"
-	PayoutStatusFailed     PayoutStatus = "failed"
-	PayoutStatusPending    PayoutStatus = "pending"
-	PayoutStatusProcessing PayoutStatus = "processing"
-)
-
 // Defines values for PayoutListRequestSortOrder.
 const (
 	PayoutListRequestSortOrderAsc  PayoutListRequestSortOrder = "asc"
 	PayoutListRequestSortOrderDesc PayoutListRequestSortOrder = "desc"
 )
 
-// Defines values for UpdatePayoutRequestRecordsStatus.
-const (
-	UpdatePayoutRequestRecordsStatusCompleted  UpdatePayoutRequestRecordsStatus = "completed"
-	UpdatePayoutRequestRecordsStatusFailed     UpdatePayoutRequestRecordsStatus = "failed"
-	UpdatePayoutRequestRecordsStatusPending    UpdatePayoutRequestRecordsStatus = "pending"
-	UpdatePayoutRequestRecordsStatusProcessing UpdatePayoutRequestRecordsStatus = "processing"
-)
-
-// Defines values for UpdatePayoutRequestStatus.
-const (
-	UpdatePayoutRequestStatusCompleted  UpdatePayoutRequestStatus = "completed"
-	UpdatePayoutRequestStatusFailed     UpdatePayoutRequestStatus = "failed"
-	UpdatePayoutRequestStatusPending    UpdatePayoutRequestStatus = "pending"
-	UpdatePayoutRequestStatusProcessing UpdatePayoutRequestStatus = "processing"
-)
-
 // Defines values for UserListRequestSortOrder.
 const (
 	UserListRequestSortOrderAsc  UserListRequestSortOrder = "asc"
@@ -107,15 +75,79 @@ type BadRequestError struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// BatchJobRun defines model for BatchJobRun.
+type BatchJobRun struct {
+	CommandName  string     `json:"command_name"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ErrorSummary *string    `json:"error_summary"`
+	FailedCount  int        `json:"failed_count"`
+	FinishedAt   *time.Time `json:"finished_at"`
+
+	// Flags Flags the command was run with
+	Flags          map[string]string `json:"flags"`
+	Id             int               `json:"id"`
+	ProcessedCount int               `json:"processed_count"`
+	StartedAt      time.Time         `json:"started_at"`
+
+	// Status 1:実行中, 2:成功, 3:一部失敗, 4:失敗
+	Status    int       `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BatchJobRunListRequest defines model for BatchJobRunListRequest.
+type BatchJobRunListRequest struct {
+	CommandName    string `json:"command_name" query:"command_name" validate:"omitempty"`
+	Page           int    `json:"page" query:"page"`
+	PageSize       int    `json:"page_size" query:"page_size" validate:"omitempty,min=1,max=100"`
+	SortField      string `json:"sort_field" query:"sort_field" validate:"omitempty"`
+	SortOrder      string `json:"sort_order" query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	StartedAtEnd   string `json:"started_at_end" query:"started_at_end" validate:"omitempty"`
+	StartedAtStart string `json:"started_at_start" query:"started_at_start" validate:"omitempty"`
+
+	// Status 1:実行中, 2:成功, 3:一部失敗, 4:失敗
+	Status []int `json:"status" query:"status" validate:"omitempty"`
+}
+
+// BatchJobRunListResponse defines model for BatchJobRunListResponse.
+type BatchJobRunListResponse struct {
+	BatchJobRuns []BatchJobRun `json:"batch_job_runs"`
+	Page         int           `json:"page"`
+	PageSize     int           `json:"page_size"`
+	Total        int           `json:"total"`
+}
+
+// CreateMerchantRequest defines model for CreateMerchantRequest.
+type CreateMerchantRequest struct {
+	MerchantName string `json:"merchant_name" validate:"required,max=255"`
+
+	// PaymentMerchantId Merchant ID issued by the payment provider; unique per payment provider
+	PaymentMerchantId string `json:"payment_merchant_id" validate:"required,max=255"`
+	PaymentProviderId int    `json:"payment_provider_id" validate:"required,min=1"`
+
+	// ShopId Makeshop shop ID
+	ShopId  int    `json:"shop_id" validate:"required,min=1"`
+	ShopUrl string `json:"shop_url" validate:"omitempty,url,max=255"`
+}
+
 // CreatePayoutRequest defines model for CreatePayoutRequest.
 type CreatePayoutRequest struct {
-	MerchantId        int `json:"merchant_id"`
-	PaymentProviderId int `json:"payment_provider_id"`
-	Records           *[]struct {
-		Amount        *float32 `json:"amount,omitempty"`
-		TransactionId *string  `json:"transaction_id,omitempty"`
-	} `json:"records,omitempty"`
-	TotalAmount float32 `json:"total_amount"`
+	Records []struct {
+		AccountName string  `json:"account_name" validate:"required,max=255"`
+		AccountNo   string  `json:"account_no" validate:"required,max=7,numeric"`
+		Amount      float64 `json:"amount" validate:"required,gt=0"`
+
+		// BankAccountType 1:普通預金, 2:当座預金（定期預金は振込先にできません）
+		BankAccountType int    `json:"bank_account_type" validate:"required,oneof=1 2"`
+		BankCode        string `json:"bank_code" validate:"required,len=4,numeric"`
+		BankName        string `json:"bank_name" validate:"required,max=255"`
+		BranchCode      string `json:"branch_code" validate:"required,len=3,numeric"`
+		BranchName      string `json:"branch_name" validate:"required,max=255"`
+		ShopId          int    `json:"shop_id" validate:"required,min=1"`
+		TransactionId   int    `json:"transaction_id" validate:"required,min=1"`
+	} `json:"records" validate:"required,min=1,dive"`
+
+	// SendingDate Scheduled transfer date (YYYY-MM-DD)
+	SendingDate string `json:"sending_date" validate:"required,datetime=2006-01-02"`
 }
 
 // CreateRoleRequest defines model for CreateRoleRequest.
@@ -153,6 +185,61 @@ type InternalServerError struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// Invoice defines model for Invoice.
+type Invoice struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	CutoffDate *time.Time `json:"cutoff_date"`
+
+	// Duplicate The invoice is the duplicate (控え) kept by the issuer
+	Duplicate            bool       `json:"duplicate"`
+	FileName             string     `json:"file_name"`
+	Id                   int        `json:"id"`
+	IssueDate            *time.Time `json:"issue_date"`
+	MerchantBusinessName string     `json:"merchant_business_name"`
+	PayinFileId          int        `json:"payin_file_id"`
+	PaymentMerchantId    string     `json:"payment_merchant_id"`
+
+	// PaypayPayinDetailId Payin detail of the merchant and cutoff date the invoice is linked to
+	PaypayPayinDetailId *int `json:"paypay_payin_detail_id"`
+
+	// PaypayPayinSummaryId Payin summary the invoice is linked to
+	PaypayPayinSummaryId *int `json:"paypay_payin_summary_id"`
+
+	// RegistrationNumber Registration number of the issuer (T followed by 13 digits), empty when it cannot be read from the PDF
+	RegistrationNumber string    `json:"registration_number"`
+	TaxAmount          *float64  `json:"tax_amount"`
+	TaxableAmount      *float64  `json:"taxable_amount"`
+	TotalAmount        *float64  `json:"total_amount"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// InvoiceListRequest defines model for InvoiceListRequest.
+type InvoiceListRequest struct {
+	CutoffDate string `json:"cutoff_date" query:"cutoff_date" validate:"omitempty"`
+
+	// Duplicate true: 控えのみ, false: 控え以外のみ
+	Duplicate      string `json:"duplicate" query:"duplicate" validate:"omitempty,oneof=true false"`
+	IssueDateEnd   string `json:"issue_date_end" query:"issue_date_end" validate:"omitempty"`
+	IssueDateStart string `json:"issue_date_start" query:"issue_date_start" validate:"omitempty"`
+
+	// MerchantBusinessName Partial match on the merchant name
+	MerchantBusinessName string `json:"merchant_business_name" query:"merchant_business_name" validate:"omitempty"`
+	Page                 int    `json:"page" query:"page"`
+	PageSize             int    `json:"page_size" query:"page_size" validate:"omitempty,min=1,max=100"`
+	PaymentMerchantId    string `json:"payment_merchant_id" query:"payment_merchant_id" validate:"omitempty"`
+	RegistrationNumber   string `json:"registration_number" query:"registration_number" validate:"omitempty"`
+	SortField            string `json:"sort_field" query:"sort_field" validate:"omitempty"`
+	SortOrder            string `json:"sort_order" query:"sort_order" validate:"omitempty,oneof=asc desc"`
+}
+
+// InvoiceListResponse defines model for InvoiceListResponse.
+type InvoiceListResponse struct {
+	Invoices []Invoice `json:"invoices"`
+	Page     int       `json:"page"`
+	PageSize int       `json:"page_size"`
+	Total    int       `json:"total"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	// Email User's email address
@@ -170,14 +257,75 @@ type LoginResponse struct {
 
 // Merchant defines model for Merchant.
 type Merchant struct {
-	CreatedAt         time.Time `json:"created_at"`
-	Id                int       `json:"id"`
-	MerchantName      string    `json:"merchant_name"`
-	PaymentMerchantId string    `json:"payment_merchant_id"`
-	PaymentProviderId int       `json:"payment_provider_id"`
-	ShopId            int       `json:"shop_id"`
-	ShopUrl           string    `json:"shop_url"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	Id           int       `json:"id"`
+	MerchantName string    `json:"merchant_name"`
+
+	// MerchantPaymentProviderReview The latest review of the merchant by the payment provider
+	MerchantPaymentProviderReview *PaymentProviderReview `json:"merchant_payment_provider_review"`
+	PaymentMerchantId             string                 `json:"payment_merchant_id"`
+	PaymentProviderId             int                    `json:"payment_provider_id"`
+	ShopId                        int                    `json:"shop_id"`
+	ShopUrl                       string                 `json:"shop_url"`
+	UpdatedAt                     time.Time              `json:"updated_at"`
+}
+
+// PaymentProviderReview The latest review of the merchant by the payment provider
+type PaymentProviderReview struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Id         int       `json:"id"`
+	MerchantId int       `json:"merchant_id"`
+
+	// MerchantReviewStatus 1:審査中, 2:審査通過, 3:審査否認
+	MerchantReviewStatus int       `json:"merchant_review_status"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+// MerchantBankAccount defines model for MerchantBankAccount.
+type MerchantBankAccount struct {
+	AccountName string `json:"account_name"`
+	AccountNo   string `json:"account_no"`
+
+	// BankAccountType 1:普通預金, 2:当座預金, 3:定期預金
+	BankAccountType int    `json:"bank_account_type"`
+	BankCode        string `json:"bank_code"`
+	BankName        string `json:"bank_name"`
+	BranchCode      string `json:"branch_code"`
+	BranchName      string `json:"branch_name"`
+
+	// ChangeType 1:登録, 2:変更, 3:削除
+	ChangeType int       `json:"change_type"`
+	CreatedAt  time.Time `json:"created_at"`
+	Id         int       `json:"id"`
+	MerchantId int       `json:"merchant_id"`
+
+	// PreviousBankAccountId The account replaced or deleted by this change
+	PreviousBankAccountId *int `json:"previous_bank_account_id"`
+
+	// RequestedBy ID of the user who requested the change
+	RequestedBy *int       `json:"requested_by"`
+	ReviewedAt  *time.Time `json:"reviewed_at"`
+
+	// ReviewedBy ID of the user who confirmed or rejected the change
+	ReviewedBy *int `json:"reviewed_by"`
+
+	// Status 1:確認待ち, 2:有効, 3:却下, 4:無効
+	Status    int       `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MerchantBankAccountRequest defines model for MerchantBankAccountRequest.
+type MerchantBankAccountRequest struct {
+	// AccountName Account holder name using only characters allowed in Zengin transfer files (half-width katakana, upper-case letters, digits and a few symbols), up to 30 characters
+	AccountName string `json:"account_name" validate:"required,max=30"`
+	AccountNo   string `json:"account_no" validate:"required,max=7,numeric"`
+
+	// BankAccountType 1:普通預金, 2:当座預金（定期預金は振込先にできません）
+	BankAccountType int    `json:"bank_account_type" validate:"required,oneof=1 2"`
+	BankCode        string `json:"bank_code" validate:"required,len=4,numeric"`
+	BankName        string `json:"bank_name" validate:"required,max=255"`
+	BranchCode      string `json:"branch_code" validate:"required,len=3,numeric"`
+	BranchName      string `json:"branch_name" validate:"required,max=255"`
 }
 
 // MerchantListRequest defines model for MerchantListRequest.
@@ -200,6 +348,32 @@ type MerchantListResponse struct {
 	Total     int        `json:"total"`
 }
 
+// MerchantReviewStatusUploadResult defines model for MerchantReviewStatusUploadResult.
+type MerchantReviewStatusUploadResult struct {
+	// Applied Whether the review statuses were recorded. Nothing is recorded unless every row is valid
+	Applied   bool                            `json:"applied"`
+	ErrorRows int                             `json:"error_rows"`
+	Rows      []MerchantReviewStatusUploadRow `json:"rows"`
+	TotalRows int                             `json:"total_rows"`
+	ValidRows int                             `json:"valid_rows"`
+}
+
+// MerchantReviewStatusUploadRow defines model for .
+type MerchantReviewStatusUploadRow struct {
+	Errors       []string `json:"errors"`
+	MerchantId   *int     `json:"merchant_id"`
+	MerchantName *string  `json:"merchant_name"`
+
+	// MerchantReviewStatus 1:審査中, 2:審査通過, 3:審査否認
+	MerchantReviewStatus *int `json:"merchant_review_status"`
+
+	// PreviousMerchantReviewStatus Latest review status before the upload. 1:審査中, 2:審査通過, 3:審査否認
+	PreviousMerchantReviewStatus *int `json:"previous_merchant_review_status"`
+
+	// RowNumber Line number in the uploaded file
+	RowNumber int `json:"row_number"`
+}
+
 // MfaType defines model for MfaType.
 type MfaType struct {
 	Id       *int    `json:"id,omitempty"`
@@ -217,6 +391,63 @@ type NotFoundError struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// PayinFile defines model for PayinFile.
+type PayinFile struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DownloadStatus 0:未処理, 1:成功, 2:失敗
+	DownloadStatus int `json:"download_status"`
+
+	// Encoding 取り込み時に判定した文字コード（UTF-8 / Shift_JIS）
+	Encoding       *string `json:"encoding"`
+	FileContentKey string  `json:"file_content_key"`
+	FileName       string  `json:"file_name"`
+	FileSize       *int64  `json:"file_size"`
+	Id             int     `json:"id"`
+
+	// ImportStatus 0:未処理, 1:成功, 2:失敗
+	ImportStatus      int       `json:"import_status"`
+	PayinFileGroupId  *int      `json:"payin_file_group_id"`
+	PayinFileType     int       `json:"payin_file_type"`
+	PaymentProviderId int       `json:"payment_provider_id"`
+	Sha256Checksum    *string   `json:"sha256_checksum"`
+	UpdatedAt         time.Time `json:"updated_at"`
+
+	// UploadStatus 0:未処理, 1:成功, 2:失敗
+	UploadStatus int `json:"upload_status"`
+}
+
+// PayinImportError defines model for PayinImportError.
+type PayinImportError struct {
+	// ColumnName 項目名（正規化後のヘッダー）
+	ColumnName string    `json:"column_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	Id         int       `json:"id"`
+
+	// LineNumber CSVの行番号（1始まり）
+	LineNumber  int `json:"line_number"`
+	PayinFileId int `json:"payin_file_id"`
+
+	// Policy 適用した方針 1:ファイルを取り込まない, 2:行をスキップ, 3:警告として取り込む
+	Policy int `json:"policy"`
+
+	// RawValue 取り込めなかった値
+	RawValue string `json:"raw_value"`
+	Reason   string `json:"reason"`
+}
+
+// PaymentMethodTotal defines model for PaymentMethodTotal.
+type PaymentMethodTotal struct {
+	// Amount 支払い方法ごとの支払い金額の合計
+	Amount float64 `json:"amount"`
+
+	// PaymentMethod 支払い方法 1:PayPay（残高）, 2:クレジットカード, 3:Yahoo!マネー廃⽌, 4:Alipay, 5:あと払い（一括のみ）, 6:プリペイドコード, 7:LinePay, 8:PayPay（クレジット）, 9:PayPay商品券, 10:PayPayポイント, 11:PayPay銀行残高
+	PaymentMethod int `json:"payment_method"`
+
+	// TransactionCount 支払い方法を利用した取引の件数
+	TransactionCount int `json:"transaction_count"`
+}
+
 // PaymentProvider defines model for PaymentProvider.
 type PaymentProvider struct {
 	Code      *string    `json:"code,omitempty"`
@@ -229,32 +460,66 @@ type PaymentProvider struct {
 
 // Payout defines model for Payout.
 type Payout struct {
-	CreatedAt         *time.Time       `json:"created_at,omitempty"`
-	Id                *int             `json:"id,omitempty"`
-	Merchant          *Merchant        `json:"merchant,omitempty"`
-	MerchantId        *int             `json:"merchant_id,omitempty"`
-	PaymentProvider   *PaymentProvider `json:"payment_provider,omitempty"`
-	PaymentProviderId *int             `json:"payment_provider_id,omitempty"`
-	Records           *[]struct {
-		Amount        *float32             `json:"amount,omitempty"`
-		CreatedAt     *time.Time           `json:"created_at,omitempty"`
-		Id            *int                 `json:"id,omitempty"`
-		PayoutId      *int                 `json:"payout_id,omitempty"`
-		Status        *PayoutRecordsStatus `json:"status,omitempty"`
-		TransactionId *string              `json:"transaction_id,omitempty"`
-		UpdatedAt     *time.Time           `json:"updated_at,omitempty"`
+	AozoraTransferApplyNo *string `json:"aozora_transfer_apply_no,omitempty"`
+	Approval              *struct {
+		// ApprovalStatus 1:承認待ち, 2:承認中, 3:承認済み, 4:却下
+		ApprovalStatus     *int `json:"approval_status,omitempty"`
+		ApprovalWorkflowId *int `json:"approval_workflow_id,omitempty"`
+		Id                 *int `json:"id,omitempty"`
+
+		// RequestedBy 承認申請したユーザーID
+		RequestedBy *int `json:"requested_by"`
+		Stages      *[]struct {
+			// ApprovalResult 1:承認, 2:却下
+			ApprovalResult          *int       `json:"approval_result,omitempty"`
+			ApprovalWorkflowStageId *int       `json:"approval_workflow_stage_id,omitempty"`
+			ApproverId              *int       `json:"approver_id,omitempty"`
+			CreatedAt               *time.Time `json:"created_at,omitempty"`
+			Id                      *int       `json:"id,omitempty"`
+		} `json:"stages,omitempty"`
+	} `json:"approval"`
+
+	// ApprovalStatus 1:承認待ち, 2:承認中, 3:承認済み, 4:却下
+	ApprovalStatus        *int       `json:"approval_status"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	Id                    *string    `json:"id,omitempty"`
+	PayoutIssuer          *string    `json:"payout_issuer,omitempty"`
+	PayoutRecordCount     *int       `json:"payout_record_count,omitempty"`
+	PayoutRecordSumAmount *float64   `json:"payout_record_sum_amount,omitempty"`
+
+	// PayoutStatus 1:ドラフト, 2:振込データ作成済み, 3:送金手続き済み, 4:振込失敗あり
+	PayoutStatus *int `json:"payout_status,omitempty"`
+	Records      *[]struct {
+		AccountName *string  `json:"account_name,omitempty"`
+		AccountNo   *string  `json:"account_no,omitempty"`
+		Amount      *float64 `json:"amount,omitempty"`
+
+		// BankAccountType 1:普通預金, 2:当座預金, 3:定期預金
+		BankAccountType      *int       `json:"bank_account_type,omitempty"`
+		BankCode             *string    `json:"bank_code,omitempty"`
+		BankName             *string    `json:"bank_name,omitempty"`
+		BranchCode           *string    `json:"branch_code,omitempty"`
+		BranchName           *string    `json:"branch_name,omitempty"`
+		CreatedAt            *time.Time `json:"created_at,omitempty"`
+		Id                   *int       `json:"id,omitempty"`
+		PayoutId             *int       `json:"payout_id,omitempty"`
+		ShopId               *int       `json:"shop_id,omitempty"`
+		TransactionId        *int       `json:"transaction_id,omitempty"`
+		TransferExecutedAt   *time.Time `json:"transfer_executed_at,omitempty"`
+		TransferRequestError *string    `json:"transfer_request_error,omitempty"`
+		TransferRequestedAt  *time.Time `json:"transfer_requested_at,omitempty"`
+
+		// TransferStatus 1:振込中, 2:ホワイトリスト追加エラー, 3:振込依頼APIエラー, 4:振込依頼失敗, 5:振込依頼済み, 6:送金手続き済み, 7:未振込
+		TransferStatus *int       `json:"transfer_status,omitempty"`
+		UpdatedAt      *time.Time `json:"updated_at,omitempty"`
 	} `json:"records,omitempty"`
-	Status      *PayoutStatus `json:"status,omitempty"`
-	TotalAmount *float32      `json:"total_amount,omitempty"`
-	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
+	SendingDate *time.Time `json:"sending_date,omitempty"`
+	SentDate    *time.Time `json:"sent_date,omitempty"`
+	Total       *float64   `json:"total,omitempty"`
+	TotalCount  *int       `json:"total_count,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
-// PayoutRecordsStatus defines model for Payout.Records.Status.
-type PayoutRecordsStatus string
-
-// PayoutStatus defines model for Payout.Status.
-type PayoutStatus string
-
 // PayoutListRequest defines model for PayoutListRequest.
 type PayoutListRequest struct {
 	// CreatedAt Filter by creation date
@@ -354,27 +619,39 @@ type UnauthorizedError struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
-// UpdatePayoutRequest defines model for UpdatePayoutRequest.
-type UpdatePayoutRequest struct {
-	MerchantId        *int `json:"merchant_id,omitempty"`
-	PaymentProviderId *int `json:"payment_provider_id,omitempty"`
-	Records           *[]struct {
-		Amount *float32 `json:"amount,omitempty"`
-
-		// Id ID of existing record (for updates)
-		Id            *int                              `json:"id,omitempty"`
-		Status        *UpdatePayoutRequestRecordsStatus `json:"status,omitempty"`
-		TransactionId *string                           `json:"transaction_id,omitempty"`
-	} `json:"records,omitempty"`
-	Status      *UpdatePayoutRequestStatus `json:"status,omitempty"`
-	TotalAmount *float32                   `json:"total_amount,omitempty"`
+// UpdateMerchantRequest defines model for UpdateMerchantRequest.
+type UpdateMerchantRequest struct {
+	MerchantName string `json:"merchant_name" validate:"required,max=255"`
+
+	// PaymentMerchantId Merchant ID issued by the payment provider; unique per payment provider
+	PaymentMerchantId string `json:"payment_merchant_id" validate:"required,max=255"`
+	PaymentProviderId int    `json:"payment_provider_id" validate:"required,min=1"`
+
+	// ShopId Makeshop shop ID
+	ShopId  int    `json:"shop_id" validate:"required,min=1"`
+	ShopUrl string `json:"shop_url" validate:"omitempty,url,max=255"`
 }
 
-// UpdatePayoutRequestRecordsStatus defines model for UpdatePayoutRequest.Records.Status.
-type UpdatePayoutRequestRecordsStatus string
+// UpdatePayoutRequest Replaces the sending date and all records of a draft payout
+type UpdatePayoutRequest struct {
+	Records []struct {
+		AccountName string  `json:"account_name" validate:"required,max=255"`
+		AccountNo   string  `json:"account_no" validate:"required,max=7,numeric"`
+		Amount      float64 `json:"amount" validate:"required,gt=0"`
+
+		// BankAccountType 1:普通預金, 2:当座預金（定期預金は振込先にできません）
+		BankAccountType int    `json:"bank_account_type" validate:"required,oneof=1 2"`
+		BankCode        string `json:"bank_code" validate:"required,len=4,numeric"`
+		BankName        string `json:"bank_name" validate:"required,max=255"`
+		BranchCode      string `json:"branch_code" validate:"required,len=3,numeric"`
+		BranchName      string `json:"branch_name" validate:"required,max=255"`
+		ShopId          int    `json:"shop_id" validate:"required,min=1"`
+		TransactionId   int    `json:"transaction_id" validate:"required,min=1"`
+	} `json:"records" validate:"required,min=1,dive"`
 
-// UpdatePayoutRequestStatus defines model for UpdatePayoutRequest.Status.
-type UpdatePayoutRequestStatus string
+	// SendingDate Scheduled transfer date (YYYY-MM-DD)
+	SendingDate string `json:"sending_date" validate:"required,datetime=2006-01-02"`
+}
 
 // UpdateRoleRequest defines model for UpdateRoleRequest.
 type UpdateRoleRequest struct {
@@ -442,6 +719,12 @@ type VerifyMFAResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// UploadMerchantReviewStatusesMultipartBody defines parameters for UploadMerchantReviewStatuses.
+type UploadMerchantReviewStatusesMultipartBody struct {
+	// File CSV file, up to 5MB and 10,000 rows
+	File openapi_types.File `json:"file"`
+}
+
 // ListPaymentProvidersParams defines parameters for ListPaymentProviders.
 type ListPaymentProvidersParams struct {
 	// IsActive Filter by active status
@@ -451,9 +734,30 @@ type ListPaymentProvidersParams struct {
 // ListAuditLogsJSONRequestBody defines body for ListAuditLogs for application/json ContentType.
 type ListAuditLogsJSONRequestBody = AuditLogListRequest
 
+// ListBatchJobRunsJSONRequestBody defines body for ListBatchJobRuns for application/json ContentType.
+type ListBatchJobRunsJSONRequestBody = BatchJobRunListRequest
+
+// ListInvoicesJSONRequestBody defines body for ListInvoices for application/json ContentType.
+type ListInvoicesJSONRequestBody = InvoiceListRequest
+
 // ListMerchantsJSONRequestBody defines body for ListMerchants for application/json ContentType.
 type ListMerchantsJSONRequestBody = MerchantListRequest
 
+// CreateMerchantJSONRequestBody defines body for CreateMerchant for application/json ContentType.
+type CreateMerchantJSONRequestBody = CreateMerchantRequest
+
+// UploadMerchantReviewStatusesMultipartRequestBody defines body for UploadMerchantReviewStatuses for multipart/form-data ContentType.
+type UploadMerchantReviewStatusesMultipartRequestBody UploadMerchantReviewStatusesMultipartBody
+
+// CreateMerchantBankAccountJSONRequestBody defines body for CreateMerchantBankAccount for application/json ContentType.
+type CreateMerchantBankAccountJSONRequestBody = MerchantBankAccountRequest
+
+// UpdateMerchantBankAccountJSONRequestBody defines body for UpdateMerchantBankAccount for application/json ContentType.
+type UpdateMerchantBankAccountJSONRequestBody = MerchantBankAccountRequest
+
+// UpdateMerchantJSONRequestBody defines body for UpdateMerchant for application/json ContentType.
+type UpdateMerchantJSONRequestBody = UpdateMerchantRequest
+
 // ListPayoutsJSONRequestBody defines body for ListPayouts for application/json ContentType.
 type ListPayoutsJSONRequestBody = PayoutListRequest
 
@@ -492,12 +796,69 @@ type ServerInterface interface {
 	// List audit logs
 	// (GET /admin/audit-logs)
 	ListAuditLogs(ctx echo.Context) error
+	// List batch job runs
+	// (GET /admin/batch-job-runs)
+	ListBatchJobRuns(ctx echo.Context) error
+	// Get batch job run details
+	// (GET /admin/batch-job-runs/{id})
+	GetBatchJobRun(ctx echo.Context, id int) error
+	// List payin files of a batch job run
+	// (GET /admin/batch-job-runs/{id}/payin-files)
+	ListBatchJobRunPayinFiles(ctx echo.Context, id int) error
+	// List qualified invoices
+	// (GET /admin/invoices)
+	ListInvoices(ctx echo.Context) error
+	// Get qualified invoice details
+	// (GET /admin/invoices/{id})
+	GetInvoice(ctx echo.Context, id int) error
+	// Download qualified invoice
+	// (GET /admin/invoices/{id}/download)
+	DownloadInvoice(ctx echo.Context, id int) error
 	// List merchants
 	// (GET /admin/merchants)
 	ListMerchants(ctx echo.Context) error
+	// Create new merchant
+	// (POST /admin/merchants/create)
+	CreateMerchant(ctx echo.Context) error
+	// Upload merchant review statuses
+	// (POST /admin/merchants/review-statuses/upload)
+	UploadMerchantReviewStatuses(ctx echo.Context) error
 	// Get merchant details
 	// (GET /admin/merchants/{id})
 	GetMerchant(ctx echo.Context, id int) error
+	// List merchant bank accounts
+	// (GET /admin/merchants/{id}/bank-accounts)
+	ListMerchantBankAccounts(ctx echo.Context, id int) error
+	// Request merchant bank account registration
+	// (POST /admin/merchants/{id}/bank-accounts)
+	CreateMerchantBankAccount(ctx echo.Context, id int) error
+	// Request merchant bank account deletion
+	// (DELETE /admin/merchants/{id}/bank-accounts/{accountId})
+	DeleteMerchantBankAccount(ctx echo.Context, id int, accountId int) error
+	// Get merchant bank account
+	// (GET /admin/merchants/{id}/bank-accounts/{accountId})
+	GetMerchantBankAccount(ctx echo.Context, id int, accountId int) error
+	// Request merchant bank account change
+	// (PUT /admin/merchants/{id}/bank-accounts/{accountId})
+	UpdateMerchantBankAccount(ctx echo.Context, id int, accountId int) error
+	// Confirm merchant bank account change
+	// (POST /admin/merchants/{id}/bank-accounts/{accountId}/confirm)
+	ConfirmMerchantBankAccount(ctx echo.Context, id int, accountId int) error
+	// Reject merchant bank account change
+	// (POST /admin/merchants/{id}/bank-accounts/{accountId}/reject)
+	RejectMerchantBankAccount(ctx echo.Context, id int, accountId int) error
+	// Delete merchant
+	// (DELETE /admin/merchants/{id}/delete)
+	DeleteMerchant(ctx echo.Context, id int) error
+	// Update merchant
+	// (PUT /admin/merchants/{id}/update)
+	UpdateMerchant(ctx echo.Context, id int) error
+	// List import errors of a payin file
+	// (GET /admin/payin-files/{id}/import-errors)
+	ListPayinFileImportErrors(ctx echo.Context, id int) error
+	// Get payment method totals of a payin file
+	// (GET /admin/payin-files/{id}/payment-method-totals)
+	GetPayinFilePaymentMethodTotals(ctx echo.Context, id int) error
 	// List payment providers
 	// (GET /admin/payment-providers)
 	ListPaymentProviders(ctx echo.Context, params ListPaymentProvidersParams) error
@@ -510,12 +871,24 @@ type ServerInterface interface {
 	// Get payout details
 	// (GET /admin/payouts/{id})
 	GetPayout(ctx echo.Context, id int) error
+	// Approve payout
+	// (POST /admin/payouts/{id}/approve)
+	ApprovePayout(ctx echo.Context, id int) error
 	// Delete payout
 	// (DELETE /admin/payouts/{id}/delete)
 	DeletePayout(ctx echo.Context, id int) error
+	// Reject payout
+	// (POST /admin/payouts/{id}/reject)
+	RejectPayout(ctx echo.Context, id int) error
+	// Submit payout for approval
+	// (POST /admin/payouts/{id}/submit)
+	SubmitPayout(ctx echo.Context, id int) error
 	// Update payout
 	// (PUT /admin/payouts/{id}/update)
 	UpdatePayout(ctx echo.Context, id int) error
+	// Download Zengin transfer file
+	// (GET /admin/payouts/{id}/zengin-file)
+	DownloadPayoutZenginFile(ctx echo.Context, id int) error
 	// List permissions
 	// (GET /admin/permissions)
 	ListPermissions(ctx echo.Context) error
@@ -582,6 +955,100 @@ func (w *ServerInterfaceWrapper) ListAuditLogs(ctx echo.Context) error {
 	return err
 }
 
+// ListBatchJobRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBatchJobRuns(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBatchJobRuns(ctx)
+	return err
+}
+
+// GetBatchJobRun converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBatchJobRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBatchJobRun(ctx, id)
+	return err
+}
+
+// ListBatchJobRunPayinFiles converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBatchJobRunPayinFiles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBatchJobRunPayinFiles(ctx, id)
+	return err
+}
+
+// ListInvoices converts echo context to params.
+func (w *ServerInterfaceWrapper) ListInvoices(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListInvoices(ctx)
+	return err
+}
+
+// GetInvoice converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInvoice(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInvoice(ctx, id)
+	return err
+}
+
+// DownloadInvoice converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadInvoice(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadInvoice(ctx, id)
+	return err
+}
+
 // ListMerchants converts echo context to params.
 func (w *ServerInterfaceWrapper) ListMerchants(ctx echo.Context) error {
 	var err error
@@ -593,6 +1060,28 @@ func (w *ServerInterfaceWrapper) ListMerchants(ctx echo.Context) error {
 	return err
 }
 
+// CreateMerchant converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateMerchant(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateMerchant(ctx)
+	return err
+}
+
+// UploadMerchantReviewStatuses converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadMerchantReviewStatuses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadMerchantReviewStatuses(ctx)
+	return err
+}
+
 // GetMerchant converts echo context to params.
 func (w *ServerInterfaceWrapper) GetMerchant(ctx echo.Context) error {
 	var err error
@@ -611,6 +1100,244 @@ func (w *ServerInterfaceWrapper) GetMerchant(ctx echo.Context) error {
 	return err
 }
 
+// ListMerchantBankAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMerchantBankAccounts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMerchantBankAccounts(ctx, id)
+	return err
+}
+
+// CreateMerchantBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateMerchantBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateMerchantBankAccount(ctx, id)
+	return err
+}
+
+// DeleteMerchantBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMerchantBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMerchantBankAccount(ctx, id, accountId)
+	return err
+}
+
+// GetMerchantBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMerchantBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMerchantBankAccount(ctx, id, accountId)
+	return err
+}
+
+// UpdateMerchantBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMerchantBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMerchantBankAccount(ctx, id, accountId)
+	return err
+}
+
+// ConfirmMerchantBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmMerchantBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmMerchantBankAccount(ctx, id, accountId)
+	return err
+}
+
+// RejectMerchantBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectMerchantBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectMerchantBankAccount(ctx, id, accountId)
+	return err
+}
+
+// DeleteMerchant converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMerchant(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMerchant(ctx, id)
+	return err
+}
+
+// UpdateMerchant converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMerchant(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMerchant(ctx, id)
+	return err
+}
+
+// ListPayinFileImportErrors converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPayinFileImportErrors(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPayinFileImportErrors(ctx, id)
+	return err
+}
+
+// GetPayinFilePaymentMethodTotals converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPayinFilePaymentMethodTotals(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPayinFilePaymentMethodTotals(ctx, id)
+	return err
+}
+
 // ListPaymentProviders converts echo context to params.
 func (w *ServerInterfaceWrapper) ListPaymentProviders(ctx echo.Context) error {
 	var err error
@@ -671,6 +1398,24 @@ func (w *ServerInterfaceWrapper) GetPayout(ctx echo.Context) error {
 	return err
 }
 
+// ApprovePayout converts echo context to params.
+func (w *ServerInterfaceWrapper) ApprovePayout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApprovePayout(ctx, id)
+	return err
+}
+
 // DeletePayout converts echo context to params.
 func (w *ServerInterfaceWrapper) DeletePayout(ctx echo.Context) error {
 	var err error
@@ -689,6 +1434,42 @@ func (w *ServerInterfaceWrapper) DeletePayout(ctx echo.Context) error {
 	return err
 }
 
+// RejectPayout converts echo context to params.
+func (w *ServerInterfaceWrapper) RejectPayout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RejectPayout(ctx, id)
+	return err
+}
+
+// SubmitPayout converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitPayout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitPayout(ctx, id)
+	return err
+}
+
 // UpdatePayout converts echo context to params.
 func (w *ServerInterfaceWrapper) UpdatePayout(ctx echo.Context) error {
 	var err error
@@ -707,6 +1488,24 @@ func (w *ServerInterfaceWrapper) UpdatePayout(ctx echo.Context) error {
 	return err
 }
 
+// DownloadPayoutZenginFile converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadPayoutZenginFile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadPayoutZenginFile(ctx, id)
+	return err
+}
+
 // ListPermissions converts echo context to params.
 func (w *ServerInterfaceWrapper) ListPermissions(ctx echo.Context) error {
 	var err error
@@ -948,14 +1747,37 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/admin/audit-logs", wrapper.ListAuditLogs)
+	router.GET(baseURL+"/admin/batch-job-runs", wrapper.ListBatchJobRuns)
+	router.GET(baseURL+"/admin/batch-job-runs/:id", wrapper.GetBatchJobRun)
+	router.GET(baseURL+"/admin/batch-job-runs/:id/payin-files", wrapper.ListBatchJobRunPayinFiles)
+	router.GET(baseURL+"/admin/invoices", wrapper.ListInvoices)
+	router.GET(baseURL+"/admin/invoices/:id", wrapper.GetInvoice)
+	router.GET(baseURL+"/admin/invoices/:id/download", wrapper.DownloadInvoice)
 	router.GET(baseURL+"/admin/merchants", wrapper.ListMerchants)
+	router.POST(baseURL+"/admin/merchants/create", wrapper.CreateMerchant)
+	router.POST(baseURL+"/admin/merchants/review-statuses/upload", wrapper.UploadMerchantReviewStatuses)
 	router.GET(baseURL+"/admin/merchants/:id", wrapper.GetMerchant)
+	router.GET(baseURL+"/admin/merchants/:id/bank-accounts", wrapper.ListMerchantBankAccounts)
+	router.POST(baseURL+"/admin/merchants/:id/bank-accounts", wrapper.CreateMerchantBankAccount)
+	router.DELETE(baseURL+"/admin/merchants/:id/bank-accounts/:accountId", wrapper.DeleteMerchantBankAccount)
+	router.GET(baseURL+"/admin/merchants/:id/bank-accounts/:accountId", wrapper.GetMerchantBankAccount)
+	router.PUT(baseURL+"/admin/merchants/:id/bank-accounts/:accountId", wrapper.UpdateMerchantBankAccount)
+	router.POST(baseURL+"/admin/merchants/:id/bank-accounts/:accountId/confirm", wrapper.ConfirmMerchantBankAccount)
+	router.POST(baseURL+"/admin/merchants/:id/bank-accounts/:accountId/reject", wrapper.RejectMerchantBankAccount)
+	router.DELETE(baseURL+"/admin/merchants/:id/delete", wrapper.DeleteMerchant)
+	router.PUT(baseURL+"/admin/merchants/:id/update", wrapper.UpdateMerchant)
+	router.GET(baseURL+"/admin/payin-files/:id/import-errors", wrapper.ListPayinFileImportErrors)
+	router.GET(baseURL+"/admin/payin-files/:id/payment-method-totals", wrapper.GetPayinFilePaymentMethodTotals)
 	router.GET(baseURL+"/admin/payment-providers", wrapper.ListPaymentProviders)
 	router.GET(baseURL+"/admin/payouts", wrapper.ListPayouts)
 	router.POST(baseURL+"/admin/payouts/create", wrapper.CreatePayout)
 	router.GET(baseURL+"/admin/payouts/:id", wrapper.GetPayout)
+	router.POST(baseURL+"/admin/payouts/:id/approve", wrapper.ApprovePayout)
 	router.DELETE(baseURL+"/admin/payouts/:id/delete", wrapper.DeletePayout)
+	router.POST(baseURL+"/admin/payouts/:id/reject", wrapper.RejectPayout)
+	router.POST(baseURL+"/admin/payouts/:id/submit", wrapper.SubmitPayout)
 	router.PUT(baseURL+"/admin/payouts/:id/update", wrapper.UpdatePayout)
+	router.GET(baseURL+"/admin/payouts/:id/zengin-file", wrapper.DownloadPayoutZenginFile)
 	router.GET(baseURL+"/admin/permissions", wrapper.ListPermissions)
 	router.GET(baseURL+"/admin/roles", wrapper.ListRoles)
 	router.POST(baseURL+"/admin/roles/create", wrapper.CreateRole)
@@ -978,87 +1800,261 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xde2/buJb/KlztAncGcBI7iTMzuRjgpm3SdbdJu0naLm4RCIxFx5yRRA1Fpc0U+e4L",
-	"knqLkin5ISch0D8aSyKPDs/58bx49MOaEi8gPvJZaB3/sMLpHHlQ/PckcjB7T+74/wNKAkQZRuIK5Fds",
-	"l9zZ7CFA1etT4ohf0XfoBS6yji2X3GHfGljyfitkFPt31uPAwk7hxv30FuwzdIcov8eHXmm49+rhHtNf",
-	"yO0faMr4w0VabZ35phRBhhwbMn7vjFCP/89yIEM7DHtI4z1GqnFxYEPHoSgMi28z+m1/d3T06+5od6Qa",
-	"2kNhCO9KHPgUIgoEV0EYTacoDGeRq3o6CpzWLxOFiNrwDvmsOOk5+Ru7Ltwb7w7BT1+w75BvIbi4BqPh",
-	"7vCf4Av2jw7/Cb4fHf4MToLARV/Q7f9gtjc++GX34Kh2nhLnDhVLolrXRDrf45Bdor8iFDIdQV0kkgPr",
-	"+w6BAd7hMnyH/B30nVG4w+CdGPCvCNEH67g88MC6hy7mPOU0epghL2APgvCiMGXT7w/393eGo53h6Ho4",
-	"PBb//t2VmtwcDZQ4KJxSHDBM/CIp+QsdKSgOUUtCUJbjqp5oTihGSoa0Q/x3adzhMgPL8dTvMfCw//tI",
-	"TB0SyuwZRm5RgovL0YmduZEbuCnuItRBtLqeS80sx6x5f+IjMvsdhlMg5nms0ePOK5sMVvvijwOLor8i",
-	"TJFjHX+VkjAorFuBfYU3KixNNlNRfEvKfaNAn1fQiUHnlFJCq8iD1D9Xd8ZXJ2/sy9P//XR6da2CyCpu",
-	"vX4/Ob241tv8lFtHRjuQVCqmjfeUwoMz6IYovfeWEBdBXz3va8Hmj/CBRPXg7CE6nUOflSVnrNo7A/jg",
-	"IZ/ZASX32KmKm3Ijp2hKqCMm4+ITKjYIj0SlXW5/PBwOso1y5pK8HvuRdysHZxT6IZxymSkTY7Hv9mj/",
-	"AN5OD8dHeisV/wAphQ/ib8KgayvIGw91yCvpSJ7Val6WZrypXdRL4qLaJa1aaufQh1L5W8HRLfYdfttx",
-	"9hoD64+Q71xyEgH9iHo4DOUCFGX162iwPzi4GWTrXpWOAssX0BNPXZqxgkWCtAph9czkVlwtM5EHsVvk",
-	"Jkesf8V/7k6JZ+UkQd7eks/xeyXP5hA3ea2BvMbfFPnw1kWO7c1ggSxGoyouaE6cG5JPMYtc165K0Tsy",
-	"98Ebgjq+Xjaq8hVjOyIMvxFaUuQrRol/9zG+Nto/+I88y9NnupGVe1zFeG5oHElVJq6G56I3aTJWHSdK",
-	"Ep1IRo7WPDez0fIrqRL4M0JvseMgf9kN89PFyafr//5wOfn36Ru9HTO5/+R68uFiiY3zkw8jNicU/40c",
-	"AOUGufq9c+IzRH3oXiF6j+iy3JpcXJ9eXpy8t69OLz+fXtqnl5cfLvXYVnp0CcYlrwRC8U5rMztEWGAx",
-	"nhYcIeFJ/yME4ipI/POWCt2Mm3loUc7dFUe6afBNPefCgPihIqDDyJ+o5DSih3fz27dT/AG/m3z6ezK6",
-	"wJNw4l+Op68nR5M/g//7/Prdb7u7u3VuPx/svyiaWcfWf+5lIai9OP60xzmjXuTz2JZRSH/buI0eYuaG",
-	"fdSJ9OiNiuW2k1pm1U3vVP4PXDFCu9JeHP4xZ0jXWd9W4HHb9XDceVOrjv+oYcCPhl05qRpZuMdzElRn",
-	"6ThJMlg6cERLttmcsSA83tvjV3eLNloXPqaTPHYJ4unNkRu2AhsNnoJqicuSnLE/x6+y/51Nf9Og6I0R",
-	"vmxAG/nOJkJsYh6tgJ8dMkg3EvaLZ3oxkTeK7jH6xt+aRVXHb3VuX0Jacb6mkByCdDovLnn8W9eAXPJ0",
-	"cxjQhCGXCQumTC4vtELFSkiwGLnqjKoEMIvxqSajCAaB7ZBpaMMA29l12yMOcu3UMFKEkzTUv41GJwGq",
-	"wn0HY2XmRhWHCuOVKS2QHFPJ0Rm8VqYatfJuoQ2nDN+jHCCkfsTAYpi5ZdNLGUtRmqMXhJ2RyHeWddQu",
-	"PlzbZx8+XWj6tA23a7tlF4QBQfsaXLGP0kb4GBsOOhzgcwfKfOTaErJ5wWgOZynT0Fe1FLfPutYwkUSr",
-	"cHQU/Ng/UHHEyzlXq0GipaL8y5FRlsFVphGWokpkRfiYKqjOGTV+5ImtDMlg+ICLAVdK+Qcf2UVMhMZn",
-	"ELtIbEa5/T93vYooy+UXVivhmhZ+NX5yhl2GKLh9AOIuTHwgDIVB0eI+kBb3etPryRZbpPAjvENAcg3M",
-	"CAUBvMM+jBONSwcR4j20YJovNJ4Lu3yR2gtJKJkBIeggQILiAkM7u9D5nb6DzR8IUcnZ/HWiIG8EqQ23",
-	"CpcnP3Wj+S801ZYX60nkSOFELnJA/MQ6xLZATDPRbCHFcMoiEcD12ZpoZYsJLTg6ZUqR6wBGAL8JUBRG",
-	"LgvB7UOBzu4eURKUyXsQCi+p6g4VqbzixDmYIpE2Bj/BcBqvP6HCj5F//SzyKRL8oXCihI9TQPcu3lX+",
-	"LRLXpyePq7AUJUnNC0NR9W4WbCF1rpYDGaz+msPiyjUXe5hpuEH6jlW42Pyp+lS61XCSTas1UarGibZn",
-	"IQcIAUWMYnTPcS4tUnQfdN0NtSGuNCPShLuOoyFcfAeG81sCqbMqh6O2vC4jjuMTnxw0Tq7juVR9kc98",
-	"3DdN467GYruMNf76GzmD9RqHvgeYotDGRWYcDJVa5M2gokKzxgtPUSfUq0RYOuN0iThAvSYO6rVWoznJ",
-	"qGThaGFERg5ws+Ct61Z5Cn2bivu0lqFWKNRelwexz7cFIZyLHlAuHHE7hoyq+nXieNjHIaOQqXLXqumv",
-	"JKYt3pag42COG9D9mLte4OICwP0QICr9n9TrWzPk5oshTEnHAl4J5H2i5ZC/jLXiAVhhlE/ecFcSfcch",
-	"4zaunB/8xP1guRmFP+ft80Ml1RuKhzRXc8Z75+H4yEGzbhWdfcd1xpp1ozXS21j3WWv7XD2EDHnAk1Wg",
-	"4Btm83jpHZBVSSp1bstqSQfjwa+D0XAw2t/iqlK5VK2rSuWCrK2wdJC58TWu5aZqTAfFgEJTtalkpbNs",
-	"xenCd28oPvXRtxVVmC5egeZi04Plik11mKBEnlCZOao/UXWgKK3Q8970DPjqY23EtUN9s6Y7mDf+u7v9",
-	"nN12kmmNJWEFIworvOJ/rmTd6oSmMaGwfCmMg2Ywcjlto2wrWRiLr4nALxFYz9ExLBKiGWfPVdSoS8z9",
-	"yHW5hJckumvJeVpPoypCVx6xU9TTdI16JjUeWpU1Aw9+/31/PF5ThY1mPFm/6uZZRIuTBakLG980qnud",
-	"l72J2pfRsP5Guxr9VQ/LN5zlQ7jcobUL9dy2DG0porn5BZGzN9TlFF9HtRafEcWzh/Ozky2OkylK2U9u",
-	"p86/RM3z0S+//rbWInw5+wLetctibL42v7XkaYdj3ms0NFgubFVPu4OZS+5s01fA9BUwfQVMX4F++gro",
-	"QKs53tb6eJv+jrXdZ9/a7rwmdmJiJ21iJ43y9QIz/wv48RLLArRZ8kRrBhrfzzi43R1cXcYa73fz3u+U",
-	"eB7xbdPVahnumQ4nq+GjaYCyLs6as4pduPasy/gWvLup8ltejNLCPtPQwjS0MA0tTEOLp9fQoiW0mY4X",
-	"y3e8aH5z05vf9Obf3t78mlprGviZBn6mgd/zbeDXrjuL6RC0woRd4Ry36R9k+gc90/5BLVilc9hxpPnt",
-	"h3XBTdztQk/XtuFgpC9NDXg7VZ6L3NAym+4Pm+r+0KIIZvtaNerW2/TXMaCOwhgXzGd1nuFndRasuemQ",
-	"ZzrkmQ55pkOe6ZBnOuR17JDXYocxDfReZgO9BSJiGhiZBkZPt4FRnXCLLgTmq5Yr7D/UyGnTR+rJ9JGq",
-	"W0eRFDafLjWfLn1Rny5t1AbTcs20XHtGLdeaZd201jKttUxrrZfSWksTC0zfrX77bgldnkYUs4crTqN8",
-	"51cIUkRPIiZU/Fb8dZbYF+++XPPlF3fzzV1czYR4zlggxQr7MyLT6D6DU5azZqwwCgJCWcmEkVu7dfJx",
-	"Aq7kDZXsuLg4IxScwz9ROCcBiINBIBRenpXmea30jrgUB5x8nHCtQFRm+63R7nB3yGcgAfJhgK1j62B3",
-	"uHsgGMnmghN70PGwvyeKjXdcIjXwDimSZW8RAzBJQCEHuDhkgMyAeBTwR6XnSQJ5zAvMRPSfrx5JTm5N",
-	"HOvY4oqRlGiHllxvFLJXxHlIuBkX8MIgcPFUPLknUeGHXBfYXQ4bOlmVsILvPOIHKbCCM/vDYSsadQLF",
-	"aaH3qgKsaf27uvK/t6j0SDMsPRprtSPQjhefZBK6mZBxpfGXkPmisvA5DoejtUt87VlFBZX5myR9B5ui",
-	"r3Q+XEFcegenbNxSD5egTHXiWkFeeopZ3geSG7P9xzr+Wtx5vt483nBB8zwojBshJDkJGVjSJPpqpfBs",
-	"3fARY9AunBhqhdnpky0g+zx3WGeTkN14QnQNkL1CGmPjrwEPsiU0cGDgQAEH+SNyCRqkdeRKMNj7gZ3H",
-	"RkRwEBOJJ8ANWG70YuIDeEsiDhZhgKZ4hqfpzBUweItSLBCWJIUeEqjBX6g4W3IfmLyxuL0sIgJsnpnC",
-	"whcv6u8gt04VI+BmA/bYqsv0te2UlFt9WSkpAVJCDCgtBUqHw8NNUVbsXdK0sj5hYCZahTxX2OQI55UF",
-	"eQF4xj72TlJwsciiSn3fe4hFnDL10rMRVDZU6dBMuAg/CwV0+B5lVYkCS2VULAPTtD5cgaEZEGwCQ8sV",
-	"LCusHSqfOupeRFRcsN59w6oIGfg1NqHCJlRhTQJvZSQrw1xSx9fKXYyfa+EsxiV6G3YVa08FbImbWF9T",
-	"2gwLgpUGDAwYqMEg1rQcBJCIqRR/TyYOxQZNQgUAyOolAIGPviUHIYpKH/+YFJuWdT9/CKsf5VcdA9NS",
-	"/9E6jKD4hPnyxdIti6NBnCLesCnTNLnAh41pYblbq4LYV9ABqYQYcH1mjq44LFe0k16A8xsDeAbfevvC",
-	"ikKG6ZSVgGG6IzS6uzF8PKVgYZ8Y35fDGk9vgoTPDDvjdX0ZIcKgKMTaMLnnIBclh12z/+UpfCN+B9DP",
-	"DkfFs0HfATAMyRQL+6jOipYDtMRMwAiIKdoK9FxCEspNpZswSNEH2gCSAaSnBUgxYrQ12fbkSQlhh0QK",
-	"y02eGVAA0T/CoiHn12NR/nRrKyyKaVsdFm08lKA62NtTjWCfZmZylLAXI1M5uQklmN1nU7vPIE0mD15o",
-	"TCHeRbQ2p9xpY80MuuvGle2lo8qKnFLh+voht/guq0heZ634lshbZ2T1n7HOscjAnklPqdJTxf4DCXRk",
-	"mpCHD0pcpAscUYgoEA/IDFXOrV4EJJdimg1ASPo+KwCPwhdPO2KHePHeUUNyxeCFwQsFXtBYNxOkEJ8K",
-	"rmBEu0Q2f6Scxs4az8IwxHc+N+rq0tlC6zbrgdZ03+kpkb3S7zW3Qque8tj1UxvX02Dq08wHU4liC4B1",
-	"RZlgAbnYn7qRaE/ZZJG9RSwG2MbYolDJp5QZ7hE0+7LwxOQmLfzM4mBiVV9GUpjmBVgLK7ulg8U8M0o8",
-	"wOYo6+ygygK3wMaXlQGOwcbkfw3yPI/sr76B1iHvyx9WZH2bDLOsJWkL/HnCWd+aPqw9ZXx7NB/7SfjW",
-	"T218brPZmM1mtdncRZtN2vat1UFB8VSLY4Kf4vZum4R5ZbPOLTkgqG4e2JBLkctkMMjE/RS5lKR5YqLk",
-	"/O+qkrfLpYi0K/ZzfqtU9zjyh5wEWVSJFNHysQdtrzZl7ymRIlZg1S00tY078en1fhIq9VMb484A69NM",
-	"qEQSzRag64oSKvFkldRJDKmN7rlQvqeUOtkCmOwrhSImNymUZ+ZbilV9GSmUKC/AWujYLYUi5tFLobTA",
-	"yJeVQonBxqRQDPI8jxSKvknWIYXCHy6mUGpSJi3x5gmnTGo+8tRTymQLzMZ+Uif1Uxvv2mwyZpNZbeqk",
-	"YZOJ2HzP5SBSH03lQyOfcVbIoeLT1yyiPnj35Row8ieq7iwCmjb+sZQME9cG7MRHH2aC9auBbetxsMRQ",
-	"l/G7XX8jZzAb8kaVguGz5tDWYO1SWPtYSF8I3pYUjd9dUrT4TLxa0yZ+8oEwqWe1ipX0KtxaP03SWJG1",
-	"bV9O7WSVfL0Fyy2/ilkbRmVzBKYRpchnAOYw1tGx298i9lo+m6ao1v2RgRm0pV23dInP+Qxe85Hij7X1",
-	"bAHno9i9BlE1CHlWWsSVIFGABapEUYh8R3x+sR4+L8VN4PzsBNwjimcxT4B4qqxA8ubX8tLGbZRs9i2r",
-	"56hQV4/x/DoQC8Oemgu3vY5IwajICXQsw3X6IeT9oV41PovrYiRhVIgPNuY2HdUWI585PzvpQ0HSybdR",
-	"P3LE1avH5zwEGaN7HUZ3WaoVCiL2I+4+q0Kab+RXoYG8wxpYEXWtY2sPBnjvfsSdqP8PAAD//8aLPg5Q",
-	"AQEA",
+	"H4sIAAAAAAAC/+y9fXPbxrU4/FW2fJ6Z2vOjJFKyXEeZzFzHL32Uazu+kpw8aephl8RSRAxiWQC0rGQ0",
+	"Y4ppLDtO47Sx07z0Jr518+I0TnLTyXUaxfkuF6Ys/eWv8Jt9AbAAFiBIgqQkYyaTsQhgz9nds+ecPa+v",
+	"5Sq43sA60i0zN/dazqzUUB3Sfx5tKqp1Ci+TfzcM3ECGpSL6BJInJQ0vl6zVBgo/r2CF/oouwXpDQ7m5",
+	"nIaXVT2Xz7H3c6ZlqPpybi2fUxXfi9PuK6puoWVkkHd0WA8Md0o+3Jr7Cy6/gioW+diPaykJvIqBoIWU",
+	"ErTIu1Vs1Mm/cgq00ISl1lGCeRRl46qNElQUA5mmfzbFp6Yni4ePTBYni7Kh68g04XJgBc6ZyAB0VYHZ",
+	"rFSQaVabmuzrZkPpeTJNExkluIx0yw/0NH5V1TQ4NTtZAAdeVHUFr5jgzBIoFiYLT4MXVf3woafBpcOH",
+	"DoKjjYaGXkTlf1etqdmZX03OHI6EE1i5Q5Itke2rQ52nVNNaQL9vItNKQqjdSDKfuzSBYUOdIDS8jPQJ",
+	"dMky4IQFl+mAv28iYzU3Fxw4n7sINZWsKcGxrlqo3rBWc2shYvLATxempycKxYlCcalQmKP//aZfbAQY",
+	"MZgoyKwYasNSse5HRXzQJwb+ISJRaATpOHxOEgKkIzlDlkz11cC4hUEGZuPJ55Gvq/ozRQraxIZVqqpI",
+	"81Owfzv6Wk5h5JjVpG9hQ0FGeD8HgszGjJg/1hGuPgPNCqBw1iLOcd876wwWOfG1fM5Av2+qBlJycy8z",
+	"Ssj79s23fL4Z+bbGg+Qn38DhPi/hPs9ChTOdE4aBjTDnQfKfw5Lx2aPHSwsn/uPcicUlGYsM861jp+ZP",
+	"nFlKJvykosPDHTAsJWC5TPF9WIWaidx3yxhrCOpyuM9Cq1J7DpcXmrpsDep1qCulsFhvwNUGXC2p9QbZ",
+	"swZcVfVSVdVQr8T8iklYnB/QWj+CPSEcb1gChS5qyWzW65AQ9Gs5valpsExmaBlN1B8M/6AETBWqGlJK",
+	"FdwMiOmeWR8H4RuQQlB11azFr1cacxPhULgafw4VRSVnEmpnfRQUIlefaMudJN8Dq4YApwCwAk1gNHWw",
+	"olq1XN5bqtdyBoIKMszcXG42F6LkhOhTdNeSKIHJBlQVJtkMTM6gdI+L032OHRyUyhELGkM4FsKwHIrV",
+	"pM/9u1Wc69z7ePv29Yf3v8qD6bmtjRudax/nwczcw/uXd9qfd+58u3XzvTw4NMf+JW5fv4vAUVnrRz1O",
+	"BkEYNiSwqMTx8SaH5N1F8u1JmBIChz8o1jzY5+N5c6zuPHw27aqv/rXY/9pjvg4vPVMsFGK1SB8FPAla",
+	"pDfhEtKV0G1pdqIwOzFTXJqemZt9am72qf9TeGquUOgbQz+wuPXx3qT/lCMmXONSQ4z+swtqabPUl2fy",
+	"h87ncwSKKGoFKwb/BRoGXO1hUoytDUOl93MPCQd1FzKw6Ym4o9nAuimxcZXJi6VXcLlkNHX6i7tm/6+B",
+	"qrm53P8z5ZnWprhdbQo2GiUFV8wSbKgl73mpjhWklQTooaVOxgB74WkEArag5ntvZlZqfxG3JzD1vGy/",
+	"2MCyFT5GZdVpZFRqUI8WP3X+gkT+nGD/AosWNvoV0P7hfYTpTJVy6enZWS4oVutIt0rud6oSPnfOpMD8",
+	"caCaZhMpoLxKFVH+OWgY+KKqIONp0NTV3zcRaCAj9FA8j7lGvVScnjk02+c8ZXgnn62DUSklzVY2bgQ2",
+	"npWlhhvy1YYXEHkI6P/mj+fysdSeUDXk0BJh1TT8ZydXs6yGOTc1RZ5O8p8nK7jerwbtAIkQok1DE7Yt",
+	"xERlay2nh+Bp8FbBRSH6KJ+Fq7gZfZANVMGGMjiHbFAwJQcaGdSB2aNU4qvrIBaz1XlFvcgURRPpiqov",
+	"l9hrQVJcrNSQ0tSQAiwD6mYVGYC8CA689NJLL02cPj1x/PjBXD6kNhyeKBb6JQ0RH/kMyN+WWkfPTBcK",
+	"h6mpeTpMJYFxnDWJ3u0FrKHIvQ7z6tNQh+z09TTLskrREqaTyztTd805DWTUVdNUsV5SFb+t6uVifjo/",
+	"k54i4/AvP8TQavLjE3gtejGJFydyMVEdqgH+QiyW/+bnK+6Vlb3eHzU538rIiD0jM0U6sfQopXoV+tDy",
+	"WX4cu2BCwMKQ1PTT1DSJxH8O13RwHPcr7L1RpVPkIs80V7ARuHcsWgbWl8/yZ8XpmV+IS+5+069sdj+P",
+	"4kCHmfKFtQSey4Rcj48VtRIBinYoQ8BVXE1vNHEnZQR/EhtlVVGQPqjB/NyZo+eW/r/nF+Z/c+J4Mou5",
+	"8/7RpfnnzwxgOD+nw6ZVw4b6KlIAZAby9G3n87qFDB1qi8i4iIxBV2v+zNKJhTNHT5UWTyy8cGKhdGJh",
+	"4fmFZMsW+HSAhXOmBEw6p6G5Heb1i1ityAISRmL4rzQtXK26KsLQ7OUiHAJXaTY0tSJVTJZqCKhsVYDK",
+	"bOLu2+DA1h8/s1sbB8EF1LCcmwq9t/iuIPINSIaqhxpzKGhIwt05gqXp6ZmZQ4dmZ0tEOyrMTjaUar8M",
+	"3wWUvlmeLtDwN1kAs7YmaOjlpqnqyDQl67j1yd3O5tsPNz94dOeB3X7DXv/Bbm90br7R+dd7g96S/VD5",
+	"FZGbfRNEcCS+HQpDxty6BT2ak0yKt2MOl1i4GUIKsqCqSa+gZ8kLgL0AcJUeIGcsQBxP7Kiyy4DlP4ua",
+	"ql8gFwYcTTA9L58M6dB8uBMxZkL8jdFiLKDFlJBl1bQMSBAr6c16GRlhdBeElwB7ydkGxsfAgSVQxZqG",
+	"V5gtpjgDFHVZtcyDeUDv0GClhnSgWqACdR1boIyAgaACqgau03HOHj/pu7ktUWvM4V8deapQnJ7pk+5k",
+	"k6MSDV4qwXrY21ecnhbUTgU3y1oMn+EDJkNFAMkxICNGYTEzRDREuGuObVKKCNmBoSEiQh2nh9DPDUUp",
+	"LwpS+UHpaufx8/PeHIhcy4p3HvpVIakjZ/CwNwFIXNxbtHpEyGQOMDXIbt2zWz/nAVV3nB8f/vj3zp1b",
+	"7JGPDdC3+kVc3MoY7xjBjqETUDxi/WMDL2sATszKCm8mc42lgVNXr1i0qhSUdYalQg3UiTsDYN0vvfnB",
+	"8KYzoEblzCTyCD5hbu+hqXYeTl08H/5VjtA30hL8DlZybh3vnJcEB3jn4YkIDhjEP5yKfJTwnxCb9Isj",
+	"j8d3laFRLmaueqflXOYwd7Fj2Z1wjy5lmg7S3Y7uZ//E9v5LE9CnwMnL6JGk4+3loklZCrtf+3F/ltuY",
+	"lYuiQAtfQIFkAbT6XK3864r6vPrc/LlX54tn1HlzXl+YrRybPzx/ofH/v3DsuacmJyej0j26kTBZGblx",
+	"z3Guj8u6l64VaaThDT6AIb+wgS6qaEVuO9SghUwLsFdCFo6I4AbJTWzADUuYYBWlS8S/zCZXigmm+vqL",
+	"rY9/5MFU7I+dyx/stP5IQqrY350bn27ffSs+NrWvZCzZzTBCZvnn0cOdjpDUMp4gP04wcsydZVt6lu/o",
+	"Ah06l0RtG0K8SqKIlEKaMSmBuJP0wkpGHzoyZhNGmiEovZkpHHnxLNQvHK24IfSBBEH2QMKFH//0/cHH",
+	"D95+/NN/P/7p/uOfNh8/uPX4wfrjB9cfP/i4z/XyASML5v6AAwmh7KYxKBhMgZShfqHk/OZ4+YIsbuv9",
+	"e4SlfXJj58o7lMv99OfOvz5z/p6Z69z7YOujj9nfvjirPs9DGCkX1bAPszDz1JE+18Ib0R0/vNV2a91u",
+	"XbNb/2mvX925dnn79vVBoLm7WzagXqlJ5lMsFPsFIAwpgJC4gj76R/9eH3FUAoUco2UUSTuP3v9x5/p/",
+	"U6q5c3Xrw39Serl6bef9O2nkaojAR5fDNSRlLzDsbJ/DhoQjEf24aZZ8x0pV5Dodfw4M1NBgBSkAG0BB",
+	"GrKccFXVBGzVxf2bScvnE4WrI0GQSfahvBpGfv64o4GSmwRYqWHgfkB/DmM9nRLWPsQYpkQjGn5unAjH",
+	"BzfhAlWwXlWNOttlAxHhGLlYh1JbLA/H+DSFR//1r+27b3Ue/MFu3aaZCh9d7Vz7gTKQt/758P6bJEfh",
+	"0eu3O9d+SEPo7IK0L7/O48kLUfb52bpfjsjEuU+PyPu1DD/zFrIjBtSkoisuBBQq/47zr0ENawoyqI0d",
+	"EEvbMsC6tkpI0oAVCxkmgNxnq+rgN0gnZS7cyFri/DLBgRrUqhMrqmLVwAVowQtQh3nQbDSQMVGBJgIa",
+	"sshIee7wpe54CKpoBZir9TLWiAu42QAWBjMFAXIuP0IlMDoSf6YwGg0xGoNf5fVmHRlqJR0t8vHmhqhE",
+	"2q2vt65/vf1gs/OHDbv1pd36zG69Zbce2K0P7fU/P968OhwtUz5dZm8ugumha6Fy+BrSnzkUXu6RaKrd",
+	"k0GGrMdGL8mMf0mGrut2W4pQGtSwuXccM453v7vcXeoqHkLhmW6uYuFNuat4GDh1dRXvr3o0IUOmLx0i",
+	"/azOoMEx2ouIoFGp+bec/9avD9H5umfP6RNWnGcQz6m7yNGWZfeIBThBd84V5XJyNOS0vJ4O1F3s9vSm",
+	"3KPf00uiJfuzSLfnXEPDpMCP2dRk6nmjoalIYhh4sYasGjLozZB7e9h2IxOsIAMBlhmGlElwBls1orKr",
+	"pvsjaOoaMk2ALiJjFRh4hTykBCqqcQPkCTl4e/V1DLxiplL2RhiObgxe8ZOeJPEiwFwlnDWYV9FTlhmH",
+	"0cVylMpVPWhJinFLLnKvZA03UrJtxDgph+aTS3fV/Gj6THFJp3LK52Flb4EyqmKDRYw36XmeBH1OuZi2",
+	"2S5m7gZeiYzUPqXqyInQVnVhZkgBvHZMCqltK14wdYDHCs8iHahO9maXqca4XPnJTeJhjeHdeEUmrFhg",
+	"cojt9btYwnBkrSizTm90YbjQVji83DclHwY+Hs9ZslT+VeGStABtomqsZglWLPUiEti2m12Wz1mqpQUD",
+	"M6QZttJglTPYOombujJo+t6Z55dKJ58/dyZhpmPM64mT9c5gC1Dch5CgR9NLTqra2FL0FLyikzMWyY4L",
+	"c1sf3e1c+fTRjTfyoOiWzpmWlMrp1yoVxIGlVlcwS3gPItR5+5a9fm37wabd+nnr/XW79WVn407n3gd2",
+	"6z279fHWrSudr96z17+z25t2++rjzY1zSycnjoApsFhTq1bpufnFgC0t5z5ISYq7qLsZfhWsW0i3ShfQ",
+	"qp+0pljCz5RFHPqNkoEa2LD8f/Hcv+nC5KtqY5DsPxGJmNzD4QB3FRr6V+juMF04dKQgJLCounX4UFpJ",
+	"VR7IIeQ+spJvYzw8fgwCiYjLBm6GYnZm0ktWC8EJwA8x5OLgsFzH9yhr8rAwJTg9e7hUqaHKBbNZT6uQ",
+	"aXDYUXnjCJRxc34/Br3ETIkJXyH2FqbBsKALTj94lnvzCFI5Pk8HOBGlymjNuh7hBtz55A+PPrzXufHW",
+	"482Nra/+tv3p253rtzoPrpM8q/Zf7Hbbbl+225tByUU9gERnw7qToNdvYWAPub0aU6KpOoq8bR1bfMFu",
+	"3du+ff3Rzbudt//n8eZGsfPZm8S9tn4tsKr9xqCI8OPzwYsp5oNjTa1IAh92Wl88evdzRyX6YefKX0Fx",
+	"zm7ftNdv2+t37PaX9vqfBEXqgd26a7deJ4d7+/Z1e/1PJLFr/StKeO+R2/T2V5923rlmt9iQn3qfrl9O",
+	"gw/wadDzD1dKF6HWRHGa33qLIvym3fqb3fq4c9kXWJUr5guFQueNN/rNRXYxoPggaAb7A2zd/KZz+Y67",
+	"Gtuf/W3nykbAY9svcAYuWQasSHJ53yEW19Gdg0suvgMewc4Izz2NrBpWlhwTbsBy6uYh+3dp692vt67e",
+	"tFuvb936Yeu7m3brXbpQ99zfd668s3P7Lbt1r3NjY/vzDR/9TBcKsjzmfvKWhYxlL8CWzKcryqA4dxau",
+	"noWrhBvfe3Pny7883rxKzoa9/rXd/oe9fp8ejA17/Ut2yyAn5CVYw/gXdvs/7fZbdnuz82P7f3+6TsJ1",
+	"jmpqA67mwewcdVZ/ziA93tx4eP/y1pv/YKm0FMDhObv9nt2+a7c/oIf0qnuNyYNfzRGD0Vky0BEPuwBC",
+	"dJSn+GOSlfnnVmfjfh4UC/w3u/1XOvJ3dnsjD4rOPJnnnE01laKBgQWnV19BVlWSUc76nzobHhvrvH2r",
+	"s3nTbt17+OP3Wze/EfE80ieaYZwiiwbymeRznpwNfRxzkpy8gSQmDsIvGtKUj6H14REtP/HeCWn3ocVI",
+	"jPvK75AtIm7KnDf4VWzAkhMIVSKGtNVQaJAML9gg6iTUBnOlHXVGEUaMMdFvXf3ZH9xH/6b26xn+x9b9",
+	"DZpzf4jH+/VmuU6HRHKybF7GRUm9SV4PyfdF59tvH737DejcubvT/mPMt8w9JinfPy33M4ofmc26rPbF",
+	"bCGJyPBGi94ewnDbXxAliTDH6TkWjmW3rxAevP7zw58+2tq44WzQzNzO5dbOlXe2rr756PsP7JawczyM",
+	"i16SCMtfvxavIq3lUysJyshSrAgqM5wHS3cmoxKTsMHePgk7fxPvFv00KaGkyWUShhGFCeikqlnIIAHj",
+	"9C1SgIdnPvvCemZYWM9wO1s5fvxgfYdl1+VUxaTM8rKqU0xT0eGZo94X/9M1QscXSuDH9oxbvYgeCV4Y",
+	"ehmlpKB44QR9BBZ14SUeKbAXgecPSyGuSgQdG2MUV6DXQ9F0S/XyL4ZBttHVeUNIW10xhhWrSWsn6taQ",
+	"cLW6I+qLpgpiijRSFQyQl4BBI09MUF714dl/2JVjNxTDlCShWOGYq0CJZoKcohqIqrDgADQrfP9p+ovz",
+	"Fy3TrDfr1FFJI7XIs9x5cS79hHCJs3Diq8YU1uXbigClisTgP3rnu4iQqHguBVow/KvAi0PPNLWuWgli",
+	"rZJHb5ldsq6ksjtpI0q2TOkqM2E1JrH7lg1AQrMsQ0UXCZ9z+4Nqq0l9uvLLkFSNcGtdJ7ns0XAJBZq1",
+	"MoaGktalL7KzpYcc4U8EOIgFnuT2GL4PvkDGPR43bjoa2wI/8Usr+CSMPnHoUkM1kFlS/YsxU5CeonoV",
+	"hv1WUaEOLtcxkxUBH7joxwIiDOoYVtBYy6TH13mRLmGxa9gnG+B8l1lH7XIF6iWDvpdoGyKJQn4/q0NV",
+	"J2KBEme3D6Qbh7U+43LC5+uoUld1VsNJVjZaBn6R8bTuYkneYtC3il0Y7vMNxAuQEspmabTDZbliHfKs",
+	"mnqXtaKcN+s2lHUbyroN7fFuQ+woh7oNBStC06IGrNS9eMNn+b+axnMWTGJpgUAxYNXiZotcPutctD87",
+	"FzHKie1cFHmFWFw1LVQHddbHiHYQBlydB16fH6no2mXdkPKz+SP5YiFfnN7FfZHYVvXcF4ltyNBaI+U9",
+	"a1gEcxxVl6S83y4X1y+JLaUyaM+krnOPaZ+ko5WUeiR134H4dkkzg7VLSrIIUvXTlDrBfTYOmTHXlwad",
+	"zAiS7B4c/qwXcu2jQ1fS0o3CHbp/6xlZ7pKTFcIpIYUR6WU2ZMZJZd+iiCbWLzd42rqCqpAmiOaKnijp",
+	"6tKKcGQNoAcLeBT8iCR0V3koRTRJS6eiEXZi0Bx0pG3UZL4zWe5735oTz8dOlAXvu2Klnw2f0C2TPEN+",
+	"XzhdnA2J8r6cjz3uUcaqUeSpFwvRL5bCThT5sETgDH5fInahkq8ydYlZiCVOEXFDGPSYHHr/dGR78QIy",
+	"1Orq6ZNHd7G5WVKU+2i5ovyb259gqOXEGfQua9ebM3D0VcZ7przEVk36sWD/Td/6G427oloaXi4dJf84",
+	"hZdjVQj6dom8HrbwamQO/YYTBAZOVBJoFMWA4jCJvPyLD/rEwD/EE1KJKCu+Y7KiZYQZDWzOdjBwBou7",
+	"gaYTFeJB8pNv4HCf74k9eaw1a9TRc6OO5BJrd3fx6FXyZraTzHbSi+0klr6ewACaLuvxJEbXJF6SPRp6",
+	"Ezu/7ILb/wU36cJmt9/R337LpInnK7hsNPXSs+Tfz+HyQlOPT2/B9TrUFYlE5o2peYkELw+572uoCOmJ",
+	"a/UZdRuktUOfpNugN+HYDr7TM3OzT83NPvV/Ck/NFQp9Y+gHFrc+3pvJ2vimhljXAs0xBSDvfbx9+zqv",
+	"hugUa5mZe3j/8k77c5aNSPNKQ8VbXp7JH0q/FnK3BKWBruZ+7uGCkixkYNPPp8VBo0Qa/aj0Ci6XjKae",
+	"Vg6EAH0XV+0NTL3H0r1RK0D2GpMNcELEBg3wffbo8dLCif84d2JxKVl877FT8yfOLA0Q2OvhDhiW6Uf1",
+	"dlm9k9goq4qC9Cw6eqB1nNctZOhQW0TGRWQMupjzZ5ZOLJw5eqq0eGLhhRMLpRMLC88vJFvVwKcDrKsz",
+	"JWDSOY2LQrP6oP2s2r7O6ugy9yzpY3Ay4v3FS77u61GXQ6Gbe5SiPrh7UgAS5590e8mHFGFCX3Ng64+f",
+	"2a0NVlspD+hKOT8+/PHvnTu32CNfIDd9q1/EPYxibz8EO4YOnUegZf7QljUAJ2ZlQ339u1190sCp663H",
+	"TZAok+ZoyDQj6iiehYalQg3UiSoKsO7vzs2vC9507PYbpNBde4PUyvrXe/3OJAK7J86s0bUV9vT0zMyh",
+	"Q7Oz/S5012wq/yobaJklSZL4eq8ypIfPkmtALchqHiXu+hMG00cfHu88ZH14utz/5SveJbcqeDgl/CfE",
+	"Jv3iyOPx5weVsVH6Gn85LcMBh7mLjQbuhFMyF7jbfYy6xbM81yzPNctz3eN5rl3PetaPN+vHm/Xjzfrx",
+	"Zv14d0E/3sTcOmvYmzXszRr2Zg17907D3h5ZW9bRd/COvl2XPKtnld3zs3v+/r7n+5stSPquhXp6D7kV",
+	"g7QKvQt2BRsXqhpeKSXqvZHgHYNxNaSUypJeTwzdR+9+t/3lm6xVit3+lPYs+N5ub/oJeTpJDwnTcrLb",
+	"I3p+uzM13K7q8gWmd2xZI4skq0fRSLSG7FtkdK9xM7QmKjKve/eCxV0onuctJ0lW7hbhIE9hDk9tOlkF",
+	"0lPy4aRz9OFaSgJvaH1uGiUnXdJvJ3hqerJ4+MhkcbIoG1oeuGEiA2gJwvt7L3XM003hMgo0v8idxq+q",
+	"mganZicL4MCLqq7gFROcWQLFwmThafCiqh8+9DS4dPjQQXC00dDQi6j876o1NTvzq8mZw5FwVKWvMt/J",
+	"Q1bHkGfg9jUUAI2usSFr1m0263VorKbVJ9Q/KAFThSpJtgw3SelXW/ENSCGoumrW4tcrjbmJcChcjT+X",
+	"x6291qXiee4k+Z5qypwCwAo0gdHUablCURa9ljMQVJBh5uZys7k1WZ/6BOhTdNNvadkwMGEs0j3uv4lk",
+	"YFB/wkOqx0IYdki5C/0ugtAueRS9dmXNHANJDIyGpMkMuTAlBA5/b81qk8UMjKkhfSDMcGgsR4TTJaRw",
+	"qYYAj1MAKmMr7tvgAIsqPAguoIbl3M95j7R8t2DNZKh6qMX0becIlpwYK966fbKhVFNp3J5y33Qv2GmY",
+	"myyAiQ8jFJw1n9ztbL79cPODR3ceDBgfGLQN+aHG9wg+lGKP4GGF5cXYhDhcossxhBRkQVWTGl5o72zA",
+	"XiBFqH0xm0R2s6PKyjBb/rOoqfoFRHot5dJrai9DOjQfrofFTIi/MVqMBbRiIiCDFcK9l5z2cHwbGB8D",
+	"B5ZAFfN4h/IqKM7wkIWDeUAtR2ClhnSgWqACdR1boIyAgaACqgau03HOHj+Zy6cWeOkW/Q5Pjkzagpdk",
+	"jSKLxelpWevBiE3oqdewAJJjQEaMwmJmiGiIcNfccoUyRMgODA0REeo4laxgx2wxTN+TbylFlKang7ke",
+	"nTEpYekK+5H6XnwAQ0Zr5geUq3gatJBpAfZKSBBFeF4kB2bADUta4yhCmse/HHKwh25iX3+x9fGP/CbG",
+	"/iARU60/kvsY+7tz49Ptu291NYf31eIrdIAjjl60P7fL0SMktYwnyI8TjByDLboX6NC5JIrTEJxpidxl",
+	"hTQdZgGnWHo+r9H7tcYsadL0jw1Dmgjhsd3jYkcXYzqSMNJUQkQpDxRiRIcTAjrsEM/Rhm+OIEBzNMGX",
+	"BAo5RssoknYevf/jzvX/plRz5+rWh/+k9HL12s77d9KwWYrAR+fLGJIqGBh2ts9hQ6KTKAa4aZZ8x0pV",
+	"5Boffw4M1iNLYZ2PWW471fdUE7BVF/dvJq2LexSua11d/vPHHf2UePDASg0D9wP6cxjr1Do/iIh5MZvD",
+	"9xGJcHxwEy5QBetV1aizXTYQEZ2Ri3UotcXycIz3gDz6r3/5A1I+utq59gNlIDR+grg/Hr1+u3PthzSE",
+	"zi5wf/g1ouGHqvuZt+BnSV/PYjeIRQrgXEPDpPyPEycTCqTRVCThTi/WkFVDBiVPfiFlCCMTrCAD8aZ9",
+	"SJkEZ7BVIwlKqun+CJq6hkwToIvIWAUGXiEPaciXSDwD9N9y8Pac3QZeMVPxQQvDUbrBK3GRSPTtQKS4",
+	"JEw8WMmjp15uHEYX8ZUKvwiKsxjLySI3nNRwIyUGG2NHGZrZIN1V86Pp0weSTuWUzwjE3gJlVMUG8z00",
+	"6XmeBH1OuZi27hAzdwOvRNr8T6k6cmz9qi7MDCmAx9sMrK8K8EMiQHgWaeNxeiR2mWqMVYif3CRGoBje",
+	"jVdkgfXMxB1ie/0uljAcWSvKrNMbXRgutBUOL/dNyYeBj8dzlty7dKResZOqNrbIAgWv6GRDI89+YW7r",
+	"o7udK58+uvFGHhTdOJRpSdxJv4pXEAfWH7OCWTfTIEKdt2/Z69e2H2zarZ+33l+3W192Nu507n3Aony3",
+	"bl3pfPWevf4difVtX328uXFu6eTEETAFFmtq1So9N78YSFPNuQ9SEhku6m5gQgXrFtKt0gW06pdXU8xP",
+	"OWURA1ejZKAGNiz/XzxkYbow+araGCRoQUQiJmRiOMBd6Un/CiXVTBcOHSkIfjdVtw4fSssX7IEcQsgG",
+	"i8kc4+HxYxCIn1g2cLMhCQBPy8ceghOAn6Aif8+wXFPPKBNomNkeTs8eLlVqqHLBbNbTCmENDjuq+yeB",
+	"Mm7O78egFx+C6KcOsbcwDYYFXXD6wbOc5h2YSvl5OvyJqOqLWrOuR9Tf2PnkD48+vNe58dbjzY2tr/62",
+	"/enbneu3Og+uk3J97b/Y7bbdvmy3N4NyjZbegBUyiBN10G/AuIfcXrWxaqqOIhX/Y4sv2K1727evP7p5",
+	"t/P2/zze3Ch2PnuT1LVYvxZY1X5tsiL8+CC3YopBblhTKxJD4E7ri0fvfu4oTD/sXPkrKM7Z7Zv2+m17",
+	"/Y7d/tJe/5OgZj2wW3ft1uvk6G/fvm6v/4nE/61/RQnvPXKx2/7q08471+wWG/JT79P1y2lwCT4Nyh3g",
+	"Suki1JooTi9cb1GE37Rbf7NbH3cu+xwNuWK+UCh03nij3wArFwPGraAZbAe4dfObzuU77mpsf/a3nSsb",
+	"gVIp/QJn4JKF9Ygkl/cdYnEd3Tm45OI74H0xO8KvTyOrhpUlJy86YOJzQ68CWXvvfr119abden3r1g9b",
+	"3920W+/SZbzn/r5z5Z2d22/ZrXudGxvbn2/4qGu6UJCFbvUTqiUEaXnOajKfriiD4txZuHoWrhJefe/N",
+	"nS//8njzKjk59vrXdvsf9vp9emw27PUv2Q2FnJ+XYA3jX9jt/7Tbb9ntzc6P7f/96Toxbh/V1AZczYPZ",
+	"OeqE/JxBery58fD+5a03/8HqtVIAh+fs9nt2+67d/oAe4avuFSgPfjVHLBtnyUBHPOwCCNFRnuKPSWjv",
+	"n1udjft5UCzw3+z2X+nI39ntjTwoOvNkHlE21VSygwMLTvZAlGSVZJSz/qfOhsfkOm/f6mzetFv3Hv74",
+	"/dbNb0Q8j/SJZhinyOxgPpN8zpPCoY/7PmdOhE6SfEfCaxrS4KqhJReaJTLNiyhRDyyJQTcS474iqXpf",
+	"YtyUeSjwq9iAJae2WYlYi1ZDYRoyrKGQr91/bQs361sYcXh53cUk6dEpEFBOFvXMODBuWiWePuL7ovPt",
+	"t4/e/QZ07tzdaf8x5lvmA5IkjE3LC3+IH5nNuixUeLaQRNx4o0VvD2HW7S+I+kUY6/Qcq7Bmt6/QdPWf",
+	"H/700dbGDWeDZuZ2Lrd2rryzdfXNR99/YLeEnWPfscsZERfr17rml7MpplVyhR2XBTqmzDpsIp3YxeIz",
+	"XEJbaBIW2tsn4WosiXeLfpqUUEbHg/iiDitArY9gs7V8TnIqpmcTrvOIw856jB4bKBis19iuPgK1hiix",
+	"HW4bvJRKy0bIQmRnZS+KCk+CwF3nCyJc0SVUafY6U/drHplTcrtexMvn4Hf9go0RxpRLcw+p3f7Qbn9N",
+	"1eoNorzTvLrtn3/qXPvEXv+cSoVNKqTZRw/+uvPJ5tGz88KzQ75nTlbwrO9XR0QcjpIdvyKWPvZFvK1j",
+	"VDwPGXXVNHmj/W5aLXVvKtCslTE0lLTOSmS3fw85YGFAgINY4EkOXfjgv0DGPR437ig2wtftObQVPV8A",
+	"whq/pVpasp7IfSK/gLU+MQ/vyVGlruosIwobg2HIuSyrx+6I+IgqbWkpaRymqFA4MPuLAXIQi6njlVfI",
+	"1q9JlD8/X1ys1JDS1JDiFYxWaCL5Sy+99NLE6dMTx48f9FnxWNOVwxPFQr/5ESI+8hmQv8lJema6UDhM",
+	"65hOhy/5gXGcNTnfOzGwjUlYADa8hCdVzUIGCdGlbxH+xHGStVEfvAxrkgKswRTgZTe+pooN0IDLqk4x",
+	"TcVKDGm4qq9ya9faqr4aj35sz7hJv/TU8SqCyyglI5cDN99PSdgud0qPFNiLwAv+SaEirgg6tjps3IH3",
+	"UDTdo8+/GAbZRp/2ENJWV4xhxWrS3oC6NSRcre6I+urgBjFFmkKUE/ISYOXoTFBe9eHZf8Fch4F6GORl",
+	"RXTD1XIDLJ8gp6gGorcCcACaFb7/NOHA+YuyfZ2421/OQVpjlzzLnRfn0k/xXXEWTmXcMRXk9W1FgFJF",
+	"YvAfvQElTG/t5wVWHXqmqXXVSlAjN3nVXTPBhTJk4klaoo2tRbo2r2SFDaUl89gAJEzdMlR0cWwNJGO0",
+	"w3BDEE9LszCAbj74JCAJRMRy4mYR1ZumxTvPWTS5iNwF/K8Ek8oPcKvCwTxYqamVGqhBEm2MdCFbhXBh",
+	"YKIK1hWazPI0HYINqyuA2VNopxITQAMBC15AulN6A1oO7MlcftdkfHavGLxr2or0bfjryfsqx2bZeqaQ",
+	"9TbJeptkvU0iixTMDrkud4/G3N796V1xCN7BXcRDo4wih47zqz4UMlanP2T/CZaioom4rMaeeEeiog5q",
+	"Gk9xM4kkhUAxYNXiF7+QeMtsSfvclmRgDXGzIjF9RlqSwkzrNNQhO649LUFZ1VmehjeTvLMuXkk/12Ze",
+	"UpVwD5v8THpdbDjoAMTQUjt5VP7Xel5pdoBjVzrSkbC4alqoDups3WkRXsCN+sDDS9r5fJftXn42fyRf",
+	"LOSL03twH2m5b3ZiiMcgch8R9Uv4Fp18+m/+Uj2uBoykboxkk3a+lTES9oylSJGQHKVUr8Lud8GEgIUh",
+	"CQhyB5XoN8/hmg6O434Dvb1RpVPkFk7TXOEhD75wMKwvn+XPitMzvxCX3P2m38pT7udRMugwzyHVEpTs",
+	"T5oP6oTrylciQO4OZQi4iqvpjSbuZM+ngXG1nk8D/UoZ2oHIexbQCKvcqM5G3m+LjTslbCmVQU9K17nH",
+	"HBodraR0MrrvQPwhmRnskCRZhLWead1ERqzPbfBmggqqQlrpIlf0pGtXd1WEk2oA35OAR8GPSEJXlIdS",
+	"BAtMpz6My8QcdKRMUuYXk3Uk7Funp0Plk/Um9N/JU+9RmNDlkrxv4b5wqDgbEuVZOd8/L4jyjoyic2Cx",
+	"EP1iKewekQ9L5jP4NR82rVqJtjVyloSukdTdIe4Wgx7T1dA/nfBG0bNcaRqqtUqu83U252cRNJBxtGnR",
+	"I16mf5109IvnXlwi20/fzs3xpx4R1yyrwchK1auYRZLpFqxYgjaTM5uNBjasgArDRHvu6Nl5sMheCDdX",
+	"IQ9JTIPbMM+piWvSW17ODXXyWurxBA5w9Ow8ORXIMLnVerIwWSAQcAPpsKHm5nIzk4XJGbqQVo2uxBRU",
+	"6qo+RdtITWiYncBlJLEe/RpZADqhFkgBmmpSlwv9lPRqMtnNEzdYSxlS6YR3VCeET31+8wqtjmJaTvMt",
+	"M+cWY3sWK6vOavLWTLR4RoV+OfUKT49jlNY/HSqqRbplOQiIEjvAK4jkoT8wgqUrM10o9IRjEpeo28Ir",
+	"LVeiM7eIjqVj878WEzpgi7OFJB7YxJ7Rox6FjsY5uhY61adCh4XAOFQoDp3iK7hex3rpnE5YMDbUV5HC",
+	"UsclWIovMfxmRoXfSWyUVUVBeiRy7hsEs9lCYVSYzesWMnSoLSLjIjIi0XNeA+w94LzoyZ/c3Mt+yfPy",
+	"+bXz+RxvKuEQiUAh+RxTiV7Ouew5d56MyJl2mXjDJ17B5QmjqSdn3DXVtLCxSsiRjuB28SKj9MDDhSZ0",
+	"o2bjFO9XcNlo6mIvvCEz89QxZbjE8Qu2Qa/gMt2cjGdkPEPGMwJU4vENH4eI4R1Tr6nKWhcGEuIVjFWo",
+	"lglod7U8oL5MkzoTaZaM06QnxD1+jUTmQdVRA9YR5TRzLwfBPyvOjrWOVpm9wap5SjW91fvPe17YzJA6",
+	"cX4Emh1dstIruFwymnq/JBXu+Jlc87HbN2hSfou1AOx8+/etr/7JanJ0HrxHU9of0P9/PHwVyL+NrPlT",
+	"xtEG4miHCodGhdkZbJ3ETT161fzbq2MLVMn7+5bxEqZYlpJ0n+x3ipZdmSBlV+J1Od4oR9VpLVFTypq5",
+	"hZBEQLNqVEjJM35t1ZBqgErTMKhRgZc97qbgueUlzf3Lrb2yNynG9LJVGyCsN8DCScWaP/ydRBkKVZYe",
+	"3r+8/elnY+LrZwVS5BG4vnORsfiMxe9h3brhJ28YIO4kzJ73p4zm6ovUAUGPzu+bUFOrKlLcrpYHdlpf",
+	"bH2yuf3lm1vfrm99eP8g6fdoumydxaKzEkokmt0I95vMuyHxedZz0gv6E5p/SkXAvIP6aO/3fO5Ot+Rd",
+	"eK+XYtj9Pu+SQsYUs5u8hNuEjr+oTvKfpLyl+w2epcZYUIEWFBrIQgnLOXv8JOUOnqbJ0aS/8l7CqhVs",
+	"uBu65PPD0U1l5K/tKWVR9fq4968fOuuTXBsMyIIxqXzOhmWX+H2m4Tkb+2Rc38OML3yF78pzp5x60JHM",
+	"9zh/wenVzdTIMHBoEp66Ak1QRRZJPRBVO5YR6cDytSPiHUZcx1GIEzsI7Al23FCqfnJy4z7Lqs6MycFy",
+	"KZFkzBqj1xBUeAzLMQZz4rhqNrCpOm5w/9fQsmClVke69TTV+8m0n/lt7jXyb5oJuvbbXE4yYw+fjB9m",
+	"/HCP8UOXSYX4Uhdm6Fwtew8ccr/swed82oU22gupg6vbhW4XXkkjcOx+KfW2MGNc2a1UciutC6fO4QXO",
+	"b3JmMMUcEPTehGW5qCxPCkCgoxV3fEeVcUMd/QyAfeNQ97g4gB+LnnhAMfVrqLsLA91D3SVNfhF1PnE9",
+	"TaONp4sHT9nEyA7js9BJWY6xnCuAv0NcctyuQoN2XdqfP060eqgZCCqOGRcZ5BYQ+MLpX5Nx64xbB7g1",
+	"56oiT03Osll/xwnHJTzF2hpFs3DWwBFAcGzxBVGNAPPHWRBQkGCDvXYnAWupd4DpfyxzGTct8Ozzpw/S",
+	"EdyOetwBgy6JTo8TlypIo1V5YKWCGhZSJn+rk2syu/HR1ryscg85Pr8TGmH+DhzoXPvk0Ycfd/713vxx",
+	"But38kaX5FXabvTRte+3vmsdBKz/ifm0iy104BEoVdUwLWCtYOc9il/TpLgt8omDOvHVILCsXkQ6ufcX",
+	"p6anZsgKuG1Pp8Sep1Niw9NJcCLUeJiyQAbasds6i0zhu7YCaFLiYDM0ibyFwFT1ZQ0BoeIFwLq2ClZq",
+	"SGdVIfAKG4bCmvytHpLMjBRkXT67uI7qTc1SG9Cwpsg1f8IRclFCsMobXIa6LlH6yINmA1gYzJ5+li5D",
+	"sUA69ADeWLO7HcGfekJhnZfKolHkCAwuzSMbZXeV6cGe2G7z2tEK+YUAGg4V9yPfJY2tx7TKJ6FKS4pg",
+	"vqygHrHsSRe4CjWzzxVeohxLQ4SR0LIHrGhiUydKCMm/zJM/TVxHHhNQdcYGwIuEPwR/BrqkYTplr3TN",
+	"fwdqWFMYQ24gY4IwMcNdr0ybybQZQZs51/18JNNsuvpGmd2f2ryYmCBCEJaZdDcbqKJW1YoYRaFXtCYt",
+	"n0TCnzXW1TxC3ZE5RoU7bKwp/rSnUu0d1+j476TjyvJyEcgco/vMEeDu7JPhGa0HCbknVjtF6t5N8Np2",
+	"0X4BalpE7C4jBK3lARlqGfFYEw2xG0mgtqtY+DVP7jSEA9MbEPOU8kfMtcAkBpgmSgkZBeuI17XjRV15",
+	"eTdDHskiOh6ehfqFo87M9iP3FmsWphUHLVm8ASKiT8towBw/0xfRcZIsMwmw7yQANvw7/YTEQUs5b4Rg",
+	"yEdYDB0TOOHBvjjlOO7u5+emBVdN0ODFS5u6pWoA0srdAFs1aoSDOgdAgSHDqfZNwmu6+JREBjUW5j5G",
+	"j7Yw9zE7tUQRNATBM5igcbQTh7xGbxQTzo2LxO5zec1ze5Cro0HdKdrvrKTj60KXVNMyCWOFzuoKnjB+",
+	"1jNJmknSPS9JHQEoFXQ+kTjIjWvqNf6veWb2opcoFC+OgxctflQTS2T+fo8CWYQcDlulaO8G0Zx/LZHO",
+	"HwXO3Y1Bb3uZqB2pqD3unIldLGbFk6ialIXyo5iJ00ycPtHiVBAs0jtqTLCyfEBsuBdPdq7iXDuZvBq2",
+	"dXL3yatdZYLMOHzG4feXM8pH3VGGx2aM3dHVhxJfcjz9yQk0hiYPWndEgbMZNPCOlOQFqFpFFQtgvYIG",
+	"sk2ykv9PuEDJLKLZNS1e+h0LgN8DtlAru7VlMj27tUUzk7TMn1NcvsYkorEXAHTOmDwYBRvufZJrBuw7",
+	"8gGV8LQ/saJWSZdA3h5YkPNP8z9pA0ZFPPsXUMOiqf865wBO5EDIU8kAZvfLJ/d+yWnR6149FkHrgt+d",
+	"1lBPeBJW6hxqkl0ETUFHKa/SI2nCOk3PybLpMtG6D/L/uDAbiWg1EGUUkZJ1gT7vWbB6d2OSJceA8Kq1",
+	"eMX1xZD8GRLbqRhwRXZ3ZsAzYfnEC0tGQOO7lDLoe0hUZoIwE4T74I5JxUaKclAMopGH07B4FRLnRgPa",
+	"iMRz4bs3wojSJv5Ylx5kFJGDHKFdkWIwAHUsMgYcV7FISLDSUIipZ4wry7bac3XXGNPovVoHZUqsUzbV",
+	"zJrSCh0KjOBIvzTFXNcurqce+RHHak9HxfsXYMwdIsefUcu2dGxxDVLwWZWnTKplUm2XVm6ggiepVBM6",
+	"HzG5xppbTCACuXszJFJEhL3qBHZo0LR4hwxAqy17XTzyTpFB2trb+YA8IbXsIzohuY185umYJxheXaSi",
+	"1xhnb1W2p1MseWufViMkYe0GyP4Ndz8iLZHevmWvX9t+sGm3frbXP7fbX9jtzbF2RWKTDZClR4UZV98v",
+	"XF045U9IJrAaJG2RvQqs3uPq8cyeazwTdWTVsDJB+4F3Z/rsNdBAhqBkkQGcw8ZbIgll7cywLHDtMmUD",
+	"wQukvL5zWFVvXF4Ew2ugR9qhOGCE8QGss1IEFqa1r/QKoQqrhuoALkNVNy1ZwLgrW3gb/9N0FktsFfan",
+	"iOELW2IbVvJ2PB1RE1hFWRt8YddKbNfClEbExubNnSvv7Nx+i8iYGxvbn2/k8r4+9YWCUFhQwc0yOwEM",
+	"Guu+FQRXiYNmt+49/PH7rZvf+OBIu/A3dadqVSnB8Fvvfr119abden37i+8e/fMbu3XPbt21W6/HgE3U",
+	"hL8XKe3isHXrh63vbnY2/r7z4Rvbn2+Mr3GhyDY4Q8lEdSaq90vkfCOKwnuW2FRAOyaJbq0enAYP8CJU",
+	"NVJFMmTUiLxlkbfOCi/FSr+TtFEEieThoXOszpMjB3/fRMaqIAjNEntN1rvFYxyjlH++BU1P9p0VTEd9",
+	"3rLOBjdsbLlNTpOKMAllfDkrGCpv2BriNQKD83GyIJvDzT762PDveuhic5ZDGq1vg+FZYsB3Yf8aCX7d",
+	"e9c4m5Yxg4wZyJkBP2kCC8BNS3bwe+tZoxiw6ozPjr5qmc7frPK1GVFtjFH4eE6/iMGYk9r4Tgyq8ZAx",
+	"elJsyAaNp2tNHPDd7M3MuGvGXaM7zTQcdpaAxaZUid0FKbOmsifd7KZkxD1mMx0btxzX3Y+Dz6qp7z9D",
+	"HNnXJ8YIJxJxYjZJZmHgizH66FH2ArVXV5qGgVg1Pq++BQfNBoLaJDgBKzX+CmdvrAYfTelbbhpIAcxp",
+	"QUZQaMhgxfkeGSZt3kKiCHmDqWX0SxMYWENPs2IXbugFA6GazqeKiE4dX0TUR+a6z0jOLOF6nmbkGPL8",
+	"/J1POOPxg/P40XBuZ/t3Z/oLp0ee/kL8aYZ7VpwAPprbXYNeyJ5L0WqmFmcCaa8JJEdk9KqzJ0h/WcRV",
+	"a0LhOTB+8wjUFWodiTKLsCD4Htn6k5X54qrCUXkvu5m5Ag3ry8hwyCLjmxnf3JtpOj2zzYQ58hJlHUCL",
+	"8kyfYs8y5PmbvPA2Z7WEx1agTtrKIkW1eNdFA5nNcl21LKREpMhnyvReUaZ3dy55f8o0mQ/AeqZMZ0Jh",
+	"zyaZ9ywUGEuOFgqLFjQs2i/FOT9VbAR16qbpGGIE4437wQo2LlQ1HG4+ukhhZ0x/rzB9V3wzGuD7uxdE",
+	"gEOv2BAzHv2CIWP6GdPfY0yfMVCH1n2HMrkESJi37+P4vzSByQtpsae64hSUjDWusMH6MK7s4TR+Huwi",
+	"Tn3MKfzjdN+OJ3k/DvgeSd3PDFiZ2NpfGfk931VeRfqykxMSFbdzHK/oGobMyev6xxx7FjFRLdbUqlV6",
+	"bn4RIL2CFaSA39BxwYHOHz7fuXb5IFhGOjKg5vmDCURQhwp1ZtcQVJCRp07iPHlH1chp1BWAdMWRfJPg",
+	"pIo0BWhIX7ZqJn1cqUEDVixkABPxZt+0FDtlSWVUxQby8v9VEzQMrDQrEmOZM0dGMAz7kyx7ZnfdoSx0",
+	"yZpqaFANUKmbpVhWdWhI+H+Y7Pge+bYkl8+xzaDgjzG4E8dVs4FNlX0XXAJoWbBSqyPdepoOQab+zG9z",
+	"jLIcUU2IrfSaqOCsTVqXrN/mZNk6Hsp74R7kHAh6h69SEq1AnTwq05LgBjKRbtGQN0qLfNn5hmWiJhM1",
+	"e8xX4oiDCP4RJ3qQUVdNU8V64hxDTeM1FYH4sTTrxvd8+Bq/fy5ppPe5Iw6S2eehNf6cPmGJMj6XhZjL",
+	"Enh8h9ZlHe6vPvZhYA0lZRzUF0U/YNk70DRxRaV6YTdGskDBjICFuPNJgXmcM5FRIpgPwDvoxMfONdiq",
+	"ZPwi4xcSfmHws+lwCvJ3mEf0lupHPglk93osgjAOdVmvkzlH5PvRUzdaAyhBueRBH3OmH92EtLhXT9xq",
+	"TIl+0aCzNL+Mp+7NND8D+25vEYw1pQQ/ynJVvaI1qT0oTiP7NbIWcHdDHD2SeymUYYxMc1waHgWeZfvt",
+	"M8MX3dUnI9fPEAk4Ea/sr68IhZOsp0gPvPHJyqjgzCbrI5Jxnv2RnJBcQeujbwj52N8zhPpX4xQzNkqP",
+	"/GcPBx3RO7c36TEHHI1RfRxPvFE06OzOnQmbTNikG0jUTdg0TWT0XkqRftVDIcVzJnsySjZPcKS8cReW",
+	"UAzg1r18ItumjAdldj+JL6Vp+qunkr/Dh7w3Xwr5xAl04nEb9Lhzyx9SHM4ic6QQyh7Hafegj9mRQneg",
+	"z5mQ81Q6hZdV3WEMlFP0oNyR18fkUIkGnSl3GWPdmw6VJuNmXbhrSg4VDizkOuEsNfZ6Tg/fXnKd7AI2",
+	"OS4XCgWeuVD22d2S7uqT4UJpigSciDv250KhcJK5UHrgkU+WC4Uzm8yFknGe/eFCSa6S9eFCIR8narve",
+	"I7/Zwy4TZq9yJz1ml8kuUBvH4zqJBp3drjMhkwmZdF0nMUKmadWmNMJEYop+N60a0i214gzFC4FYTUMH",
+	"z724BCx8AYUlC2VNI7adijxxaIwd6+j5Kl36dNh2bi0/wFALfG5LK/gk9IY8L3PBEKgCt8147UC8ds3n",
+	"vqBrGzho5O3AQeMlWeQnbV53SgWwcxZ5sJxmTrv2nsZwDNHabt/OxM4qNr0u211HXXuKO7VWocBjlSR6",
+	"+6+RdYx967qohqyr1quwxPS6gUN8TlfhEhlpLb8bNGDRij1WI2oCRPbVKSKHwDkAXY6SgUykKxMVrKC4",
+	"0sbkJXD65FFwERlqla8JoF+FaxGTl4+xRyPXUTzouyyeI4RdNI8nzwHdGGuvXeF270VkzV/u1SVoTsNR",
+	"54PS+2r00XiBPqcjUaWClRH0hI5MxLBvTp88Oo4D4gLfjedDQC76eLwgsqBM6R6G0h2kaskBIe/T67PM",
+	"pHkcVWFTswB7I5fPNQ0tN5ebgg116mKRXKL+7wC83CxgPj4CAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
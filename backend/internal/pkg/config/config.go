@@ -59,11 +59,19 @@ type Config struct {
 	AwsAccessKeyID     string
 	AwsSecretAccessKey string
 
+	// Object storage configuration: "s3" (default) or "local"
+	StorageBackend  string
+	LocalStorageDir string
+
 	// SSH configuration
 	SSHUser                                 string
 	SSHHost                                 string
 	SSHPort                                 string
 	SSHPassword                             string
+	SSHPrivateKeyPath                       string
+	SSHPrivateKeyPassphrase                 string
+	SSHKnownHostsPath                       string
+	SSHTimeoutSeconds                       int
 	RemoteDir                               string
 	TransactionDetailsNoShippingRelatedPath string
 	TransactionDetailsSummaryPath           string
@@ -71,12 +79,44 @@ type Config struct {
 	TopUpDetailsPath                        string
 	TopUpSummaryDetailsPath                 string
 	TopUpReportPath                         string
+	TopUpSummaryDetailsEncoding             string // "auto" (default), "utf-8" or "shift_jis"
+	TopUpReportEncoding                     string // "auto" (default), "utf-8" or "shift_jis"
+	TopUpDetailsEncoding                    string // "auto" (default), "utf-8" or "shift_jis"
+	TransactionDetailsEncoding              string // "auto" (default), "utf-8" or "shift_jis"; all transaction details folders
+	PayinImportErrorPolicy                  string // "reject_file" (default), "skip_row" or "warn"
+	PaypayPaymentMethodAliases              string // labels added to the default payment method labels, e.g. "PayPayカード=8,d払い=1"
 	ValidInvoicesPath                       string
 	ValidInvoicesDuplicatePath              string
 	ValidInvoicesSpreadsheetsPath           string
 	LocalDir                                string
 
 	ProviderID int
+
+	// Aozora Net Bank API configuration
+	AozoraAPIBaseURL        string
+	AozoraAccessToken       string
+	AozoraAccountID         string
+	AozoraAPITimeoutSeconds int
+
+	// Zengin transfer file requester configuration
+	ZenginRequesterCode string
+	ZenginRequesterName string
+	ZenginBankCode      string
+	ZenginBankName      string
+	ZenginBranchCode    string
+	ZenginBranchName    string
+	ZenginAccountType   int
+	ZenginAccountNo     string
+
+	// Approval configuration
+	PayoutApprovalWorkflowID int
+
+	// Payin aggregation configuration
+	PayoutTransferFee int
+	PayoutBatchUserID int
+
+	// Batch lock configuration: a lease that is not renewed within the TTL is taken over by another process
+	BatchLeaseTTLSeconds int
 }
 
 var (
@@ -95,21 +135,28 @@ func LoadConfig() *Config {
 		}
 
 		configInstance = &Config{
-			ServerHost:             "0.0.0.0",
-			ServerPort:             "8080",
-			LogLevel:               "warn",
-			LogDirectory:           "/app/logs",
-			EnableConsoleLog:       true,
-			EnableSQLLog:           false,
-			SqlLogLevel:            sqlLogLevel,
-			JWTDurationHour:        24,
-			MFATokenExpiryMinutes:  30,
-			MFATokenResendInterval: 1,
-			EmailTemplateDir:       "internal/resource/templates/email",
-			SMTPFromName:           "Makeshop Payment",
-			SMTPUseAuth:            true,
-			SMTPUseTLS:             true,
-			ProviderID:             1,
+			ServerHost:               "0.0.0.0",
+			ServerPort:               "8080",
+			LogLevel:                 "warn",
+			LogDirectory:             "/app/logs",
+			EnableConsoleLog:         true,
+			EnableSQLLog:             false,
+			SqlLogLevel:              sqlLogLevel,
+			JWTDurationHour:          24,
+			MFATokenExpiryMinutes:    30,
+			MFATokenResendInterval:   1,
+			EmailTemplateDir:         "internal/resource/templates/email",
+			SMTPFromName:             "Makeshop Payment",
+			SMTPUseAuth:              true,
+			SMTPUseTLS:               true,
+			ProviderID:               1,
+			PayoutApprovalWorkflowID: 1,
+			AozoraAPITimeoutSeconds:  30,
+			ZenginAccountType:        1,
+			PayoutBatchUserID:        1,
+			SSHTimeoutSeconds:        30,
+			StorageBackend:           "s3",
+			BatchLeaseTTLSeconds:     300,
 		}
 
 		envVars := map[string]*string{
@@ -151,6 +198,27 @@ func LoadConfig() *Config {
 			"VALID_INVOICES_PATH":                          &configInstance.ValidInvoicesPath,
 			"VALID_INVOICES_DUPLICATE_PATH":                &configInstance.ValidInvoicesDuplicatePath,
 			"VALID_INVOICES_SPREADSHEETS_PATH":             &configInstance.ValidInvoicesSpreadsheetsPath,
+			"SSH_PRIVATE_KEY_PATH":                         &configInstance.SSHPrivateKeyPath,
+			"SSH_PRIVATE_KEY_PASSPHRASE":                   &configInstance.SSHPrivateKeyPassphrase,
+			"SSH_KNOWN_HOSTS_PATH":                         &configInstance.SSHKnownHostsPath,
+			"STORAGE_BACKEND":                              &configInstance.StorageBackend,
+			"LOCAL_STORAGE_DIR":                            &configInstance.LocalStorageDir,
+			"TOP_UP_SUMMARY_DETAILS_ENCODING":              &configInstance.TopUpSummaryDetailsEncoding,
+			"TOP_UP_REPORT_ENCODING":                       &configInstance.TopUpReportEncoding,
+			"TOP_UP_DETAILS_ENCODING":                      &configInstance.TopUpDetailsEncoding,
+			"TRANSACTION_DETAILS_ENCODING":                 &configInstance.TransactionDetailsEncoding,
+			"PAYIN_IMPORT_ERROR_POLICY":                    &configInstance.PayinImportErrorPolicy,
+			"PAYPAY_PAYMENT_METHOD_ALIASES":                &configInstance.PaypayPaymentMethodAliases,
+			"AOZORA_API_BASE_URL":                          &configInstance.AozoraAPIBaseURL,
+			"AOZORA_ACCESS_TOKEN":                          &configInstance.AozoraAccessToken,
+			"AOZORA_ACCOUNT_ID":                            &configInstance.AozoraAccountID,
+			"ZENGIN_REQUESTER_CODE":                        &configInstance.ZenginRequesterCode,
+			"ZENGIN_REQUESTER_NAME":                        &configInstance.ZenginRequesterName,
+			"ZENGIN_BANK_CODE":                             &configInstance.ZenginBankCode,
+			"ZENGIN_BANK_NAME":                             &configInstance.ZenginBankName,
+			"ZENGIN_BRANCH_CODE":                           &configInstance.ZenginBranchCode,
+			"ZENGIN_BRANCH_NAME":                           &configInstance.ZenginBranchName,
+			"ZENGIN_ACCOUNT_NO":                            &configInstance.ZenginAccountNo,
 		}
 
 		for env, field := range envVars {
@@ -177,8 +245,15 @@ func LoadConfig() *Config {
 		}
 
 		intVars := map[string]*int{
-			"JWT_EXPIRATION_HOURS": &configInstance.JWTDurationHour,
-			"SMTP_PORT":            &configInstance.SMTPPort,
+			"JWT_EXPIRATION_HOURS":        &configInstance.JWTDurationHour,
+			"SMTP_PORT":                   &configInstance.SMTPPort,
+			"PAYOUT_APPROVAL_WORKFLOW_ID": &configInstance.PayoutApprovalWorkflowID,
+			"AOZORA_API_TIMEOUT_SECONDS":  &configInstance.AozoraAPITimeoutSeconds,
+			"ZENGIN_ACCOUNT_TYPE":         &configInstance.ZenginAccountType,
+			"PAYOUT_TRANSFER_FEE":         &configInstance.PayoutTransferFee,
+			"PAYOUT_BATCH_USER_ID":        &configInstance.PayoutBatchUserID,
+			"SSH_TIMEOUT_SECONDS":         &configInstance.SSHTimeoutSeconds,
+			"BATCH_LEASE_TTL_SECONDS":     &configInstance.BatchLeaseTTLSeconds,
 		}
 
 		for env, field := range intVars {
@@ -74,4 +74,50 @@ const (
 	MsgUpdateUserFailed = "ユーザーを更新できませんでした"
 	MsgDeleteUserFailed = "ユーザーを削除できませんでした"
 	MsgGetUserFailed    = "ユーザーを取得できませんでした"
+
+	// payout related error messages
+	MsgPayoutNotFound      = "出金が見つかりません"
+	MsgGetPayoutFailed     = "出金を取得できませんでした"
+	MsgCreatePayoutFailed  = "出金を作成できませんでした"
+	MsgUpdatePayoutFailed  = "出金を更新できませんでした"
+	MsgDeletePayoutFailed  = "出金を削除できませんでした"
+	MsgSubmitPayoutFailed  = "出金の承認申請ができませんでした"
+	MsgApprovePayoutFailed = "出金を承認できませんでした"
+	MsgRejectPayoutFailed  = "出金を却下できませんでした"
+	MsgExportZenginFailed  = "全銀振込ファイルを出力できませんでした"
+
+	// merchant related error messages
+	MsgMerchantNotFound                 = "加盟店が見つかりません"
+	MsgGetMerchantFailed                = "加盟店を取得できませんでした"
+	MsgCreateMerchantFailed             = "加盟店を作成できませんでした"
+	MsgUpdateMerchantFailed             = "加盟店を更新できませんでした"
+	MsgDeleteMerchantFailed             = "加盟店を削除できませんでした"
+	MsgMerchantBankAccountNotFound      = "加盟店口座が見つかりません"
+	MsgListMerchantBankAccountsFailed   = "加盟店口座一覧を取得できませんでした"
+	MsgGetMerchantBankAccountFailed     = "加盟店口座を取得できませんでした"
+	MsgRequestMerchantBankAccountFailed = "加盟店口座の変更を申請できませんでした"
+	MsgConfirmMerchantBankAccountFailed = "加盟店口座の変更を確認できませんでした"
+	MsgRejectMerchantBankAccountFailed  = "加盟店口座の変更を却下できませんでした"
+
+	// merchant review status upload error messages
+	MsgUploadMerchantReviewStatusesFailed = "加盟店審査状況をアップロードできませんでした"
+	MsgUploadFileRequired                 = "アップロードファイルを指定してください"
+	MsgUploadFileTooLarge                 = "アップロードファイルのサイズが上限を超えています"
+
+	// batch job run related error messages
+	MsgBatchJobRunNotFound             = "バッチ実行履歴が見つかりません"
+	MsgListBatchJobRunsFailed          = "バッチ実行履歴一覧を取得できませんでした"
+	MsgGetBatchJobRunFailed            = "バッチ実行履歴を取得できませんでした"
+	MsgListBatchJobRunPayinFilesFailed = "バッチ実行の入金ファイル一覧を取得できませんでした"
+
+	// payin file related error messages
+	MsgPayinFileNotFound                     = "入金ファイルが見つかりません"
+	MsgListPayinFileImportErrorsFailed       = "入金ファイルの取り込みエラー一覧を取得できませんでした"
+	MsgGetPayinFilePaymentMethodTotalsFailed = "入金ファイルの支払い方法別集計を取得できませんでした"
+
+	// invoice related error messages
+	MsgInvoiceNotFound       = "適格請求書が見つかりません"
+	MsgListInvoicesFailed    = "適格請求書一覧を取得できませんでした"
+	MsgGetInvoiceFailed      = "適格請求書を取得できませんでした"
+	MsgDownloadInvoiceFailed = "適格請求書をダウンロードできませんでした"
 )
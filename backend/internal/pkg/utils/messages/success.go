@@ -22,10 +22,44 @@ const (
 	MsgAuthCodeSentSuccess = "認証コードが正常に送信されました"
 
 	// merchant related success messages
-	MsgListMerchantsSuccess = "加盟店一覧を取得しました"
+	MsgListMerchantsSuccess  = "加盟店一覧を取得しました"
+	MsgGetMerchantSuccess    = "加盟店を取得しました"
+	MsgCreateMerchantSuccess = "加盟店を作成しました"
+	MsgUpdateMerchantSuccess = "加盟店を更新しました"
+	MsgDeleteMerchantSuccess = "加盟店を削除しました"
+
+	// merchant review status upload success messages
+	MsgUploadMerchantReviewStatusesSuccess = "加盟店審査状況をアップロードしました"
+
+	// merchant bank account related success messages
+	MsgListMerchantBankAccountsSuccess   = "加盟店口座一覧を取得しました"
+	MsgGetMerchantBankAccountSuccess     = "加盟店口座を取得しました"
+	MsgRequestMerchantBankAccountSuccess = "加盟店口座の変更を申請しました"
+	MsgConfirmMerchantBankAccountSuccess = "加盟店口座の変更を確認しました"
+	MsgRejectMerchantBankAccountSuccess  = "加盟店口座の変更を却下しました"
 
 	// payout related success messages
-	MsgListPayoutsSuccess = "出金履歴の取得に成功しました"
+	MsgListPayoutsSuccess   = "出金履歴の取得に成功しました"
+	MsgGetPayoutSuccess     = "出金を取得しました"
+	MsgCreatePayoutSuccess  = "出金を作成しました"
+	MsgUpdatePayoutSuccess  = "出金を更新しました"
+	MsgDeletePayoutSuccess  = "出金を削除しました"
+	MsgSubmitPayoutSuccess  = "出金の承認申請をしました"
+	MsgApprovePayoutSuccess = "出金を承認しました"
+	MsgRejectPayoutSuccess  = "出金を却下しました"
+
+	// batch job run related success messages
+	MsgListBatchJobRunsSuccess          = "バッチ実行履歴一覧を取得しました"
+	MsgGetBatchJobRunSuccess            = "バッチ実行履歴を取得しました"
+	MsgListBatchJobRunPayinFilesSuccess = "バッチ実行の入金ファイル一覧を取得しました"
+
+	// payin file related success messages
+	MsgListPayinFileImportErrorsSuccess       = "入金ファイルの取り込みエラー一覧を取得しました"
+	MsgGetPayinFilePaymentMethodTotalsSuccess = "入金ファイルの支払い方法別集計を取得しました"
+
+	// invoice related success messages
+	MsgListInvoicesSuccess = "適格請求書一覧を取得しました"
+	MsgGetInvoiceSuccess   = "適格請求書を取得しました"
 
 	// User related success messages
 	MsgListUsersSuccess  = "ユーザー一覧を取得しました"
@@ -11,6 +11,9 @@ import (
 	"github.com/labstack/echo/v4"
 
 	auditLogController "github.com/huydq/test/internal/controller/audit_log"
+	batchJobRunController "github.com/huydq/test/internal/controller/batch_job_run"
+	invoiceController "github.com/huydq/test/internal/controller/invoice"
+	payinFileController "github.com/huydq/test/internal/controller/payin_file"
 	permissionController "github.com/huydq/test/internal/controller/permission"
 	roleController "github.com/huydq/test/internal/controller/role"
 	"github.com/huydq/test/internal/controller/user"
@@ -26,6 +29,9 @@ func SetupRoutes(
 	roleController *roleController.RoleController,
 	permissionController *permissionController.PermissionController,
 	auditLogController *auditLogController.AuditLogController,
+	batchJobRunController *batchJobRunController.BatchJobRunController,
+	payinFileController *payinFileController.PayinFileController,
+	invoiceController *invoiceController.InvoiceController,
 	middlewareManager *middleware.MiddlewareManager,
 ) {
 	if os.Getenv("API_ENV") != "production" {
@@ -59,12 +65,43 @@ func SetupRoutes(
 		userGroup.GET("/users/:id", userController.GetUserByID)
 		userGroup.DELETE("/users/:id", userController.DeleteUser)
 		// Merchant management routes
-		merchantGroup := adminGroup.Group("/merchants", middlewareManager.RoutePermissions(permissionObject.PermissionCodeUserManage))
+		merchantGroup := adminGroup.Group("/merchants", middlewareManager.RoutePermissions(permissionObject.PermissionCodeMerchantManage))
 		merchantGroup.GET("", merchantController.ListMerchants)
+		merchantGroup.GET("/:id", merchantController.GetMerchant)
+		merchantGroup.POST("/review-statuses/upload", merchantController.UploadReviewStatuses, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantStatusUpload).AsMiddleware())
+		merchantGroup.POST("/create", merchantController.CreateMerchant, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantCreate).AsMiddleware())
+		merchantGroup.PUT("/:id/update", merchantController.UpdateMerchant, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantUpdate).AsMiddleware())
+		merchantGroup.DELETE("/:id/delete", merchantController.DeleteMerchant, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantDelete).AsMiddleware())
+		merchantGroup.GET("/:id/bank-accounts", merchantController.ListBankAccounts)
+		merchantGroup.GET("/:id/bank-accounts/:accountId", merchantController.GetBankAccount)
+		merchantGroup.POST("/:id/bank-accounts", merchantController.CreateBankAccount, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantBankAccountRequest).AsMiddleware())
+		merchantGroup.PUT("/:id/bank-accounts/:accountId", merchantController.UpdateBankAccount, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantBankAccountRequest).AsMiddleware())
+		merchantGroup.DELETE("/:id/bank-accounts/:accountId", merchantController.DeleteBankAccount, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantBankAccountRequest).AsMiddleware())
+		merchantGroup.POST("/:id/bank-accounts/:accountId/confirm", merchantController.ConfirmBankAccount, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantBankAccountConfirm).AsMiddleware())
+		merchantGroup.POST("/:id/bank-accounts/:accountId/reject", merchantController.RejectBankAccount, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeMerchantBankAccountReject).AsMiddleware())
 
 		// Payout management routes
 		payoutGroup := adminGroup.Group("/payouts")
-		payoutGroup.GET("", payoutController.ListPayouts)
+		{
+			payoutGroup.GET("", payoutController.ListPayouts)
+			payoutGroup.GET("/:id", payoutController.GetPayout)
+
+			payoutOperationGroup := payoutGroup.Group("", middlewareManager.RoutePermissions(permissionObject.PermissionCodeManualTransfer))
+			payoutOperationGroup.POST("/create", payoutController.CreatePayout, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutRequest).AsMiddleware())
+			payoutOperationGroup.PUT("/:id/update", payoutController.UpdatePayout, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutUpdate).AsMiddleware())
+			payoutOperationGroup.DELETE("/:id/delete", payoutController.DeletePayout, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutDelete).AsMiddleware())
+			payoutOperationGroup.POST("/:id/submit", payoutController.SubmitPayout, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutRequest).AsMiddleware())
+
+			payoutApprovalGroup := payoutGroup.Group("", middlewareManager.RoutePermissions(
+				permissionObject.PermissionCodeTransferApproveBusiness,
+				permissionObject.PermissionCodeTransferApproveAccountant,
+			))
+			payoutApprovalGroup.POST("/:id/approve", payoutController.ApprovePayout, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutApproval).AsMiddleware())
+			payoutApprovalGroup.POST("/:id/reject", payoutController.RejectPayout, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutReject).AsMiddleware())
+
+			payoutTransferFileGroup := payoutGroup.Group("", middlewareManager.RoutePermissions(permissionObject.PermissionCodeManualTransfer))
+			payoutTransferFileGroup.GET("/:id/zengin-file", payoutController.DownloadZenginTransferFile, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypePayoutFileDownload).AsMiddleware())
+		}
 
 		// Role routes
 		roleGroup := adminGroup.Group("/roles", middlewareManager.RoutePermissions(permissionObject.PermissionCodeUserManage))
@@ -89,5 +126,28 @@ func SetupRoutes(
 			auditLogGroup.GET("", auditLogController.ListAuditLogs)
 			auditLogGroup.GET("/users", auditLogController.GetAuditLogUsers)
 		}
+
+		// Batch job run routes
+		batchJobRunGroup := adminGroup.Group("/batch-job-runs", middlewareManager.RoutePermissions(permissionObject.PermissionCodeSystemLogView))
+		{
+			batchJobRunGroup.GET("", batchJobRunController.ListBatchJobRuns)
+			batchJobRunGroup.GET("/:id", batchJobRunController.GetBatchJobRun)
+			batchJobRunGroup.GET("/:id/payin-files", batchJobRunController.ListBatchJobRunPayinFiles)
+		}
+
+		// Payin file routes
+		payinFileGroup := adminGroup.Group("/payin-files", middlewareManager.RoutePermissions(permissionObject.PermissionCodeSystemLogView))
+		{
+			payinFileGroup.GET("/:id/import-errors", payinFileController.ListPayinFileImportErrors)
+			payinFileGroup.GET("/:id/payment-method-totals", payinFileController.GetPayinFilePaymentMethodTotals)
+		}
+
+		// Invoice routes
+		invoiceGroup := adminGroup.Group("/invoices", middlewareManager.RoutePermissions(permissionObject.PermissionCodeInvoiceView))
+		{
+			invoiceGroup.GET("", invoiceController.ListInvoices)
+			invoiceGroup.GET("/:id", invoiceController.GetInvoice)
+			invoiceGroup.GET("/:id/download", invoiceController.DownloadInvoice, middlewareManager.AuditLogger.WithType(auditLogObject.AuditLogTypeInvoiceDownload).AsMiddleware())
+		}
 	}
 }
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	model "github.com/huydq/test/internal/domain/model/batch_job_run"
+	payinModel "github.com/huydq/test/internal/domain/model/payin"
+	repository "github.com/huydq/test/internal/domain/repository/batch_job_run"
+)
+
+var ErrBatchJobRunNotFound = errors.New("バッチ実行履歴が見つかりません")
+
+type BatchJobRunUsecase interface {
+	ListBatchJobRuns(ctx context.Context, input *inputdata.BatchJobRunListInputData) ([]*model.BatchJobRun, int, int, error)
+	GetBatchJobRun(ctx context.Context, id int) (*model.BatchJobRun, error)
+	ListBatchJobRunPayinFiles(ctx context.Context, id int) ([]*payinModel.PayinFile, error)
+}
+
+type batchJobRunUsecaseImpl struct {
+	batchJobRunRepo repository.BatchJobRunRepository
+}
+
+func NewBatchJobRunUsecase(batchJobRunRepo repository.BatchJobRunRepository) BatchJobRunUsecase {
+	return &batchJobRunUsecaseImpl{
+		batchJobRunRepo: batchJobRunRepo,
+	}
+}
+
+// ListBatchJobRuns lists batch job runs with optional filtering and pagination
+func (uc *batchJobRunUsecaseImpl) ListBatchJobRuns(ctx context.Context, input *inputdata.BatchJobRunListInputData) ([]*model.BatchJobRun, int, int, error) {
+	const (
+		defaultPage     = 1
+		defaultPageSize = 10
+	)
+
+	if input.Page <= 0 {
+		input.Page = defaultPage
+	}
+
+	if input.PageSize <= 0 {
+		input.PageSize = defaultPageSize
+	}
+
+	return uc.batchJobRunRepo.ListBatchJobRuns(ctx, input)
+}
+
+// GetBatchJobRun gets a batch job run by its ID
+func (uc *batchJobRunUsecaseImpl) GetBatchJobRun(ctx context.Context, id int) (*model.BatchJobRun, error) {
+	run, err := uc.batchJobRunRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, ErrBatchJobRunNotFound
+	}
+
+	return run, nil
+}
+
+// ListBatchJobRunPayinFiles lists the payin files touched by a batch job run
+func (uc *batchJobRunUsecaseImpl) ListBatchJobRunPayinFiles(ctx context.Context, id int) ([]*payinModel.PayinFile, error) {
+	if _, err := uc.GetBatchJobRun(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return uc.batchJobRunRepo.ListPayinFiles(ctx, id)
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	model "github.com/huydq/test/internal/domain/model/paypay"
+	repository "github.com/huydq/test/internal/domain/repository/invoice"
+)
+
+var ErrInvoiceNotFound = errors.New("適格請求書が見つかりません")
+
+// InvoiceFile is the PDF of an invoice
+type InvoiceFile struct {
+	FileName string
+	Content  []byte
+}
+
+type InvoiceUsecase interface {
+	ListInvoices(ctx context.Context, input *inputdata.InvoiceListInputData) ([]*model.PaypayInvoice, int, int, error)
+	GetInvoice(ctx context.Context, id int) (*model.PaypayInvoice, error)
+	DownloadInvoice(ctx context.Context, id int) (*model.PaypayInvoice, *InvoiceFile, error)
+}
+
+type invoiceUsecaseImpl struct {
+	invoiceRepo   repository.InvoiceRepository
+	invoiceFiles  repository.InvoiceFileStorage
+	storageBucket string
+}
+
+func NewInvoiceUsecase(invoiceRepo repository.InvoiceRepository, invoiceFiles repository.InvoiceFileStorage, storageBucket string) InvoiceUsecase {
+	return &invoiceUsecaseImpl{
+		invoiceRepo:   invoiceRepo,
+		invoiceFiles:  invoiceFiles,
+		storageBucket: storageBucket,
+	}
+}
+
+// ListInvoices lists the invoices with optional filtering and pagination
+func (uc *invoiceUsecaseImpl) ListInvoices(ctx context.Context, input *inputdata.InvoiceListInputData) ([]*model.PaypayInvoice, int, int, error) {
+	const (
+		defaultPage     = 1
+		defaultPageSize = 10
+	)
+
+	if input.Page <= 0 {
+		input.Page = defaultPage
+	}
+
+	if input.PageSize <= 0 {
+		input.PageSize = defaultPageSize
+	}
+
+	return uc.invoiceRepo.ListInvoices(ctx, input)
+}
+
+// GetInvoice gets an invoice by its ID
+func (uc *invoiceUsecaseImpl) GetInvoice(ctx context.Context, id int) (*model.PaypayInvoice, error) {
+	invoice, err := uc.invoiceRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return invoice, nil
+}
+
+// DownloadInvoice reads the PDF of an invoice from the storage
+func (uc *invoiceUsecaseImpl) DownloadInvoice(ctx context.Context, id int) (*model.PaypayInvoice, *InvoiceFile, error) {
+	invoice, err := uc.GetInvoice(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream, err := uc.invoiceFiles.DownloadStream(ctx, uc.storageBucket, invoice.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stream.Close()
+
+	content, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invoice, &InvoiceFile{FileName: invoice.FileName, Content: content}, nil
+}
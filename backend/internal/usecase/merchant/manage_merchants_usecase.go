@@ -2,23 +2,39 @@ package merchant
 
 import (
 	"context"
+	"errors"
 
 	"github.com/huydq/test/internal/datastructure/inputdata"
+	"github.com/huydq/test/internal/datastructure/outputdata"
 	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
 	merchantRepo "github.com/huydq/test/internal/domain/repository/merchant"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/pkg/database"
 )
 
+var ErrPaymentMerchantIDAlreadyExists = errors.New("この決済会社の加盟店IDは既に登録されています")
+
 type MerchantManagementUsecase interface {
 	ListMerchants(ctx context.Context, input *inputdata.MerchantListInputData) ([]*merchantModel.Merchant, int, int, error)
+	GetMerchant(ctx context.Context, id int) (*merchantModel.Merchant, error)
+	CreateMerchant(ctx context.Context, input *inputdata.MerchantInputData) (*merchantModel.Merchant, error)
+	UpdateMerchant(ctx context.Context, id int, input *inputdata.MerchantInputData) (*merchantModel.Merchant, error)
+	DeleteMerchant(ctx context.Context, id int) error
+	UploadReviewStatuses(ctx context.Context, input *inputdata.MerchantReviewStatusUploadInputData) (*outputdata.MerchantReviewStatusUploadOutput, error)
 }
 
 type ManageMerchantsUsecase struct {
-	merchantRepo merchantRepo.MerchantRepository
+	merchantRepo                   merchantRepo.MerchantRepository
+	merchantReviewStatusCSVService service.MerchantReviewStatusCSVService
 }
 
-func NewManageMerchantsUsecase(merchantRepo merchantRepo.MerchantRepository) *ManageMerchantsUsecase {
+func NewManageMerchantsUsecase(
+	merchantRepo merchantRepo.MerchantRepository,
+	merchantReviewStatusCSVService service.MerchantReviewStatusCSVService,
+) *ManageMerchantsUsecase {
 	return &ManageMerchantsUsecase{
-		merchantRepo: merchantRepo,
+		merchantRepo:                   merchantRepo,
+		merchantReviewStatusCSVService: merchantReviewStatusCSVService,
 	}
 }
 
@@ -39,3 +55,92 @@ func (uc *ManageMerchantsUsecase) ListMerchants(ctx context.Context, input *inpu
 
 	return uc.merchantRepo.ListMerchants(ctx, input)
 }
+
+// GetMerchant gets a merchant together with its latest payment provider review
+func (uc *ManageMerchantsUsecase) GetMerchant(ctx context.Context, id int) (*merchantModel.Merchant, error) {
+	merchant, err := uc.merchantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if merchant == nil {
+		return nil, ErrMerchantNotFound
+	}
+
+	return merchant, nil
+}
+
+// CreateMerchant creates a merchant; the payment merchant ID must be unique per payment provider
+func (uc *ManageMerchantsUsecase) CreateMerchant(ctx context.Context, input *inputdata.MerchantInputData) (*merchantModel.Merchant, error) {
+	tx, err := database.NewTx[*merchantModel.Merchant](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.Merchant, error) {
+		if err := uc.ensurePaymentMerchantIDAvailable(ctx, input, 0); err != nil {
+			return nil, err
+		}
+
+		merchant := &merchantModel.Merchant{}
+		applyMerchantInput(merchant, input)
+		if err := uc.merchantRepo.Create(ctx, merchant); err != nil {
+			return nil, err
+		}
+
+		return uc.merchantRepo.FindByID(ctx, merchant.ID)
+	})
+}
+
+// UpdateMerchant updates a merchant; the payment merchant ID must stay unique per payment provider
+func (uc *ManageMerchantsUsecase) UpdateMerchant(ctx context.Context, id int, input *inputdata.MerchantInputData) (*merchantModel.Merchant, error) {
+	tx, err := database.NewTx[*merchantModel.Merchant](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.Merchant, error) {
+		merchant, err := uc.GetMerchant(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.ensurePaymentMerchantIDAvailable(ctx, input, id); err != nil {
+			return nil, err
+		}
+
+		applyMerchantInput(merchant, input)
+		if err := uc.merchantRepo.Update(ctx, merchant); err != nil {
+			return nil, err
+		}
+
+		return uc.merchantRepo.FindByID(ctx, id)
+	})
+}
+
+// DeleteMerchant soft-deletes a merchant
+func (uc *ManageMerchantsUsecase) DeleteMerchant(ctx context.Context, id int) error {
+	if _, err := uc.GetMerchant(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.merchantRepo.Delete(ctx, id)
+}
+
+func (uc *ManageMerchantsUsecase) ensurePaymentMerchantIDAvailable(ctx context.Context, input *inputdata.MerchantInputData, excludeID int) error {
+	exists, err := uc.merchantRepo.ExistsByPaymentMerchantID(ctx, input.PaymentProviderID, input.PaymentMerchantID, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrPaymentMerchantIDAlreadyExists
+	}
+
+	return nil
+}
+
+func applyMerchantInput(merchant *merchantModel.Merchant, input *inputdata.MerchantInputData) {
+	merchant.PaymentProviderID = input.PaymentProviderID
+	merchant.PaymentMerchantID = input.PaymentMerchantID
+	merchant.MerchantName = input.MerchantName
+	merchant.ShopID = input.ShopID
+	merchant.ShopURL = input.ShopURL
+}
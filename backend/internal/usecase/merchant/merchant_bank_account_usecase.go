@@ -0,0 +1,347 @@
+package merchant
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	payoutObject "github.com/huydq/test/internal/domain/object/payout"
+	merchantRepo "github.com/huydq/test/internal/domain/repository/merchant"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+// accountNameMaxLength is the length of the account name field of a Zengin data record
+const accountNameMaxLength = 30
+
+var (
+	ErrMerchantNotFound                = errors.New("加盟店が見つかりません")
+	ErrBankAccountNotFound             = errors.New("加盟店口座が見つかりません")
+	ErrInvalidBankAccountType          = errors.New("口座種別は普通預金または当座預金である必要があります")
+	ErrInvalidAccountName              = errors.New("口座名義は全銀で使用できる半角文字で30文字以内である必要があります")
+	ErrBankAccountAlreadyRegistered    = errors.New("有効な口座が既に登録されています。変更を申請してください")
+	ErrBankAccountNotActive            = errors.New("有効な口座ではありません")
+	ErrBankAccountChangePending        = errors.New("確認待ちの口座変更が既に存在します")
+	ErrBankAccountChangeNotPending     = errors.New("確認待ちの口座変更ではありません")
+	ErrBankAccountSelfConfirmation     = errors.New("口座変更は申請者以外のユーザーが確認する必要があります")
+	ErrBankAccountPreviousNotAvailable = errors.New("変更前の口座が有効ではないため確認できません")
+)
+
+type MerchantBankAccountUsecase interface {
+	ListBankAccounts(ctx context.Context, merchantID int) ([]*merchantModel.MerchantBankAccount, error)
+	GetBankAccount(ctx context.Context, merchantID int, id int) (*merchantModel.MerchantBankAccount, error)
+	RequestCreate(ctx context.Context, input *inputdata.MerchantBankAccountInputData) (*merchantModel.MerchantBankAccount, error)
+	RequestUpdate(ctx context.Context, id int, input *inputdata.MerchantBankAccountInputData) (*merchantModel.MerchantBankAccount, error)
+	RequestDelete(ctx context.Context, merchantID int, id int, userID int) (*merchantModel.MerchantBankAccount, error)
+	Confirm(ctx context.Context, merchantID int, id int, userID int) (*merchantModel.MerchantBankAccount, error)
+	Reject(ctx context.Context, merchantID int, id int, userID int) (*merchantModel.MerchantBankAccount, error)
+}
+
+type merchantBankAccountUsecaseImpl struct {
+	merchantRepo    merchantRepo.MerchantRepository
+	bankAccountRepo merchantRepo.MerchantBankAccountRepository
+}
+
+func NewMerchantBankAccountUsecase(
+	merchantRepo merchantRepo.MerchantRepository,
+	bankAccountRepo merchantRepo.MerchantBankAccountRepository,
+) MerchantBankAccountUsecase {
+	return &merchantBankAccountUsecaseImpl{
+		merchantRepo:    merchantRepo,
+		bankAccountRepo: bankAccountRepo,
+	}
+}
+
+// ListBankAccounts lists the bank account history of a merchant, newest first
+func (u *merchantBankAccountUsecaseImpl) ListBankAccounts(ctx context.Context, merchantID int) ([]*merchantModel.MerchantBankAccount, error) {
+	if err := u.ensureMerchantExists(ctx, merchantID); err != nil {
+		return nil, err
+	}
+
+	return u.bankAccountRepo.ListByMerchantID(ctx, merchantID)
+}
+
+// GetBankAccount gets a bank account of a merchant
+func (u *merchantBankAccountUsecaseImpl) GetBankAccount(ctx context.Context, merchantID int, id int) (*merchantModel.MerchantBankAccount, error) {
+	if err := u.ensureMerchantExists(ctx, merchantID); err != nil {
+		return nil, err
+	}
+
+	return u.getBankAccount(ctx, merchantID, id)
+}
+
+// RequestCreate requests the registration of a merchant's first bank account.
+// The account is not used for payouts until another user confirms it.
+func (u *merchantBankAccountUsecaseImpl) RequestCreate(ctx context.Context, input *inputdata.MerchantBankAccountInputData) (*merchantModel.MerchantBankAccount, error) {
+	if err := validateBankAccountInput(input); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.NewTx[*merchantModel.MerchantBankAccount](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.MerchantBankAccount, error) {
+		if err := u.ensureNoPendingChange(ctx, input.MerchantID); err != nil {
+			return nil, err
+		}
+
+		active, err := u.bankAccountRepo.FindByStatus(ctx, input.MerchantID, merchantObject.BankAccountStatusActive)
+		if err != nil {
+			return nil, err
+		}
+		if active != nil {
+			return nil, ErrBankAccountAlreadyRegistered
+		}
+
+		account := newBankAccountRequest(input, merchantObject.BankAccountChangeTypeCreate)
+		if err := u.bankAccountRepo.Create(ctx, account); err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	})
+}
+
+// RequestUpdate requests a change of the merchant's active bank account
+func (u *merchantBankAccountUsecaseImpl) RequestUpdate(ctx context.Context, id int, input *inputdata.MerchantBankAccountInputData) (*merchantModel.MerchantBankAccount, error) {
+	if err := validateBankAccountInput(input); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.NewTx[*merchantModel.MerchantBankAccount](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.MerchantBankAccount, error) {
+		current, err := u.prepareChange(ctx, input.MerchantID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		account := newBankAccountRequest(input, merchantObject.BankAccountChangeTypeUpdate)
+		account.PreviousBankAccountID = &current.ID
+		if err := u.bankAccountRepo.Create(ctx, account); err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	})
+}
+
+// RequestDelete requests the deletion of the merchant's active bank account
+func (u *merchantBankAccountUsecaseImpl) RequestDelete(ctx context.Context, merchantID int, id int, userID int) (*merchantModel.MerchantBankAccount, error) {
+	tx, err := database.NewTx[*merchantModel.MerchantBankAccount](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.MerchantBankAccount, error) {
+		current, err := u.prepareChange(ctx, merchantID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		account := current.NewDeletionRequest(userID)
+		if err := u.bankAccountRepo.Create(ctx, account); err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	})
+}
+
+// Confirm applies a pending change. The confirming user must differ from the requester so that
+// a single account cannot redirect payouts on its own; the replaced account is kept as history.
+func (u *merchantBankAccountUsecaseImpl) Confirm(ctx context.Context, merchantID int, id int, userID int) (*merchantModel.MerchantBankAccount, error) {
+	tx, err := database.NewTx[*merchantModel.MerchantBankAccount](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.MerchantBankAccount, error) {
+		if err := u.lockMerchant(ctx, merchantID); err != nil {
+			return nil, err
+		}
+
+		account, err := u.getPendingBankAccount(ctx, merchantID, id)
+		if err != nil {
+			return nil, err
+		}
+		if account.IsRequestedBy(userID) {
+			return nil, ErrBankAccountSelfConfirmation
+		}
+		// Requests made before time deposits were refused must not become the payout destination
+		if !account.ChangeType.IsDelete() && !account.BankAccountType.CanReceiveTransfer() {
+			return nil, ErrInvalidBankAccountType
+		}
+
+		if account.PreviousBankAccountID != nil {
+			previous, err := u.bankAccountRepo.FindByID(ctx, merchantID, *account.PreviousBankAccountID)
+			if err != nil {
+				return nil, err
+			}
+			if previous == nil || !previous.IsActive() {
+				return nil, ErrBankAccountPreviousNotAvailable
+			}
+
+			previous.Deactivate()
+			if err := u.bankAccountRepo.UpdateStatus(ctx, previous); err != nil {
+				return nil, err
+			}
+		}
+
+		account.Confirm(userID, time.Now())
+		if err := u.bankAccountRepo.UpdateStatus(ctx, account); err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	})
+}
+
+// Reject discards a pending change; the requester may also reject it to withdraw the request
+func (u *merchantBankAccountUsecaseImpl) Reject(ctx context.Context, merchantID int, id int, userID int) (*merchantModel.MerchantBankAccount, error) {
+	tx, err := database.NewTx[*merchantModel.MerchantBankAccount](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*merchantModel.MerchantBankAccount, error) {
+		if err := u.lockMerchant(ctx, merchantID); err != nil {
+			return nil, err
+		}
+
+		account, err := u.getPendingBankAccount(ctx, merchantID, id)
+		if err != nil {
+			return nil, err
+		}
+
+		account.Reject(userID, time.Now())
+		if err := u.bankAccountRepo.UpdateStatus(ctx, account); err != nil {
+			return nil, err
+		}
+
+		return account, nil
+	})
+}
+
+// prepareChange loads the active account a change or deletion is requested for
+func (u *merchantBankAccountUsecaseImpl) prepareChange(ctx context.Context, merchantID int, id int) (*merchantModel.MerchantBankAccount, error) {
+	if err := u.ensureNoPendingChange(ctx, merchantID); err != nil {
+		return nil, err
+	}
+
+	current, err := u.getBankAccount(ctx, merchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !current.IsActive() {
+		return nil, ErrBankAccountNotActive
+	}
+
+	return current, nil
+}
+
+func (u *merchantBankAccountUsecaseImpl) getPendingBankAccount(ctx context.Context, merchantID int, id int) (*merchantModel.MerchantBankAccount, error) {
+	account, err := u.getBankAccount(ctx, merchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IsPending() {
+		return nil, ErrBankAccountChangeNotPending
+	}
+
+	return account, nil
+}
+
+func (u *merchantBankAccountUsecaseImpl) getBankAccount(ctx context.Context, merchantID int, id int) (*merchantModel.MerchantBankAccount, error) {
+	account, err := u.bankAccountRepo.FindByID(ctx, merchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrBankAccountNotFound
+	}
+
+	return account, nil
+}
+
+// ensureNoPendingChange locks the merchant before looking for a pending change, so that two requests cannot both
+// find none and each create one
+func (u *merchantBankAccountUsecaseImpl) ensureNoPendingChange(ctx context.Context, merchantID int) error {
+	if err := u.lockMerchant(ctx, merchantID); err != nil {
+		return err
+	}
+
+	pending, err := u.bankAccountRepo.FindByStatus(ctx, merchantID, merchantObject.BankAccountStatusPending)
+	if err != nil {
+		return err
+	}
+	if pending != nil {
+		return ErrBankAccountChangePending
+	}
+
+	return nil
+}
+
+// lockMerchant locks the merchant row until the end of the transaction, serializing the changes of its bank account
+func (u *merchantBankAccountUsecaseImpl) lockMerchant(ctx context.Context, merchantID int) error {
+	merchant, err := u.merchantRepo.FindByIDForUpdate(ctx, merchantID)
+	if err != nil {
+		return err
+	}
+	if merchant == nil {
+		return ErrMerchantNotFound
+	}
+
+	return nil
+}
+
+func (u *merchantBankAccountUsecaseImpl) ensureMerchantExists(ctx context.Context, merchantID int) error {
+	merchant, err := u.merchantRepo.FindByID(ctx, merchantID)
+	if err != nil {
+		return err
+	}
+	if merchant == nil {
+		return ErrMerchantNotFound
+	}
+
+	return nil
+}
+
+// validateBankAccountInput checks the rules the request validation cannot express
+func validateBankAccountInput(input *inputdata.MerchantBankAccountInputData) error {
+	if !payoutObject.BankAccountType(input.BankAccountType).CanReceiveTransfer() {
+		return ErrInvalidBankAccountType
+	}
+	if input.AccountName == "" ||
+		utf8.RuneCountInString(input.AccountName) > accountNameMaxLength ||
+		!payoutObject.IsZenginCharacters(input.AccountName) {
+		return ErrInvalidAccountName
+	}
+
+	return nil
+}
+
+func newBankAccountRequest(input *inputdata.MerchantBankAccountInputData, changeType merchantObject.BankAccountChangeType) *merchantModel.MerchantBankAccount {
+	requestedBy := input.UserID
+	return &merchantModel.MerchantBankAccount{
+		MerchantID:      input.MerchantID,
+		BankName:        input.BankName,
+		BankCode:        input.BankCode,
+		BranchName:      input.BranchName,
+		BranchCode:      input.BranchCode,
+		BankAccountType: payoutObject.BankAccountType(input.BankAccountType),
+		AccountNo:       input.AccountNo,
+		AccountName:     input.AccountName,
+		ChangeType:      changeType,
+		Status:          merchantObject.BankAccountStatusPending,
+		RequestedBy:     &requestedBy,
+	}
+}
@@ -0,0 +1,120 @@
+package merchant
+
+import (
+	"context"
+	"errors"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	"github.com/huydq/test/internal/datastructure/outputdata"
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+var ErrReviewStatusUploadHasErrors = errors.New("アップロードファイルにエラーがあるため審査状況を更新しませんでした")
+
+// UploadReviewStatuses validates every row of an uploaded review status CSV and, only when all rows are valid,
+// records them as new payment provider reviews in a single transaction.
+// When any row is invalid the per-row report is returned together with ErrReviewStatusUploadHasErrors.
+func (uc *ManageMerchantsUsecase) UploadReviewStatuses(ctx context.Context, input *inputdata.MerchantReviewStatusUploadInputData) (*outputdata.MerchantReviewStatusUploadOutput, error) {
+	rows, err := uc.merchantReviewStatusCSVService.Parse(input.File)
+	if err != nil {
+		return nil, err
+	}
+
+	merchants, err := uc.findUploadedMerchants(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &outputdata.MerchantReviewStatusUploadOutput{
+		TotalRows: len(rows),
+		Rows:      make([]*outputdata.MerchantReviewStatusUploadRowOutput, len(rows)),
+	}
+	reviews := make([]*merchantModel.PaymentProviderReview, 0, len(rows))
+
+	for i, row := range rows {
+		var merchant *merchantModel.Merchant
+		if row.MerchantID != nil {
+			merchant = merchants[*row.MerchantID]
+			if merchant == nil {
+				row.AddError(ErrMerchantNotFound.Error())
+			}
+		}
+
+		output.Rows[i] = toReviewStatusUploadRowOutput(row, merchant)
+		if !row.IsValid() {
+			output.ErrorRows++
+			continue
+		}
+
+		output.ValidRows++
+		reviews = append(reviews, merchant.NewPaymentProviderReview(int(*row.MerchantReviewStatus)))
+	}
+
+	if output.ErrorRows > 0 {
+		return output, ErrReviewStatusUploadHasErrors
+	}
+
+	tx, err := database.NewTx[any](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Transact(ctx, func(ctx context.Context) (any, error) {
+		return nil, uc.merchantRepo.CreatePaymentProviderReviews(ctx, reviews)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output.Applied = true
+	return output, nil
+}
+
+func (uc *ManageMerchantsUsecase) findUploadedMerchants(ctx context.Context, rows []*service.MerchantReviewStatusRow) (map[int]*merchantModel.Merchant, error) {
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		if row.MerchantID != nil {
+			ids = append(ids, *row.MerchantID)
+		}
+	}
+
+	merchants, err := uc.merchantRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]*merchantModel.Merchant, len(merchants))
+	for _, merchant := range merchants {
+		result[merchant.ID] = merchant
+	}
+
+	return result, nil
+}
+
+func toReviewStatusUploadRowOutput(row *service.MerchantReviewStatusRow, merchant *merchantModel.Merchant) *outputdata.MerchantReviewStatusUploadRowOutput {
+	result := &outputdata.MerchantReviewStatusUploadRowOutput{
+		RowNumber:  row.RowNumber,
+		MerchantID: row.MerchantID,
+		Errors:     row.Errors,
+	}
+
+	if result.Errors == nil {
+		result.Errors = []string{}
+	}
+
+	if row.MerchantReviewStatus != nil {
+		status := int(*row.MerchantReviewStatus)
+		result.MerchantReviewStatus = &status
+	}
+
+	if merchant != nil {
+		result.MerchantName = &merchant.MerchantName
+		if merchant.MerchantPaymentProviderReview != nil {
+			result.PreviousMerchantReviewStatus = &merchant.MerchantPaymentProviderReview.MerchantReviewStatus
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/huydq/test/internal/domain/model/payin"
+	paypayModel "github.com/huydq/test/internal/domain/model/paypay"
+	repository "github.com/huydq/test/internal/domain/repository/payin_file"
+)
+
+var ErrPayinFileNotFound = errors.New("入金ファイルが見つかりません")
+
+type PayinFileUsecase interface {
+	ListPayinFileImportErrors(ctx context.Context, id int) ([]*model.PayinImportError, error)
+	GetPayinFilePaymentMethodTotals(ctx context.Context, id int) (*paypayModel.PaymentMethodBreakdown, error)
+}
+
+type payinFileUsecaseImpl struct {
+	payinFileRepo repository.PayinFileRepository
+}
+
+func NewPayinFileUsecase(payinFileRepo repository.PayinFileRepository) PayinFileUsecase {
+	return &payinFileUsecaseImpl{
+		payinFileRepo: payinFileRepo,
+	}
+}
+
+// ListPayinFileImportErrors lists the row errors of the last import of a payin file
+func (uc *payinFileUsecaseImpl) ListPayinFileImportErrors(ctx context.Context, id int) ([]*model.PayinImportError, error) {
+	file, err := uc.payinFileRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, ErrPayinFileNotFound
+	}
+
+	return uc.payinFileRepo.ListImportErrors(ctx, id)
+}
+
+// GetPayinFilePaymentMethodTotals sums the PayPay transactions of a payin file per payment method
+func (uc *payinFileUsecaseImpl) GetPayinFilePaymentMethodTotals(ctx context.Context, id int) (*paypayModel.PaymentMethodBreakdown, error) {
+	file, err := uc.payinFileRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, ErrPayinFileNotFound
+	}
+
+	transactions, err := uc.payinFileRepo.ListPaypayTransactions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return paypayModel.NewPaymentMethodBreakdown(transactions), nil
+}
@@ -3,28 +3,56 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
 	model "github.com/huydq/test/internal/domain/model/payout"
+	prModel "github.com/huydq/test/internal/domain/model/payout_record"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	merchantRepo "github.com/huydq/test/internal/domain/repository/merchant"
 	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/pkg/database"
 )
 
 var (
-	ErrInvalidInput    = errors.New("入力が無効です")
-	ErrPayoutNotFound  = errors.New("支払いが見つかりません")
-	ErrOperationFailed = errors.New("操作に失敗しました")
+	ErrInvalidInput           = errors.New("入力が無効です")
+	ErrPayoutNotFound         = errors.New("支払いが見つかりません")
+	ErrOperationFailed        = errors.New("操作に失敗しました")
+	ErrPayoutNotEditable      = errors.New("ドラフト状態の出金のみ変更できます")
+	ErrPayoutAwaitingTransfer = errors.New("振込データ作成済みの出金は変更できません")
+	ErrPayoutAlreadyProcessed = errors.New("送金手続き済みの出金は変更できません")
+	ErrPayoutUnderApproval    = errors.New("承認申請中の出金は変更できません")
+	ErrPayoutNotApproved      = errors.New("承認済みの出金のみ振込ファイルを出力できます")
+	ErrBankAccountNotActive   = errors.New("加盟店に確認済みの有効な口座が登録されていません")
+	ErrBankAccountMismatch    = errors.New("振込先が加盟店の確認済みの口座と一致しません")
 )
 
 type PayoutUsecase interface {
 	ListPayouts(ctx context.Context, filter *model.PayoutFilter) ([]*model.Payout, int, int64, error)
+	GetPayoutByID(ctx context.Context, id int) (*model.Payout, error)
+	CreatePayout(ctx context.Context, input *inputdata.CreatePayoutInputData) (*model.Payout, error)
+	UpdatePayout(ctx context.Context, id int, input *inputdata.UpdatePayoutInputData) (*model.Payout, error)
+	DeletePayout(ctx context.Context, id int) error
+	ExportZenginTransferFile(ctx context.Context, id int) (*service.ZenginTransferFile, error)
 }
 
 type payoutUsecaseImpl struct {
-	payoutService service.PayoutManagementService
+	payoutService             service.PayoutManagementService
+	zenginTransferFileService service.ZenginTransferFileService
+	bankAccountRepo           merchantRepo.MerchantBankAccountRepository
 }
 
-func NewPayoutUsecase(payoutService service.PayoutManagementService) PayoutUsecase {
+func NewPayoutUsecase(
+	payoutService service.PayoutManagementService,
+	zenginTransferFileService service.ZenginTransferFileService,
+	bankAccountRepo merchantRepo.MerchantBankAccountRepository,
+) PayoutUsecase {
 	return &payoutUsecaseImpl{
-		payoutService: payoutService,
+		payoutService:             payoutService,
+		zenginTransferFileService: zenginTransferFileService,
+		bankAccountRepo:           bankAccountRepo,
 	}
 }
 
@@ -37,3 +65,201 @@ func (u *payoutUsecaseImpl) ListPayouts(ctx context.Context, filter *model.Payou
 	filter.ApplyFilters()
 	return u.payoutService.ListPayouts(ctx, filter)
 }
+
+// GetPayoutByID retrieves a payout with its records
+func (u *payoutUsecaseImpl) GetPayoutByID(ctx context.Context, id int) (*model.Payout, error) {
+	payout, err := u.payoutService.GetPayoutByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payout == nil {
+		return nil, ErrPayoutNotFound
+	}
+
+	return payout, nil
+}
+
+// CreatePayout creates a draft payout with its records, which are paid to the confirmed bank accounts of the merchants
+func (u *payoutUsecaseImpl) CreatePayout(ctx context.Context, input *inputdata.CreatePayoutInputData) (*model.Payout, error) {
+	if input == nil || len(input.Records) == 0 {
+		return nil, ErrInvalidInput
+	}
+
+	records, err := toPayoutRecords(input.SendingDate, input.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := model.NewPayout(model.NewPayoutParams{
+		PayoutStatus: object.PayoutStatusDraft,
+		SendingDate:  input.SendingDate,
+		UserID:       input.UserID,
+	})
+	payout.SetPayoutRecords(records)
+
+	tx, err := database.NewTx[*model.Payout](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*model.Payout, error) {
+		if err := u.applyConfirmedBankAccounts(ctx, records); err != nil {
+			return nil, err
+		}
+		if err := u.payoutService.CreatePayout(ctx, payout); err != nil {
+			return nil, err
+		}
+
+		return u.payoutService.GetPayoutByID(ctx, payout.ID)
+	})
+}
+
+// UpdatePayout updates a draft payout and replaces its records, which are paid to the confirmed bank accounts of the merchants
+func (u *payoutUsecaseImpl) UpdatePayout(ctx context.Context, id int, input *inputdata.UpdatePayoutInputData) (*model.Payout, error) {
+	if input == nil || len(input.Records) == 0 {
+		return nil, ErrInvalidInput
+	}
+
+	records, err := toPayoutRecords(input.SendingDate, input.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.NewTx[*model.Payout](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*model.Payout, error) {
+		payout, err := u.GetPayoutByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := ensurePayoutEditable(payout); err != nil {
+			return nil, err
+		}
+		if err := u.applyConfirmedBankAccounts(ctx, records); err != nil {
+			return nil, err
+		}
+
+		payout.SendingDate = input.SendingDate
+		payout.SetPayoutRecords(records)
+		if err := u.payoutService.UpdatePayout(ctx, payout); err != nil {
+			return nil, err
+		}
+
+		return u.payoutService.GetPayoutByID(ctx, id)
+	})
+}
+
+// DeletePayout soft-deletes a draft payout and its records
+func (u *payoutUsecaseImpl) DeletePayout(ctx context.Context, id int) error {
+	tx, err := database.NewTx[any](ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Transact(ctx, func(ctx context.Context) (any, error) {
+		payout, err := u.GetPayoutByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := ensurePayoutEditable(payout); err != nil {
+			return nil, err
+		}
+
+		return nil, u.payoutService.DeletePayout(ctx, id)
+	})
+
+	return err
+}
+
+// ExportZenginTransferFile builds the Zengin transfer file of an approved payout
+func (u *payoutUsecaseImpl) ExportZenginTransferFile(ctx context.Context, id int) (*service.ZenginTransferFile, error) {
+	payout, err := u.GetPayoutByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !payout.CanBeProcessed() && !payout.IsProcessed() {
+		return nil, ErrPayoutNotApproved
+	}
+
+	return u.zenginTransferFileService.BuildTransferFile(payout, payout.PayoutRecords)
+}
+
+// applyConfirmedBankAccounts pays the records to the active bank account of their merchant, which has been confirmed by
+// a second user. Records whose transfer destination differs from it are rejected, so that a payout cannot be used to
+// send money to an account that did not go through the confirmation.
+func (u *payoutUsecaseImpl) applyConfirmedBankAccounts(ctx context.Context, records []*prModel.PayoutRecord) error {
+	accounts := make(map[int]*merchantModel.MerchantBankAccount)
+	for _, record := range records {
+		account, ok := accounts[record.ShopID]
+		if !ok {
+			var err error
+			account, err = u.bankAccountRepo.FindByStatus(ctx, record.ShopID, merchantObject.BankAccountStatusActive)
+			if err != nil {
+				return err
+			}
+			if account == nil {
+				return ErrBankAccountNotActive
+			}
+			accounts[record.ShopID] = account
+		}
+
+		if record.BankCode != account.BankCode ||
+			record.BranchCode != account.BranchCode ||
+			record.BankAccountType != account.BankAccountType ||
+			record.AccountNo != account.AccountNo ||
+			record.AccountName != account.AccountName {
+			return ErrBankAccountMismatch
+		}
+		record.BankName = account.BankName
+		record.BranchName = account.BranchName
+	}
+
+	return nil
+}
+
+// ensurePayoutEditable checks that the payout has not progressed beyond the draft status
+func ensurePayoutEditable(payout *model.Payout) error {
+	switch {
+	case payout.IsProcessed():
+		return ErrPayoutAlreadyProcessed
+	case payout.CanBeProcessed():
+		return ErrPayoutAwaitingTransfer
+	case payout.IsUnderApproval():
+		return ErrPayoutUnderApproval
+	case !payout.CanBeEdited():
+		return ErrPayoutNotEditable
+	}
+
+	return nil
+}
+
+// toPayoutRecords builds payout record models from the request input
+func toPayoutRecords(sendingDate time.Time, inputs []inputdata.PayoutRecordInputData) ([]*prModel.PayoutRecord, error) {
+	records := make([]*prModel.PayoutRecord, 0, len(inputs))
+	for _, in := range inputs {
+		accountType := object.BankAccountType(in.BankAccountType)
+		if !accountType.CanReceiveTransfer() || in.Amount <= 0 {
+			return nil, ErrInvalidInput
+		}
+
+		records = append(records, prModel.NewPayoutRecord(prModel.PayoutRecordParams{
+			ShopID:          in.ShopID,
+			TransactionID:   in.TransactionID,
+			BankName:        in.BankName,
+			BankCode:        in.BankCode,
+			BranchName:      in.BranchName,
+			BranchCode:      in.BranchCode,
+			BankAccountType: accountType,
+			AccountNo:       in.AccountNo,
+			AccountName:     in.AccountName,
+			Amount:          in.Amount,
+			TransferStatus:  object.TransferStatusNotSubmitted,
+			SendingDate:     &sendingDate,
+		}))
+	}
+
+	return records, nil
+}
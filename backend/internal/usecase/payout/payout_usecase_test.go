@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/huydq/test/internal/datastructure/inputdata"
+	approvalModel "github.com/huydq/test/internal/domain/model/approval"
+	merchantModel "github.com/huydq/test/internal/domain/model/merchant"
+	model "github.com/huydq/test/internal/domain/model/payout"
+	prModel "github.com/huydq/test/internal/domain/model/payout_record"
+	approvalObject "github.com/huydq/test/internal/domain/object/approval"
+	merchantObject "github.com/huydq/test/internal/domain/object/merchant"
+	object "github.com/huydq/test/internal/domain/object/payout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testShopID = 10
+
+// fakeMerchantBankAccountRepository keeps the bank accounts of the merchants and counts the lookups by status
+type fakeMerchantBankAccountRepository struct {
+	accounts map[int]*merchantModel.MerchantBankAccount
+	lookups  int
+}
+
+func (r *fakeMerchantBankAccountRepository) ListByMerchantID(context.Context, int) ([]*merchantModel.MerchantBankAccount, error) {
+	return nil, nil
+}
+
+func (r *fakeMerchantBankAccountRepository) FindByID(context.Context, int, int) (*merchantModel.MerchantBankAccount, error) {
+	return nil, nil
+}
+
+func (r *fakeMerchantBankAccountRepository) FindByStatus(_ context.Context, merchantID int, status merchantObject.BankAccountStatus) (*merchantModel.MerchantBankAccount, error) {
+	r.lookups++
+	account, ok := r.accounts[merchantID]
+	if !ok || account.Status != status {
+		return nil, nil
+	}
+	return account, nil
+}
+
+func (r *fakeMerchantBankAccountRepository) Create(context.Context, *merchantModel.MerchantBankAccount) error {
+	return nil
+}
+
+func (r *fakeMerchantBankAccountRepository) UpdateStatus(context.Context, *merchantModel.MerchantBankAccount) error {
+	return nil
+}
+
+func newTestBankAccount(status merchantObject.BankAccountStatus) *merchantModel.MerchantBankAccount {
+	return &merchantModel.MerchantBankAccount{
+		ID:              1,
+		MerchantID:      testShopID,
+		BankName:        "みずほ銀行",
+		BankCode:        "0001",
+		BranchName:      "本店",
+		BranchCode:      "001",
+		BankAccountType: object.BankAccountTypeOrdinary,
+		AccountNo:       "1234567",
+		AccountName:     "ｶ)ﾏｲｸｼﾖﾂﾌﾟ",
+		Status:          status,
+	}
+}
+
+func newTestPayoutRecordInput() inputdata.PayoutRecordInputData {
+	return inputdata.PayoutRecordInputData{
+		ShopID:          testShopID,
+		TransactionID:   100,
+		BankCode:        "0001",
+		BranchCode:      "001",
+		BankAccountType: int(object.BankAccountTypeOrdinary),
+		AccountNo:       "1234567",
+		AccountName:     "ｶ)ﾏｲｸｼﾖﾂﾌﾟ",
+		Amount:          5000,
+	}
+}
+
+func TestEnsurePayoutEditable(t *testing.T) {
+	cases := []struct {
+		name           string
+		status         object.PayoutStatus
+		approvalStatus *approvalObject.ApprovalStatus
+		want           error
+	}{
+		{name: "draft", status: object.PayoutStatusDraft},
+		{name: "draft with a rejected approval", status: object.PayoutStatusDraft, approvalStatus: approvalStatusPtr(approvalObject.ApprovalStatusRejected)},
+		{name: "draft under approval", status: object.PayoutStatusDraft, approvalStatus: approvalStatusPtr(approvalObject.ApprovalStatusWaitApproval), want: ErrPayoutUnderApproval},
+		{name: "draft waiting for approval", status: object.PayoutStatusDraft, approvalStatus: approvalStatusPtr(approvalObject.ApprovalStatusPending), want: ErrPayoutUnderApproval},
+		{name: "transfer data created", status: object.PayoutStatusCreated, want: ErrPayoutAwaitingTransfer},
+		{name: "processed", status: object.PayoutStatusProcessed, want: ErrPayoutAlreadyProcessed},
+		{name: "transfer failed", status: object.PayoutStatusTransferFailed, want: ErrPayoutNotEditable},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			payout := &model.Payout{PayoutStatus: tc.status}
+			if tc.approvalStatus != nil {
+				payout.Approval = &approvalModel.Approval{ApprovalStatus: *tc.approvalStatus}
+			}
+
+			err := ensurePayoutEditable(payout)
+			if tc.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.want)
+			}
+		})
+	}
+}
+
+func approvalStatusPtr(status approvalObject.ApprovalStatus) *approvalObject.ApprovalStatus {
+	return &status
+}
+
+func TestToPayoutRecords(t *testing.T) {
+	sendingDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.Local)
+
+	records, err := toPayoutRecords(sendingDate, []inputdata.PayoutRecordInputData{newTestPayoutRecordInput()})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, object.BankAccountTypeOrdinary, records[0].BankAccountType)
+	assert.Equal(t, object.TransferStatusNotSubmitted, records[0].TransferStatus)
+	if assert.NotNil(t, records[0].SendingDate) {
+		assert.True(t, records[0].SendingDate.Equal(sendingDate))
+	}
+
+	invalid := []struct {
+		name   string
+		modify func(in *inputdata.PayoutRecordInputData)
+	}{
+		{name: "fixed deposit account", modify: func(in *inputdata.PayoutRecordInputData) { in.BankAccountType = int(object.BankAccountTypeFixed) }},
+		{name: "unknown account type", modify: func(in *inputdata.PayoutRecordInputData) { in.BankAccountType = 0 }},
+		{name: "zero amount", modify: func(in *inputdata.PayoutRecordInputData) { in.Amount = 0 }},
+		{name: "negative amount", modify: func(in *inputdata.PayoutRecordInputData) { in.Amount = -1 }},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			in := newTestPayoutRecordInput()
+			tc.modify(&in)
+
+			_, err := toPayoutRecords(sendingDate, []inputdata.PayoutRecordInputData{newTestPayoutRecordInput(), in})
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		})
+	}
+}
+
+func TestApplyConfirmedBankAccounts(t *testing.T) {
+	cases := []struct {
+		name    string
+		account *merchantModel.MerchantBankAccount
+		modify  func(in *inputdata.PayoutRecordInputData)
+		want    error
+	}{
+		{name: "matching the active account", account: newTestBankAccount(merchantObject.BankAccountStatusActive)},
+		{name: "no account", want: ErrBankAccountNotActive},
+		{name: "pending account", account: newTestBankAccount(merchantObject.BankAccountStatusPending), want: ErrBankAccountNotActive},
+		{name: "inactive account", account: newTestBankAccount(merchantObject.BankAccountStatusInactive), want: ErrBankAccountNotActive},
+		{
+			name:    "different bank",
+			account: newTestBankAccount(merchantObject.BankAccountStatusActive),
+			modify:  func(in *inputdata.PayoutRecordInputData) { in.BankCode = "0005" },
+			want:    ErrBankAccountMismatch,
+		},
+		{
+			name:    "different branch",
+			account: newTestBankAccount(merchantObject.BankAccountStatusActive),
+			modify:  func(in *inputdata.PayoutRecordInputData) { in.BranchCode = "002" },
+			want:    ErrBankAccountMismatch,
+		},
+		{
+			name:    "different account type",
+			account: newTestBankAccount(merchantObject.BankAccountStatusActive),
+			modify:  func(in *inputdata.PayoutRecordInputData) { in.BankAccountType = int(object.BankAccountTypeCurrent) },
+			want:    ErrBankAccountMismatch,
+		},
+		{
+			name:    "different account number",
+			account: newTestBankAccount(merchantObject.BankAccountStatusActive),
+			modify:  func(in *inputdata.PayoutRecordInputData) { in.AccountNo = "7654321" },
+			want:    ErrBankAccountMismatch,
+		},
+		{
+			name:    "different account name",
+			account: newTestBankAccount(merchantObject.BankAccountStatusActive),
+			modify:  func(in *inputdata.PayoutRecordInputData) { in.AccountName = "ﾀﾅｶ ﾀﾛｳ" },
+			want:    ErrBankAccountMismatch,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeMerchantBankAccountRepository{accounts: map[int]*merchantModel.MerchantBankAccount{}}
+			if tc.account != nil {
+				repo.accounts[testShopID] = tc.account
+			}
+			uc := &payoutUsecaseImpl{bankAccountRepo: repo}
+
+			in := newTestPayoutRecordInput()
+			if tc.modify != nil {
+				tc.modify(&in)
+			}
+			records, err := toPayoutRecords(time.Now(), []inputdata.PayoutRecordInputData{in})
+			require.NoError(t, err)
+
+			err = uc.applyConfirmedBankAccounts(context.Background(), records)
+			if tc.want != nil {
+				assert.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "みずほ銀行", records[0].BankName, "the names are taken from the confirmed account")
+			assert.Equal(t, "本店", records[0].BranchName)
+		})
+	}
+}
+
+func TestApplyConfirmedBankAccounts_LooksUpEachMerchantOnce(t *testing.T) {
+	repo := &fakeMerchantBankAccountRepository{accounts: map[int]*merchantModel.MerchantBankAccount{
+		testShopID: newTestBankAccount(merchantObject.BankAccountStatusActive),
+	}}
+	uc := &payoutUsecaseImpl{bankAccountRepo: repo}
+
+	records := []*prModel.PayoutRecord{}
+	for range 3 {
+		built, err := toPayoutRecords(time.Now(), []inputdata.PayoutRecordInputData{newTestPayoutRecordInput()})
+		require.NoError(t, err)
+		records = append(records, built...)
+	}
+
+	require.NoError(t, uc.applyConfirmedBankAccounts(context.Background(), records))
+	assert.Equal(t, 1, repo.lookups)
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/huydq/test/internal/domain/model/payout"
+	userModel "github.com/huydq/test/internal/domain/model/user"
+	userRepository "github.com/huydq/test/internal/domain/repository/user"
+	"github.com/huydq/test/internal/domain/service"
+	"github.com/huydq/test/internal/pkg/database"
+)
+
+var (
+	ErrPayoutNotFound             = errors.New("支払いが見つかりません")
+	ErrApproverNotFound           = errors.New("承認者が見つかりません")
+	ErrPayoutNotSubmittable       = errors.New("ドラフト状態の出金のみ承認申請できます")
+	ErrPayoutAlreadyUnderApproval = errors.New("出金は既に承認申請中です")
+	ErrPayoutNotUnderApproval     = errors.New("承認申請中の出金ではありません")
+)
+
+type PayoutApprovalUsecase interface {
+	SubmitPayout(ctx context.Context, payoutID int, requesterID int) (*model.Payout, error)
+	ApprovePayout(ctx context.Context, payoutID int, approverID int) (*model.Payout, error)
+	RejectPayout(ctx context.Context, payoutID int, approverID int) (*model.Payout, error)
+}
+
+type payoutApprovalUsecaseImpl struct {
+	payoutService   service.PayoutManagementService
+	approvalService service.ApprovalWorkflowService
+	userRepo        userRepository.UserRepository
+	workflowID      int
+}
+
+func NewPayoutApprovalUsecase(
+	payoutService service.PayoutManagementService,
+	approvalService service.ApprovalWorkflowService,
+	userRepo userRepository.UserRepository,
+	workflowID int,
+) PayoutApprovalUsecase {
+	return &payoutApprovalUsecaseImpl{
+		payoutService:   payoutService,
+		approvalService: approvalService,
+		userRepo:        userRepo,
+		workflowID:      workflowID,
+	}
+}
+
+// SubmitPayout starts an approval for a draft payout using the configured approval workflow, requested by the given user
+func (u *payoutApprovalUsecaseImpl) SubmitPayout(ctx context.Context, payoutID int, requesterID int) (*model.Payout, error) {
+	tx, err := database.NewTx[*model.Payout](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*model.Payout, error) {
+		payout, err := u.getPayout(ctx, payoutID)
+		if err != nil {
+			return nil, err
+		}
+		if payout.IsUnderApproval() {
+			return nil, ErrPayoutAlreadyUnderApproval
+		}
+		if !payout.CanBeEdited() {
+			return nil, ErrPayoutNotSubmittable
+		}
+
+		approval, err := u.approvalService.StartApproval(ctx, u.workflowID, requesterID)
+		if err != nil {
+			return nil, err
+		}
+
+		payout.StartApproval(approval)
+		if err := u.payoutService.UpdatePayoutStatus(ctx, payout); err != nil {
+			return nil, err
+		}
+
+		return u.payoutService.GetPayoutByID(ctx, payoutID)
+	})
+}
+
+// ApprovePayout records the approver's approval on the current stage.
+// When the final stage is approved the payout moves on to the transfer data created status.
+func (u *payoutApprovalUsecaseImpl) ApprovePayout(ctx context.Context, payoutID int, approverID int) (*model.Payout, error) {
+	tx, err := database.NewTx[*model.Payout](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*model.Payout, error) {
+		payout, approver, err := u.prepareDecision(ctx, payoutID, approverID)
+		if err != nil {
+			return nil, err
+		}
+
+		approval, err := u.approvalService.Approve(ctx, *payout.ApprovalID, approver)
+		if err != nil {
+			return nil, err
+		}
+
+		if approval.ApprovalStatus.IsApproved() {
+			payout.MarkAsApproved()
+			if err := u.payoutService.UpdatePayoutStatus(ctx, payout); err != nil {
+				return nil, err
+			}
+		}
+
+		return u.payoutService.GetPayoutByID(ctx, payoutID)
+	})
+}
+
+// RejectPayout rejects the payout's approval; the payout stays a draft and may be edited and resubmitted
+func (u *payoutApprovalUsecaseImpl) RejectPayout(ctx context.Context, payoutID int, approverID int) (*model.Payout, error) {
+	tx, err := database.NewTx[*model.Payout](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Transact(ctx, func(ctx context.Context) (*model.Payout, error) {
+		payout, approver, err := u.prepareDecision(ctx, payoutID, approverID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := u.approvalService.Reject(ctx, *payout.ApprovalID, approver); err != nil {
+			return nil, err
+		}
+
+		return u.payoutService.GetPayoutByID(ctx, payoutID)
+	})
+}
+
+// prepareDecision loads the payout under approval and the acting approver, who may not be the creator of the payout
+func (u *payoutApprovalUsecaseImpl) prepareDecision(ctx context.Context, payoutID int, approverID int) (*model.Payout, *userModel.User, error) {
+	payout, err := u.getPayout(ctx, payoutID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !payout.IsUnderApproval() {
+		return nil, nil, ErrPayoutNotUnderApproval
+	}
+	if payout.UserID == approverID {
+		return nil, nil, service.ErrApproverIsRequester
+	}
+
+	approver, err := u.userRepo.FindByID(ctx, approverID)
+	if err != nil || approver == nil {
+		return nil, nil, ErrApproverNotFound
+	}
+
+	return payout, approver, nil
+}
+
+func (u *payoutApprovalUsecaseImpl) getPayout(ctx context.Context, payoutID int) (*model.Payout, error) {
+	payout, err := u.payoutService.GetPayoutByID(ctx, payoutID)
+	if err != nil {
+		return nil, err
+	}
+	if payout == nil {
+		return nil, ErrPayoutNotFound
+	}
+
+	return payout, nil
+}